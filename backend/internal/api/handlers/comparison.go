@@ -1,9 +1,12 @@
 package handlers
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -32,8 +35,11 @@ func NewComparisonHandler(comparisonService *comparison.ComparisonService, swimm
 // GetComparison handles GET /comparisons requests.
 // Query parameters:
 //   - standard_id (required): UUID of the time standard to compare against
-//   - course_type (optional): "25m" or "50m", defaults to "25m"
-//   - threshold (optional): "almost there" threshold percentage, defaults to 3.0
+//   - course_type (optional): "25m", "50m" or "open_water", defaults to "25m"
+//   - threshold (optional): default "almost there" threshold percentage, defaults to the
+//     swimmer's setting; thresholds set for the standard, an event or a distance take precedence
+//   - date (optional): YYYY-MM-DD; evaluates the comparison as it stood on that date,
+//     using only times swum up to then and the age group that applied
 func (h *ComparisonHandler) GetComparison(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -55,8 +61,8 @@ func (h *ComparisonHandler) GetComparison(w http.ResponseWriter, r *http.Request
 	if courseType == "" {
 		courseType = "25m"
 	}
-	if courseType != "25m" && courseType != "50m" {
-		middleware.WriteError(w, http.StatusBadRequest, "course_type must be '25m' or '50m'", "INVALID_INPUT")
+	if courseType != "25m" && courseType != "50m" && courseType != "open_water" {
+		middleware.WriteError(w, http.StatusBadRequest, "course_type must be '25m', '50m' or 'open_water'", "INVALID_INPUT")
 		return
 	}
 
@@ -71,6 +77,17 @@ func (h *ComparisonHandler) GetComparison(w http.ResponseWriter, r *http.Request
 		threshold = &t
 	}
 
+	// Get date parameter (optional)
+	var asOf *time.Time
+	if dateStr := r.URL.Query().Get("date"); dateStr != "" {
+		parsed, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "invalid date format (expected YYYY-MM-DD)", "INVALID_INPUT")
+			return
+		}
+		asOf = &parsed
+	}
+
 	// Get swimmer profile
 	swimmerProfile, err := h.swimmerService.Get(ctx)
 	if err != nil {
@@ -88,15 +105,199 @@ func (h *ComparisonHandler) GetComparison(w http.ResponseWriter, r *http.Request
 	}
 
 	// Perform comparison
-	result, err := h.comparisonService.Compare(ctx, swimmerProfile.ID, standardID, courseType, threshold)
+	result, err := h.comparisonService.Compare(ctx, swimmerProfile.ID, standardID, courseType, threshold, asOf)
 	if err != nil {
 		if err == postgres.ErrNotFound {
 			middleware.WriteError(w, http.StatusNotFound, "standard not found", "NOT_FOUND")
 			return
 		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
 		middleware.WriteInternalError(w, h.logger, err, "failed to compare times")
 		return
 	}
 
 	middleware.WriteJSON(w, http.StatusOK, result)
 }
+
+// GetComparisonMatrix handles GET /comparisons/matrix requests.
+// Query parameters:
+//   - standard_ids (optional): comma-separated standard UUIDs, defaults to every
+//     standard for the course type that applies to the swimmer
+//   - course_type (optional): "25m", "50m" or "open_water", defaults to "25m"
+//   - threshold (optional): "almost there" threshold percentage, defaults to the swimmer's setting
+func (h *ComparisonHandler) GetComparisonMatrix(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	standardIDs, err := parseStandardIDs(r.URL.Query().Get("standard_ids"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid standard_ids", "INVALID_INPUT")
+		return
+	}
+
+	courseType := r.URL.Query().Get("course_type")
+	if courseType == "" {
+		courseType = "25m"
+	}
+	if courseType != "25m" && courseType != "50m" && courseType != "open_water" {
+		middleware.WriteError(w, http.StatusBadRequest, "course_type must be '25m', '50m' or 'open_water'", "INVALID_INPUT")
+		return
+	}
+
+	var threshold *float64
+	if thresholdStr := r.URL.Query().Get("threshold"); thresholdStr != "" {
+		t, err := strconv.ParseFloat(thresholdStr, 64)
+		if err != nil || t < 0 || t > 100 {
+			middleware.WriteError(w, http.StatusBadRequest, "threshold must be a number between 0 and 100", "INVALID_INPUT")
+			return
+		}
+		threshold = &t
+	}
+
+	swimmerProfile, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found - please set up your profile first", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer profile")
+		return
+	}
+	if threshold == nil {
+		threshold = &swimmerProfile.ThresholdPercent
+	}
+
+	matrix, err := h.comparisonService.CompareMatrix(ctx, swimmerProfile.ID, standardIDs, courseType, threshold)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "standard not found", "NOT_FOUND")
+			return
+		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to build comparison matrix")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, matrix)
+}
+
+// GetAchievements handles GET /achievements requests.
+// Query parameters:
+//   - standard_ids (optional): comma-separated standard UUIDs, defaults to every
+//     standard that applies to the swimmer
+//   - course_type (optional): "25m", "50m" or "open_water", defaults to all courses
+func (h *ComparisonHandler) GetAchievements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	standardIDs, err := parseStandardIDs(r.URL.Query().Get("standard_ids"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid standard_ids", "INVALID_INPUT")
+		return
+	}
+
+	var courseType *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		if ct != "25m" && ct != "50m" && ct != "open_water" {
+			middleware.WriteError(w, http.StatusBadRequest, "course_type must be '25m', '50m' or 'open_water'", "INVALID_INPUT")
+			return
+		}
+		courseType = &ct
+	}
+
+	swimmerProfile, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found - please set up your profile first", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer profile")
+		return
+	}
+
+	timeline, err := h.comparisonService.GetAchievements(ctx, swimmerProfile.ID, standardIDs, courseType)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "standard not found", "NOT_FOUND")
+			return
+		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get achievements")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, timeline)
+}
+
+// GetAgeUpPlan handles GET /comparisons/age-up requests.
+// Query parameters:
+//   - standard_ids (optional): comma-separated standard UUIDs, defaults to every
+//     standard of the swimmer's category that applies to the swimmer
+//   - course_type (optional): "25m", "50m" or "open_water", defaults to all courses
+func (h *ComparisonHandler) GetAgeUpPlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	standardIDs, err := parseStandardIDs(r.URL.Query().Get("standard_ids"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid standard_ids", "INVALID_INPUT")
+		return
+	}
+
+	var courseType *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		if ct != "25m" && ct != "50m" && ct != "open_water" {
+			middleware.WriteError(w, http.StatusBadRequest, "course_type must be '25m', '50m' or 'open_water'", "INVALID_INPUT")
+			return
+		}
+		courseType = &ct
+	}
+
+	swimmerProfile, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found - please set up your profile first", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer profile")
+		return
+	}
+
+	plan, err := h.comparisonService.PlanAgeUp(ctx, swimmerProfile.ID, standardIDs, courseType)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "standard not found", "NOT_FOUND")
+			return
+		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to plan age-up")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, plan)
+}
+
+// parseStandardIDs parses a comma-separated list of standard UUIDs.
+func parseStandardIDs(ids string) ([]uuid.UUID, error) {
+	if ids == "" {
+		return nil, nil
+	}
+	var standardIDs []uuid.UUID
+	for _, idStr := range strings.Split(ids, ",") {
+		id, err := uuid.Parse(strings.TrimSpace(idStr))
+		if err != nil {
+			return nil, err
+		}
+		standardIDs = append(standardIDs, id)
+	}
+	return standardIDs, nil
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/event"
+)
+
+// EventHandler handles event catalogue API requests.
+type EventHandler struct {
+	service *event.Service
+	logger  *slog.Logger
+}
+
+// NewEventHandler creates a new event handler.
+func NewEventHandler(service *event.Service, logger *slog.Logger) *EventHandler {
+	return &EventHandler{service: service, logger: logger}
+}
+
+// ListEvents handles GET /events requests.
+func (h *EventHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	var courseType *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+
+	list, err := h.service.List(courseType)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to list events")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
+
+// CreateEvent handles POST /events requests.
+func (h *EventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	var input event.Input
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	e, err := h.service.Create(ctx, input)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to create event")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, e)
+}
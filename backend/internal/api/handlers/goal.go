@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/comparison"
+	"github.com/bpg/swimstats/backend/internal/domain/goal"
+	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// GoalHandler handles goal API requests.
+type GoalHandler struct {
+	goalService    *goal.Service
+	tracker        *comparison.GoalTracker
+	swimmerService *swimmer.Service
+	logger         *slog.Logger
+}
+
+// NewGoalHandler creates a new goal handler.
+func NewGoalHandler(goalService *goal.Service, tracker *comparison.GoalTracker, swimmerService *swimmer.Service, logger *slog.Logger) *GoalHandler {
+	return &GoalHandler{
+		goalService:    goalService,
+		tracker:        tracker,
+		swimmerService: swimmerService,
+		logger:         logger,
+	}
+}
+
+// ListGoals handles GET /goals requests.
+// Query parameters:
+//   - status (optional): "active", "achieved" or "missed", defaults to all goals
+func (h *GoalHandler) ListGoals(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var status *goal.Status
+	if s := r.URL.Query().Get("status"); s != "" {
+		st := goal.Status(s)
+		if !st.IsValid() {
+			middleware.WriteError(w, http.StatusBadRequest, "status must be 'active', 'achieved' or 'missed'", "INVALID_INPUT")
+			return
+		}
+		status = &st
+	}
+
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found - please set up your profile first", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer profile")
+		return
+	}
+
+	list, err := h.tracker.List(ctx, sw.ID, status)
+	if err != nil {
+		middleware.WriteInternalError(w, h.logger, err, "failed to list goals")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
+
+// GetGoal handles GET /goals/{id} requests.
+func (h *GoalHandler) GetGoal(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid goal ID", "INVALID_INPUT")
+		return
+	}
+
+	g, err := h.tracker.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "goal not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get goal")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, g)
+}
+
+// CreateGoal handles POST /goals requests.
+func (h *GoalHandler) CreateGoal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusBadRequest, "swimmer profile required", "PRECONDITION_FAILED")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	var input goal.Input
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	created, err := h.goalService.Create(ctx, sw.ID, input)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to create goal")
+		return
+	}
+
+	g, err := h.tracker.Track(ctx, created.ID)
+	if err != nil {
+		middleware.WriteInternalError(w, h.logger, err, "failed to evaluate goal")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, g)
+}
+
+// UpdateGoal handles PUT /goals/{id} requests.
+func (h *GoalHandler) UpdateGoal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid goal ID", "INVALID_INPUT")
+		return
+	}
+
+	var input goal.Input
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	if _, err := h.goalService.Update(ctx, id, input); err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "goal not found", "NOT_FOUND")
+			return
+		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to update goal")
+		return
+	}
+
+	g, err := h.tracker.Track(ctx, id)
+	if err != nil {
+		middleware.WriteInternalError(w, h.logger, err, "failed to evaluate goal")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, g)
+}
+
+// DeleteGoal handles DELETE /goals/{id} requests.
+func (h *GoalHandler) DeleteGoal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid goal ID", "INVALID_INPUT")
+		return
+	}
+
+	if err := h.goalService.Delete(ctx, id); err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "goal not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to delete goal")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/comparison"
+	"github.com/bpg/swimstats/backend/internal/domain/ladder"
+	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// LadderHandler handles standard ladder API requests.
+type LadderHandler struct {
+	service         *ladder.Service
+	progressService *comparison.LadderProgressService
+	swimmerService  *swimmer.Service
+	logger          *slog.Logger
+}
+
+// NewLadderHandler creates a new ladder handler.
+func NewLadderHandler(service *ladder.Service, progressService *comparison.LadderProgressService, swimmerService *swimmer.Service, logger *slog.Logger) *LadderHandler {
+	return &LadderHandler{
+		service:         service,
+		progressService: progressService,
+		swimmerService:  swimmerService,
+		logger:          logger,
+	}
+}
+
+// ListLadders handles GET /ladders requests.
+func (h *LadderHandler) ListLadders(w http.ResponseWriter, r *http.Request) {
+	var courseType *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+
+	list, err := h.service.List(r.Context(), courseType)
+	if err != nil {
+		middleware.WriteInternalError(w, h.logger, err, "failed to list ladders")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
+
+// GetLadder handles GET /ladders/{id} requests.
+func (h *LadderHandler) GetLadder(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid ladder ID", "INVALID_INPUT")
+		return
+	}
+
+	lad, err := h.service.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "ladder not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get ladder")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, lad)
+}
+
+// CreateLadder handles POST /ladders requests.
+func (h *LadderHandler) CreateLadder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	var input ladder.Input
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	lad, err := h.service.Create(ctx, input)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to create ladder")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, lad)
+}
+
+// UpdateLadder handles PUT /ladders/{id} requests.
+func (h *LadderHandler) UpdateLadder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid ladder ID", "INVALID_INPUT")
+		return
+	}
+
+	var input ladder.Input
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	lad, err := h.service.Update(ctx, id, input)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "ladder not found", "NOT_FOUND")
+			return
+		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to update ladder")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, lad)
+}
+
+// DeleteLadder handles DELETE /ladders/{id} requests.
+func (h *LadderHandler) DeleteLadder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid ladder ID", "INVALID_INPUT")
+		return
+	}
+
+	if err := h.service.Delete(ctx, id); err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "ladder not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to delete ladder")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetLadderProgress handles GET /ladders/{id}/progress requests.
+// Query parameters:
+//   - threshold (optional): "almost there" threshold percentage, defaults to the swimmer's setting
+func (h *LadderHandler) GetLadderProgress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid ladder ID", "INVALID_INPUT")
+		return
+	}
+
+	var threshold *float64
+	if thresholdStr := r.URL.Query().Get("threshold"); thresholdStr != "" {
+		t, err := strconv.ParseFloat(thresholdStr, 64)
+		if err != nil || t < 0 || t > 100 {
+			middleware.WriteError(w, http.StatusBadRequest, "threshold must be a number between 0 and 100", "INVALID_INPUT")
+			return
+		}
+		threshold = &t
+	}
+
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+	if threshold == nil {
+		threshold = &sw.ThresholdPercent
+	}
+
+	progress, err := h.progressService.GetProgress(ctx, sw.ID, id, threshold)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "ladder not found", "NOT_FOUND")
+			return
+		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get ladder progress")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, progress)
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/comparison"
+	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// ParaPointsHandler handles para points API requests.
+type ParaPointsHandler struct {
+	paraService    *comparison.ParaPointsService
+	swimmerService *swimmer.Service
+	logger         *slog.Logger
+}
+
+// NewParaPointsHandler creates a new para points handler.
+func NewParaPointsHandler(paraService *comparison.ParaPointsService, swimmerService *swimmer.Service, logger *slog.Logger) *ParaPointsHandler {
+	return &ParaPointsHandler{
+		paraService:    paraService,
+		swimmerService: swimmerService,
+		logger:         logger,
+	}
+}
+
+// GetParaPoints handles GET /para-points requests.
+func (h *ParaPointsHandler) GetParaPoints(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Get swimmer profile
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	courseType := r.URL.Query().Get("course_type")
+	if courseType == "" {
+		middleware.WriteError(w, http.StatusBadRequest, "course_type is required", "INVALID_INPUT")
+		return
+	}
+
+	result, err := h.paraService.GetParaPoints(ctx, sw.ID, courseType)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get para points")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, result)
+}
+
+// ListBaseTimes handles GET /para-points/base-times requests.
+func (h *ParaPointsHandler) ListBaseTimes(w http.ResponseWriter, r *http.Request) {
+	var courseType, gender *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+	if g := r.URL.Query().Get("gender"); g != "" {
+		gender = &g
+	}
+
+	list, err := h.paraService.ListBaseTimes(r.Context(), courseType, gender)
+	if err != nil {
+		middleware.WriteInternalError(w, h.logger, err, "failed to list para base times")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
+
+// ImportBaseTimes handles POST /para-points/base-times requests.
+func (h *ParaPointsHandler) ImportBaseTimes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	var input comparison.ParaBaseTimeInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	list, err := h.paraService.ImportBaseTimes(ctx, input)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to import para base times")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
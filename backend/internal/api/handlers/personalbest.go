@@ -4,6 +4,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/bpg/swimstats/backend/internal/api/middleware"
 	"github.com/bpg/swimstats/backend/internal/domain/comparison"
@@ -28,6 +29,10 @@ func NewPersonalBestHandler(pbService *comparison.PersonalBestService, swimmerSe
 }
 
 // GetPersonalBests handles GET /personal-bests requests.
+// Query parameters:
+//   - course_type (required): "25m", "50m" or "open_water"
+//   - include_electronic (optional): "true" adds the best electronic swim
+//   - as_of (optional): YYYY-MM-DD, personal bests as they stood on that date
 func (h *PersonalBestHandler) GetPersonalBests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -49,7 +54,19 @@ func (h *PersonalBestHandler) GetPersonalBests(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	pbs, err := h.pbService.GetPersonalBests(ctx, sw.ID, courseType)
+	opts := comparison.PersonalBestOptions{
+		IncludeBestElectronic: r.URL.Query().Get("include_electronic") == "true",
+	}
+	if asOfStr := r.URL.Query().Get("as_of"); asOfStr != "" {
+		parsed, err := time.Parse("2006-01-02", asOfStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "invalid as_of format (expected YYYY-MM-DD)", "INVALID_INPUT")
+			return
+		}
+		opts.AsOf = &parsed
+	}
+
+	pbs, err := h.pbService.GetPersonalBests(ctx, sw.ID, courseType, opts)
 	if err != nil {
 		if isValidationError(err) {
 			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
@@ -61,3 +78,78 @@ func (h *PersonalBestHandler) GetPersonalBests(w http.ResponseWriter, r *http.Re
 
 	middleware.WriteJSON(w, http.StatusOK, pbs)
 }
+
+// GetPersonalBestHistory handles GET /personal-bests/history requests.
+// Query parameters:
+//   - course_type (required): "25m", "50m" or "open_water"
+//   - event (optional): limits the progression to a single event
+func (h *PersonalBestHandler) GetPersonalBestHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	courseType := r.URL.Query().Get("course_type")
+	if courseType == "" {
+		middleware.WriteError(w, http.StatusBadRequest, "course_type is required", "VALIDATION_ERROR")
+		return
+	}
+
+	var event *string
+	if e := r.URL.Query().Get("event"); e != "" {
+		event = &e
+	}
+
+	history, err := h.pbService.GetPersonalBestHistory(ctx, sw.ID, courseType, event)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get personal best history")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, history)
+}
+
+// GetAgeGroupBests handles GET /personal-bests/age-groups requests.
+// Query parameters:
+//   - course_type (optional): "25m", "50m" or "open_water", defaults to all courses
+func (h *PersonalBestHandler) GetAgeGroupBests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	var courseType *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+
+	bests, err := h.pbService.GetAgeGroupBests(ctx, sw.ID, courseType)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get age group bests")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, bests)
+}
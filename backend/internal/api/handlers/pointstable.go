@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/pointstable"
+	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// PointsTableHandler handles points table API requests.
+type PointsTableHandler struct {
+	tableService   *pointstable.Service
+	swimmerService *swimmer.Service
+	logger         *slog.Logger
+}
+
+// NewPointsTableHandler creates a new points table handler.
+func NewPointsTableHandler(tableService *pointstable.Service, swimmerService *swimmer.Service, logger *slog.Logger) *PointsTableHandler {
+	return &PointsTableHandler{
+		tableService:   tableService,
+		swimmerService: swimmerService,
+		logger:         logger,
+	}
+}
+
+// ListTables handles GET /points-tables requests.
+func (h *PointsTableHandler) ListTables(w http.ResponseWriter, r *http.Request) {
+	middleware.WriteJSON(w, http.StatusOK, h.tableService.ListTables())
+}
+
+// ScoreTimes handles GET /points-tables/{name}/times requests.
+// Query parameters:
+//   - course_type (optional): filter by course type
+func (h *PointsTableHandler) ScoreTimes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	sw, ok := h.getSwimmer(w, r)
+	if !ok {
+		return
+	}
+
+	var courseType *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+
+	list, err := h.tableService.ScoreTimes(ctx, chi.URLParam(r, "name"), sw.ID, courseType)
+	if err != nil {
+		h.writeError(w, err, "failed to score times")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
+
+// GetProfile handles GET /points-tables/{name}/profile requests.
+// Query parameters:
+//   - course_type (optional): filter by course type
+//   - season (optional): limit the profile to one season, e.g. "2025-26"
+func (h *PointsTableHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	sw, ok := h.getSwimmer(w, r)
+	if !ok {
+		return
+	}
+
+	var courseType, season *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+	if s := r.URL.Query().Get("season"); s != "" {
+		season = &s
+	}
+
+	profile, err := h.tableService.GetProfile(ctx, chi.URLParam(r, "name"), sw.ID, courseType, season)
+	if err != nil {
+		h.writeError(w, err, "failed to build points profile")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, profile)
+}
+
+// getSwimmer loads the swimmer profile, writing an error response if it cannot.
+func (h *PointsTableHandler) getSwimmer(w http.ResponseWriter, r *http.Request) (*swimmer.Swimmer, bool) {
+	sw, err := h.swimmerService.Get(r.Context())
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return nil, false
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return nil, false
+	}
+	return sw, true
+}
+
+// writeError maps service errors to responses.
+func (h *PointsTableHandler) writeError(w http.ResponseWriter, err error, msg string) {
+	switch {
+	case errors.Is(err, pointstable.ErrTableNotFound):
+		middleware.WriteError(w, http.StatusNotFound, err.Error(), "NOT_FOUND")
+	case isValidationError(err):
+		middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+	default:
+		middleware.WriteInternalError(w, h.logger, err, msg)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 
 	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain"
 	"github.com/bpg/swimstats/backend/internal/domain/comparison"
 	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
 	"github.com/bpg/swimstats/backend/internal/store/postgres"
@@ -16,21 +18,27 @@ import (
 
 // ProgressHandler handles progress chart API requests.
 type ProgressHandler struct {
-	progressService *comparison.ProgressService
-	swimmerService  *swimmer.Service
-	logger          *slog.Logger
+	progressService   *comparison.ProgressService
+	comparisonService *comparison.ComparisonService
+	swimmerService    *swimmer.Service
+	logger            *slog.Logger
 }
 
 // NewProgressHandler creates a new progress handler.
-func NewProgressHandler(progressService *comparison.ProgressService, swimmerService *swimmer.Service, logger *slog.Logger) *ProgressHandler {
+func NewProgressHandler(progressService *comparison.ProgressService, comparisonService *comparison.ComparisonService, swimmerService *swimmer.Service, logger *slog.Logger) *ProgressHandler {
 	return &ProgressHandler{
-		progressService: progressService,
-		swimmerService:  swimmerService,
-		logger:          logger,
+		progressService:   progressService,
+		comparisonService: comparisonService,
+		swimmerService:    swimmerService,
+		logger:            logger,
 	}
 }
 
 // GetProgressData handles GET /progress/{event} requests.
+// Query parameters:
+//   - course_type (required): "25m", "50m" or "open_water"
+//   - start_date, end_date (optional): YYYY-MM-DD date range
+//   - trend_model (optional): "auto", "log" or "exponential", defaults to "auto"
 func (h *ProgressHandler) GetProgressData(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -78,7 +86,12 @@ func (h *ProgressHandler) GetProgressData(w http.ResponseWriter, r *http.Request
 		endDate = &parsed
 	}
 
-	progressData, err := h.progressService.GetProgressData(ctx, sw.ID, courseType, event, startDate, endDate)
+	model := domain.TrendAuto
+	if m := r.URL.Query().Get("trend_model"); m != "" {
+		model = domain.TrendModel(m)
+	}
+
+	progressData, err := h.progressService.GetProgressData(ctx, sw.ID, courseType, event, startDate, endDate, model)
 	if err != nil {
 		if isValidationError(err) {
 			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
@@ -90,3 +103,105 @@ func (h *ProgressHandler) GetProgressData(w http.ResponseWriter, r *http.Request
 
 	middleware.WriteJSON(w, http.StatusOK, progressData)
 }
+
+// GetPrediction handles GET /progress/{event}/prediction requests.
+// Query parameters:
+//   - course_type (required): "25m", "50m" or "open_water"
+//   - date (optional): YYYY-MM-DD date to predict, defaults to one year from today
+//   - model (optional): "auto", "log" or "exponential", defaults to "auto"
+//   - standard_id (optional): UUID of a standard to estimate when it is reached
+func (h *ProgressHandler) GetPrediction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	event := chi.URLParam(r, "event")
+	courseType := r.URL.Query().Get("course_type")
+	if courseType == "" {
+		middleware.WriteError(w, http.StatusBadRequest, "course_type is required", "VALIDATION_ERROR")
+		return
+	}
+
+	var date *time.Time
+	if dateStr := r.URL.Query().Get("date"); dateStr != "" {
+		parsed, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "invalid date format (expected YYYY-MM-DD)", "VALIDATION_ERROR")
+			return
+		}
+		date = &parsed
+	}
+
+	model := domain.TrendAuto
+	if m := r.URL.Query().Get("model"); m != "" {
+		model = domain.TrendModel(m)
+	}
+
+	var standardID *uuid.UUID
+	if idStr := r.URL.Query().Get("standard_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "invalid standard_id", "INVALID_INPUT")
+			return
+		}
+		standardID = &id
+	}
+
+	prediction, err := h.comparisonService.Predict(ctx, sw.ID, event, courseType, date, model, standardID)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "standard not found", "NOT_FOUND")
+			return
+		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to predict time")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, prediction)
+}
+
+// GetImprovementStatistics handles GET /analytics/improvement requests.
+// Query parameters:
+//   - course_type (optional): "25m", "50m" or "open_water", defaults to all courses
+func (h *ProgressHandler) GetImprovementStatistics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	var courseType *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+
+	stats, err := h.progressService.GetImprovementStatistics(ctx, sw.ID, courseType)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get improvement statistics")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, stats)
+}
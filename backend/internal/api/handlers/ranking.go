@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/comparison"
+	"github.com/bpg/swimstats/backend/internal/domain/ranking"
+	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// RankingHandler handles ranking snapshot API requests.
+type RankingHandler struct {
+	service           *ranking.Service
+	comparisonService *comparison.RankingComparisonService
+	swimmerService    *swimmer.Service
+	logger            *slog.Logger
+}
+
+// NewRankingHandler creates a new ranking handler.
+func NewRankingHandler(service *ranking.Service, comparisonService *comparison.RankingComparisonService, swimmerService *swimmer.Service, logger *slog.Logger) *RankingHandler {
+	return &RankingHandler{
+		service:           service,
+		comparisonService: comparisonService,
+		swimmerService:    swimmerService,
+		logger:            logger,
+	}
+}
+
+// ListRankings handles GET /rankings requests.
+// Query parameters (all optional): season, course_type, event, gender, age_group
+func (h *RankingHandler) ListRankings(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	list, err := h.service.List(r.Context(), ranking.Filter{
+		Season:     query.Get("season"),
+		CourseType: query.Get("course_type"),
+		Event:      query.Get("event"),
+		Gender:     query.Get("gender"),
+		AgeGroup:   query.Get("age_group"),
+	})
+	if err != nil {
+		middleware.WriteInternalError(w, h.logger, err, "failed to list rankings")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
+
+// GetRanking handles GET /rankings/{id} requests.
+func (h *RankingHandler) GetRanking(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid ranking ID", "INVALID_INPUT")
+		return
+	}
+
+	snapshot, err := h.service.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "ranking not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get ranking")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, snapshot)
+}
+
+// DeleteRanking handles DELETE /rankings/{id} requests.
+func (h *RankingHandler) DeleteRanking(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid ranking ID", "INVALID_INPUT")
+		return
+	}
+
+	if err := h.service.Delete(ctx, id); err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "ranking not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to delete ranking")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ImportRanking handles POST /rankings/import requests with a JSON body.
+func (h *RankingHandler) ImportRanking(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	var input ranking.ImportInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	h.importRanking(w, r, input)
+}
+
+// ImportRankingCSV handles POST /rankings/import/csv requests. The body is a
+// CSV file of ranked times and the snapshot is described by query parameters:
+//   - source (required): where the list comes from, e.g. "Swim Ontario Top 50"
+//   - season (required): e.g. "2025-26"
+//   - event, course_type, gender, age_group (required)
+//   - captured_on (optional): YYYY-MM-DD date the list was taken
+func (h *RankingHandler) ImportRankingCSV(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	entries, err := ranking.ParseCSV(r.Body)
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+		return
+	}
+
+	query := r.URL.Query()
+	h.importRanking(w, r, ranking.ImportInput{
+		Source:     query.Get("source"),
+		Season:     query.Get("season"),
+		Event:      query.Get("event"),
+		CourseType: query.Get("course_type"),
+		Gender:     query.Get("gender"),
+		AgeGroup:   query.Get("age_group"),
+		CapturedOn: query.Get("captured_on"),
+		Entries:    entries,
+	})
+}
+
+func (h *RankingHandler) importRanking(w http.ResponseWriter, r *http.Request, input ranking.ImportInput) {
+	result, err := h.service.Import(r.Context(), input)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to import ranking")
+		return
+	}
+
+	status := http.StatusCreated
+	if result.Replaced {
+		status = http.StatusOK
+	}
+	middleware.WriteJSON(w, status, result)
+}
+
+// CompareRankings handles GET /comparisons/rankings requests.
+// Query parameters:
+//   - season (optional): e.g. "2025-26", defaults to all seasons
+//   - course_type (optional): "25m", "50m" or "open_water", defaults to all courses
+func (h *RankingHandler) CompareRankings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var season, courseType *string
+	if s := r.URL.Query().Get("season"); s != "" {
+		season = &s
+	}
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	result, err := h.comparisonService.CompareRankings(ctx, sw.ID, season, courseType)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to compare rankings")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, result)
+}
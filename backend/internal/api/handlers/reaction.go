@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/comparison"
+	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// ReactionTimeHandler handles reaction time analysis API requests.
+type ReactionTimeHandler struct {
+	reactionService *comparison.ReactionTimeService
+	swimmerService  *swimmer.Service
+	logger          *slog.Logger
+}
+
+// NewReactionTimeHandler creates a new reaction time handler.
+func NewReactionTimeHandler(reactionService *comparison.ReactionTimeService, swimmerService *swimmer.Service, logger *slog.Logger) *ReactionTimeHandler {
+	return &ReactionTimeHandler{
+		reactionService: reactionService,
+		swimmerService:  swimmerService,
+		logger:          logger,
+	}
+}
+
+// GetReactionTimes handles GET /reaction-times requests.
+func (h *ReactionTimeHandler) GetReactionTimes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Get swimmer profile
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	// Get optional course_type filter
+	var courseType *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+
+	analysis, err := h.reactionService.GetAnalysis(ctx, sw.ID, courseType)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get reaction times")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, analysis)
+}
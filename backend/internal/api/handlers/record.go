@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/record"
+	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// RecordHandler handles record table API requests.
+type RecordHandler struct {
+	service        *record.Service
+	swimmerService *swimmer.Service
+	logger         *slog.Logger
+}
+
+// NewRecordHandler creates a new record handler.
+func NewRecordHandler(service *record.Service, swimmerService *swimmer.Service, logger *slog.Logger) *RecordHandler {
+	return &RecordHandler{
+		service:        service,
+		swimmerService: swimmerService,
+		logger:         logger,
+	}
+}
+
+// ListRecordTables handles GET /records requests.
+func (h *RecordHandler) ListRecordTables(w http.ResponseWriter, r *http.Request) {
+	list, err := h.service.List(r.Context())
+	if err != nil {
+		middleware.WriteInternalError(w, h.logger, err, "failed to list record tables")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
+
+// GetRecordTable handles GET /records/{id} requests.
+func (h *RecordHandler) GetRecordTable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid record table ID", "INVALID_INPUT")
+		return
+	}
+
+	table, err := h.service.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "record table not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get record table")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, table)
+}
+
+// DeleteRecordTable handles DELETE /records/{id} requests.
+func (h *RecordHandler) DeleteRecordTable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid record table ID", "INVALID_INPUT")
+		return
+	}
+
+	if err := h.service.Delete(ctx, id); err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "record table not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to delete record table")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ImportRecords handles POST /records/import requests with a JSON body.
+func (h *RecordHandler) ImportRecords(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	var input record.ImportInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	h.importRecords(w, r, input)
+}
+
+// ImportRecordsCSV handles POST /records/import/csv requests. The body is a
+// CSV file of records and the table is described by query parameters:
+//   - name (required): the record table name
+//   - scope (required): "club", "provincial" or "national"
+//   - description (optional)
+func (h *RecordHandler) ImportRecordsCSV(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	records, err := record.ParseCSV(r.Body)
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+		return
+	}
+
+	query := r.URL.Query()
+	h.importRecords(w, r, record.ImportInput{
+		Name:        query.Get("name"),
+		Scope:       query.Get("scope"),
+		Description: query.Get("description"),
+		Records:     records,
+	})
+}
+
+func (h *RecordHandler) importRecords(w http.ResponseWriter, r *http.Request, input record.ImportInput) {
+	result, err := h.service.Import(r.Context(), input)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to import records")
+		return
+	}
+
+	status := http.StatusCreated
+	if result.Replaced {
+		status = http.StatusOK
+	}
+	middleware.WriteJSON(w, status, result)
+}
+
+// CompareRecords handles GET /records/comparison requests.
+// Query parameters:
+//   - course_type (optional): "25m", "50m" or "open_water", defaults to all courses
+//   - table_id (optional): limits the comparison to one record table
+func (h *RecordHandler) CompareRecords(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var courseType *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+
+	var tableID *uuid.UUID
+	if idStr := r.URL.Query().Get("table_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "invalid table_id", "INVALID_INPUT")
+			return
+		}
+		tableID = &id
+	}
+
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	comparison, err := h.service.Compare(ctx, sw.ID, courseType, tableID)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "record table not found", "NOT_FOUND")
+			return
+		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to compare records")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, comparison)
+}
@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/importer"
+	"github.com/bpg/swimstats/backend/internal/domain/reference"
+	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// ReferenceHandler handles reference swimmer API requests.
+type ReferenceHandler struct {
+	service        *reference.Service
+	swimmerService *swimmer.Service
+	logger         *slog.Logger
+}
+
+// NewReferenceHandler creates a new reference swimmer handler.
+func NewReferenceHandler(service *reference.Service, swimmerService *swimmer.Service, logger *slog.Logger) *ReferenceHandler {
+	return &ReferenceHandler{
+		service:        service,
+		swimmerService: swimmerService,
+		logger:         logger,
+	}
+}
+
+// ListReferenceSwimmers handles GET /reference-swimmers requests.
+func (h *ReferenceHandler) ListReferenceSwimmers(w http.ResponseWriter, r *http.Request) {
+	list, err := h.service.List(r.Context())
+	if err != nil {
+		middleware.WriteInternalError(w, h.logger, err, "failed to list reference swimmers")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
+
+// GetReferenceSwimmer handles GET /reference-swimmers/{id} requests.
+func (h *ReferenceHandler) GetReferenceSwimmer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid reference swimmer ID", "INVALID_INPUT")
+		return
+	}
+
+	ref, err := h.service.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "reference swimmer not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get reference swimmer")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, ref)
+}
+
+// DeleteReferenceSwimmer handles DELETE /reference-swimmers/{id} requests.
+func (h *ReferenceHandler) DeleteReferenceSwimmer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid reference swimmer ID", "INVALID_INPUT")
+		return
+	}
+
+	if err := h.service.Delete(ctx, id); err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "reference swimmer not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to delete reference swimmer")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ImportReferenceSwimmer handles POST /reference-swimmers/import requests.
+// The body is a JSON export file. Query parameters:
+//   - name (optional): the reference swimmer's name, defaults to the name in the file
+//   - description (optional)
+func (h *ReferenceHandler) ImportReferenceSwimmer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	var data importer.ImportData
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	query := r.URL.Query()
+	result, err := h.service.Import(ctx, &data, query.Get("name"), query.Get("description"))
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to import reference swimmer")
+		return
+	}
+
+	status := http.StatusCreated
+	if result.Replaced {
+		status = http.StatusOK
+	}
+	middleware.WriteJSON(w, status, result)
+}
+
+// CompareReferenceSwimmer handles GET /reference-swimmers/{id}/comparison requests.
+// Query parameters:
+//   - course_type (optional): "25m", "50m" or "open_water", defaults to "25m"
+//   - age (optional): age at competition for the equal-age comparison, defaults
+//     to the swimmer's current age
+func (h *ReferenceHandler) CompareReferenceSwimmer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid reference swimmer ID", "INVALID_INPUT")
+		return
+	}
+
+	courseType := r.URL.Query().Get("course_type")
+	if courseType == "" {
+		courseType = "25m"
+	}
+
+	var age *int
+	if ageStr := r.URL.Query().Get("age"); ageStr != "" {
+		a, err := strconv.Atoi(ageStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "invalid age", "INVALID_INPUT")
+			return
+		}
+		age = &a
+	}
+
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	result, err := h.service.Compare(ctx, sw.ID, id, courseType, age)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "reference swimmer not found", "NOT_FOUND")
+			return
+		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to compare with reference swimmer")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, result)
+}
@@ -39,6 +39,15 @@ func (h *StandardHandler) ListStandards(w http.ResponseWriter, r *http.Request)
 		params.Gender = &gender
 	}
 
+	if category := r.URL.Query().Get("category"); category != "" {
+		params.Category = &category
+	}
+
+	// Standards that apply to a swimmer: same gender plus mixed standards
+	if swimmerGender := r.URL.Query().Get("swimmer_gender"); swimmerGender != "" {
+		params.SwimmerGender = &swimmerGender
+	}
+
 	list, err := h.service.List(ctx, params)
 	if err != nil {
 		middleware.WriteInternalError(w, h.logger, err, "failed to list standards")
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/comparison"
+	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// StrengthHandler handles event strength profile API requests.
+type StrengthHandler struct {
+	strengthService *comparison.StrengthService
+	swimmerService  *swimmer.Service
+	logger          *slog.Logger
+}
+
+// NewStrengthHandler creates a new strength handler.
+func NewStrengthHandler(strengthService *comparison.StrengthService, swimmerService *swimmer.Service, logger *slog.Logger) *StrengthHandler {
+	return &StrengthHandler{
+		strengthService: strengthService,
+		swimmerService:  swimmerService,
+		logger:          logger,
+	}
+}
+
+// GetStrengthProfile handles GET /analytics/strength requests.
+// Query parameters:
+//   - course_type (optional): "25m", "50m" or "open_water", defaults to "25m"
+//   - method (optional): "standard" or "points", defaults to "standard" with a
+//     standard_id and "points" otherwise
+//   - standard_id (optional): UUID of the reference standard
+func (h *StrengthHandler) GetStrengthProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	courseType := r.URL.Query().Get("course_type")
+	if courseType == "" {
+		courseType = "25m"
+	}
+
+	var method *comparison.StrengthMethod
+	if m := r.URL.Query().Get("method"); m != "" {
+		sm := comparison.StrengthMethod(m)
+		method = &sm
+	}
+
+	var standardID *uuid.UUID
+	if idStr := r.URL.Query().Get("standard_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "invalid standard_id", "INVALID_INPUT")
+			return
+		}
+		standardID = &id
+	}
+
+	profile, err := h.strengthService.GetProfile(ctx, sw.ID, courseType, method, standardID)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "standard not found", "NOT_FOUND")
+			return
+		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get strength profile")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, profile)
+}
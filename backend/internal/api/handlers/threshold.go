@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/threshold"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// ThresholdHandler handles "almost there" threshold API requests.
+type ThresholdHandler struct {
+	service *threshold.Service
+	logger  *slog.Logger
+}
+
+// NewThresholdHandler creates a new threshold handler.
+func NewThresholdHandler(service *threshold.Service, logger *slog.Logger) *ThresholdHandler {
+	return &ThresholdHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ListThresholds handles GET /thresholds requests.
+func (h *ThresholdHandler) ListThresholds(w http.ResponseWriter, r *http.Request) {
+	list, err := h.service.List(r.Context())
+	if err != nil {
+		middleware.WriteInternalError(w, h.logger, err, "failed to list thresholds")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
+
+// GetThreshold handles GET /thresholds/{id} requests.
+func (h *ThresholdHandler) GetThreshold(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid threshold ID", "INVALID_INPUT")
+		return
+	}
+
+	t, err := h.service.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "threshold not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get threshold")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, t)
+}
+
+// CreateThreshold handles POST /thresholds requests.
+func (h *ThresholdHandler) CreateThreshold(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	var input threshold.Input
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	t, err := h.service.Create(ctx, input)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to create threshold")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, t)
+}
+
+// UpdateThreshold handles PUT /thresholds/{id} requests.
+func (h *ThresholdHandler) UpdateThreshold(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid threshold ID", "INVALID_INPUT")
+		return
+	}
+
+	var input threshold.Input
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	t, err := h.service.Update(ctx, id, input)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "threshold not found", "NOT_FOUND")
+			return
+		}
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to update threshold")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, t)
+}
+
+// DeleteThreshold handles DELETE /thresholds/{id} requests.
+func (h *ThresholdHandler) DeleteThreshold(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid threshold ID", "INVALID_INPUT")
+		return
+	}
+
+	if err := h.service.Delete(ctx, id); err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "threshold not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to delete threshold")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
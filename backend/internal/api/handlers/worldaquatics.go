@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/bpg/swimstats/backend/internal/api/middleware"
+	"github.com/bpg/swimstats/backend/internal/domain/comparison"
+	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// WorldAquaticsPointsHandler handles World Aquatics points API requests.
+type WorldAquaticsPointsHandler struct {
+	pointsService  *comparison.WorldAquaticsPointsService
+	swimmerService *swimmer.Service
+	logger         *slog.Logger
+}
+
+// NewWorldAquaticsPointsHandler creates a new World Aquatics points handler.
+func NewWorldAquaticsPointsHandler(pointsService *comparison.WorldAquaticsPointsService, swimmerService *swimmer.Service, logger *slog.Logger) *WorldAquaticsPointsHandler {
+	return &WorldAquaticsPointsHandler{
+		pointsService:  pointsService,
+		swimmerService: swimmerService,
+		logger:         logger,
+	}
+}
+
+// RankEvents handles GET /points requests.
+// Query parameters:
+//   - course_type (optional): limits the ranking to one course, defaults to both pool courses
+func (h *WorldAquaticsPointsHandler) RankEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Get swimmer profile
+	sw, err := h.swimmerService.Get(ctx)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, "swimmer profile not found", "NOT_FOUND")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to get swimmer")
+		return
+	}
+
+	var courseType *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+
+	ranking, err := h.pointsService.RankEvents(ctx, sw.ID, courseType)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to rank events by points")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, ranking)
+}
+
+// ListBaseTimes handles GET /points/base-times requests.
+func (h *WorldAquaticsPointsHandler) ListBaseTimes(w http.ResponseWriter, r *http.Request) {
+	var courseType, gender *string
+	if ct := r.URL.Query().Get("course_type"); ct != "" {
+		courseType = &ct
+	}
+	if g := r.URL.Query().Get("gender"); g != "" {
+		gender = &g
+	}
+
+	list, err := h.pointsService.ListBaseTimes(r.Context(), courseType, gender)
+	if err != nil {
+		middleware.WriteInternalError(w, h.logger, err, "failed to list World Aquatics base times")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
+
+// ImportBaseTimes handles POST /points/base-times requests.
+func (h *WorldAquaticsPointsHandler) ImportBaseTimes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Check write access
+	user := middleware.GetUser(ctx)
+	if user != nil && !user.AccessLevel.CanWrite() {
+		middleware.WriteError(w, http.StatusForbidden, "write access required", "FORBIDDEN")
+		return
+	}
+
+	var input comparison.WorldAquaticsBaseTimeInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "invalid request body", "INVALID_INPUT")
+		return
+	}
+
+	list, err := h.pointsService.ImportBaseTimes(ctx, input)
+	if err != nil {
+		if isValidationError(err) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error(), "VALIDATION_ERROR")
+			return
+		}
+		middleware.WriteInternalError(w, h.logger, err, "failed to import World Aquatics base times")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, list)
+}
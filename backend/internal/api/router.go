@@ -2,6 +2,8 @@
 package api
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
 
@@ -12,11 +14,19 @@ import (
 	"github.com/bpg/swimstats/backend/internal/api/middleware"
 	"github.com/bpg/swimstats/backend/internal/auth"
 	"github.com/bpg/swimstats/backend/internal/domain/comparison"
+	"github.com/bpg/swimstats/backend/internal/domain/event"
 	"github.com/bpg/swimstats/backend/internal/domain/exporter"
+	"github.com/bpg/swimstats/backend/internal/domain/goal"
 	"github.com/bpg/swimstats/backend/internal/domain/importer"
+	"github.com/bpg/swimstats/backend/internal/domain/ladder"
 	"github.com/bpg/swimstats/backend/internal/domain/meet"
+	"github.com/bpg/swimstats/backend/internal/domain/pointstable"
+	"github.com/bpg/swimstats/backend/internal/domain/ranking"
+	"github.com/bpg/swimstats/backend/internal/domain/record"
+	"github.com/bpg/swimstats/backend/internal/domain/reference"
 	"github.com/bpg/swimstats/backend/internal/domain/standard"
 	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
+	"github.com/bpg/swimstats/backend/internal/domain/threshold"
 	timeservice "github.com/bpg/swimstats/backend/internal/domain/time"
 	"github.com/bpg/swimstats/backend/internal/store/db"
 	"github.com/bpg/swimstats/backend/internal/store/postgres"
@@ -35,9 +45,24 @@ type Router struct {
 	pbService         *comparison.PersonalBestService
 	comparisonService *comparison.ComparisonService
 	progressService   *comparison.ProgressService
+	reactionService   *comparison.ReactionTimeService
+	paraService       *comparison.ParaPointsService
+	pointsService     *comparison.WorldAquaticsPointsService
+	tableService      *pointstable.Service
 	standardService   *standard.Service
 	importService     *importer.Service
 	exportService     *exporter.Service
+	eventService      *event.Service
+	ladderService     *ladder.Service
+	ladderProgress    *comparison.LadderProgressService
+	thresholdService  *threshold.Service
+	goalService       *goal.Service
+	goalTracker       *comparison.GoalTracker
+	strengthService   *comparison.StrengthService
+	recordService     *record.Service
+	rankingService    *ranking.Service
+	rankingCompare    *comparison.RankingComparisonService
+	referenceService  *reference.Service
 
 	// Handlers
 	authHandler       *handlers.AuthHandler
@@ -47,13 +72,26 @@ type Router struct {
 	pbHandler         *handlers.PersonalBestHandler
 	comparisonHandler *handlers.ComparisonHandler
 	progressHandler   *handlers.ProgressHandler
+	reactionHandler   *handlers.ReactionTimeHandler
+	paraHandler       *handlers.ParaPointsHandler
+	pointsHandler     *handlers.WorldAquaticsPointsHandler
+	tableHandler      *handlers.PointsTableHandler
 	standardHandler   *handlers.StandardHandler
 	importHandler     *handlers.ImportHandler
 	exportHandler     *handlers.ExportHandler
+	eventHandler      *handlers.EventHandler
+	ladderHandler     *handlers.LadderHandler
+	thresholdHandler  *handlers.ThresholdHandler
+	goalHandler       *handlers.GoalHandler
+	strengthHandler   *handlers.StrengthHandler
+	recordHandler     *handlers.RecordHandler
+	rankingHandler    *handlers.RankingHandler
+	referenceHandler  *handlers.ReferenceHandler
 }
 
-// NewRouter creates a new API router with all dependencies.
-func NewRouter(logger *slog.Logger, authProvider *auth.Provider, pool *pgxpool.Pool) *Router {
+// NewRouter creates a new API router with all dependencies. It fails when the
+// event catalogue cannot be loaded, as every event would be rejected without it.
+func NewRouter(logger *slog.Logger, authProvider *auth.Provider, pool *pgxpool.Pool) (*Router, error) {
 	// Create queries instance
 	queries := db.New(pool)
 
@@ -62,17 +100,52 @@ func NewRouter(logger *slog.Logger, authProvider *auth.Provider, pool *pgxpool.P
 	meetRepo := postgres.NewMeetRepository(queries)
 	timeRepo := postgres.NewTimeRepository(queries)
 	standardRepo := postgres.NewStandardRepository(queries)
+	eventRepo := postgres.NewEventRepository(queries)
+	paraBaseTimeRepo := postgres.NewParaBaseTimeRepository(queries, pool)
+	waBaseTimeRepo := postgres.NewWorldAquaticsBaseTimeRepository(queries, pool)
+	ladderRepo := postgres.NewLadderRepository(queries)
+	thresholdRepo := postgres.NewThresholdRepository(queries)
+	goalRepo := postgres.NewGoalRepository(queries)
+	recordRepo := postgres.NewRecordRepository(queries, pool)
+	rankingRepo := postgres.NewRankingRepository(queries)
+	referenceRepo := postgres.NewReferenceRepository(queries, pool)
 
 	// Create services
 	swimmerService := swimmer.NewService(swimmerRepo)
 	meetService := meet.NewService(meetRepo)
-	timeService := timeservice.NewService(timeRepo, meetRepo)
-	pbService := comparison.NewPersonalBestService(timeRepo)
-	comparisonService := comparison.NewComparisonService(timeRepo, standardRepo, swimmerRepo)
-	progressService := comparison.NewProgressService(timeRepo)
+	timeService := timeservice.NewService(timeRepo, meetRepo, swimmerRepo, logger)
+	pbService := comparison.NewPersonalBestService(timeRepo, swimmerRepo)
+	comparisonService := comparison.NewComparisonService(timeRepo, standardRepo, swimmerRepo, thresholdRepo)
+	progressService := comparison.NewProgressService(timeRepo, swimmerRepo)
+	reactionService := comparison.NewReactionTimeService(timeRepo)
+	paraService := comparison.NewParaPointsService(timeRepo, swimmerRepo, paraBaseTimeRepo)
+	pointsService := comparison.NewWorldAquaticsPointsService(timeRepo, swimmerRepo, waBaseTimeRepo)
+	tableService := pointstable.NewService(timeRepo, swimmerRepo)
 	standardService := standard.NewService(standardRepo)
 	importService := importer.NewService(swimmerService, meetService, timeService, standardService)
 	exportService := exporter.NewService(swimmerService, meetService, timeService, standardService)
+	eventService := event.NewService(eventRepo)
+	ladderService := ladder.NewService(ladderRepo, standardRepo)
+	ladderProgress := comparison.NewLadderProgressService(comparisonService, ladderService)
+	thresholdService := threshold.NewService(thresholdRepo, standardRepo)
+	goalService := goal.NewService(goalRepo, standardRepo, swimmerRepo)
+	goalTracker := comparison.NewGoalTracker(comparisonService, goalService)
+	strengthService := comparison.NewStrengthService(comparisonService, pbService)
+	recordService := record.NewService(recordRepo, swimmerRepo, timeRepo)
+	rankingService := ranking.NewService(rankingRepo)
+	rankingCompare := comparison.NewRankingComparisonService(comparisonService, rankingService)
+	referenceService := reference.NewService(referenceRepo, swimmerRepo, timeRepo)
+
+	// Keep goal statuses current as times change
+	timeService.AddObserver(goalTracker)
+
+	// Mark new times that break or approach records
+	timeService.SetRecordChecker(recordService)
+
+	// Load the event catalogue used by validation and comparisons
+	if err := eventService.Load(context.Background()); err != nil {
+		return nil, fmt.Errorf("load event catalogue: %w", err)
+	}
 
 	// Create handlers
 	authHandler := handlers.NewAuthHandler(authProvider)
@@ -81,10 +154,22 @@ func NewRouter(logger *slog.Logger, authProvider *auth.Provider, pool *pgxpool.P
 	timeHandler := handlers.NewTimeHandler(timeService, swimmerService, logger)
 	pbHandler := handlers.NewPersonalBestHandler(pbService, swimmerService, logger)
 	comparisonHandler := handlers.NewComparisonHandler(comparisonService, swimmerService, logger)
-	progressHandler := handlers.NewProgressHandler(progressService, swimmerService, logger)
+	progressHandler := handlers.NewProgressHandler(progressService, comparisonService, swimmerService, logger)
+	reactionHandler := handlers.NewReactionTimeHandler(reactionService, swimmerService, logger)
+	paraHandler := handlers.NewParaPointsHandler(paraService, swimmerService, logger)
+	pointsHandler := handlers.NewWorldAquaticsPointsHandler(pointsService, swimmerService, logger)
+	tableHandler := handlers.NewPointsTableHandler(tableService, swimmerService, logger)
 	standardHandler := handlers.NewStandardHandler(standardService, logger)
 	importHandler := handlers.NewImportHandler(importService, logger)
 	exportHandler := handlers.NewExportHandler(exportService, logger)
+	eventHandler := handlers.NewEventHandler(eventService, logger)
+	ladderHandler := handlers.NewLadderHandler(ladderService, ladderProgress, swimmerService, logger)
+	thresholdHandler := handlers.NewThresholdHandler(thresholdService, logger)
+	goalHandler := handlers.NewGoalHandler(goalService, goalTracker, swimmerService, logger)
+	strengthHandler := handlers.NewStrengthHandler(strengthService, swimmerService, logger)
+	recordHandler := handlers.NewRecordHandler(recordService, swimmerService, logger)
+	rankingHandler := handlers.NewRankingHandler(rankingService, rankingCompare, swimmerService, logger)
+	referenceHandler := handlers.NewReferenceHandler(referenceService, swimmerService, logger)
 
 	return &Router{
 		logger:            logger,
@@ -96,9 +181,24 @@ func NewRouter(logger *slog.Logger, authProvider *auth.Provider, pool *pgxpool.P
 		pbService:         pbService,
 		comparisonService: comparisonService,
 		progressService:   progressService,
+		reactionService:   reactionService,
+		paraService:       paraService,
+		pointsService:     pointsService,
+		tableService:      tableService,
 		standardService:   standardService,
 		importService:     importService,
 		exportService:     exportService,
+		eventService:      eventService,
+		ladderService:     ladderService,
+		ladderProgress:    ladderProgress,
+		thresholdService:  thresholdService,
+		goalService:       goalService,
+		goalTracker:       goalTracker,
+		strengthService:   strengthService,
+		recordService:     recordService,
+		rankingService:    rankingService,
+		rankingCompare:    rankingCompare,
+		referenceService:  referenceService,
 		authHandler:       authHandler,
 		swimmerHandler:    swimmerHandler,
 		meetHandler:       meetHandler,
@@ -106,10 +206,22 @@ func NewRouter(logger *slog.Logger, authProvider *auth.Provider, pool *pgxpool.P
 		pbHandler:         pbHandler,
 		comparisonHandler: comparisonHandler,
 		progressHandler:   progressHandler,
+		reactionHandler:   reactionHandler,
+		paraHandler:       paraHandler,
+		pointsHandler:     pointsHandler,
+		tableHandler:      tableHandler,
 		standardHandler:   standardHandler,
 		importHandler:     importHandler,
 		exportHandler:     exportHandler,
-	}
+		eventHandler:      eventHandler,
+		ladderHandler:     ladderHandler,
+		thresholdHandler:  thresholdHandler,
+		goalHandler:       goalHandler,
+		strengthHandler:   strengthHandler,
+		recordHandler:     recordHandler,
+		rankingHandler:    rankingHandler,
+		referenceHandler:  referenceHandler,
+	}, nil
 }
 
 // Handler returns the configured HTTP handler with all routes.
@@ -161,6 +273,8 @@ func (rt *Router) Handler() http.Handler {
 
 			// Personal Bests
 			r.Get("/personal-bests", rt.pbHandler.GetPersonalBests)
+			r.Get("/personal-bests/history", rt.pbHandler.GetPersonalBestHistory)
+			r.Get("/personal-bests/age-groups", rt.pbHandler.GetAgeGroupBests)
 
 			// Standards
 			r.Get("/standards", rt.standardHandler.ListStandards)
@@ -172,11 +286,88 @@ func (rt *Router) Handler() http.Handler {
 			r.Delete("/standards/{id}", rt.standardHandler.DeleteStandard)
 			r.Put("/standards/{id}/times", rt.standardHandler.SetStandardTimes)
 
+			// Standard ladders
+			r.Get("/ladders", rt.ladderHandler.ListLadders)
+			r.Post("/ladders", rt.ladderHandler.CreateLadder)
+			r.Get("/ladders/{id}", rt.ladderHandler.GetLadder)
+			r.Put("/ladders/{id}", rt.ladderHandler.UpdateLadder)
+			r.Delete("/ladders/{id}", rt.ladderHandler.DeleteLadder)
+			r.Get("/ladders/{id}/progress", rt.ladderHandler.GetLadderProgress)
+
+			// Event catalogue
+			r.Get("/events", rt.eventHandler.ListEvents)
+			r.Post("/events", rt.eventHandler.CreateEvent)
+
 			// Comparisons
 			r.Get("/comparisons", rt.comparisonHandler.GetComparison)
+			r.Get("/comparisons/matrix", rt.comparisonHandler.GetComparisonMatrix)
+			r.Get("/comparisons/age-up", rt.comparisonHandler.GetAgeUpPlan)
+			r.Get("/comparisons/rankings", rt.rankingHandler.CompareRankings)
+			r.Get("/achievements", rt.comparisonHandler.GetAchievements)
+
+			// "Almost there" thresholds
+			r.Get("/thresholds", rt.thresholdHandler.ListThresholds)
+			r.Post("/thresholds", rt.thresholdHandler.CreateThreshold)
+			r.Get("/thresholds/{id}", rt.thresholdHandler.GetThreshold)
+			r.Put("/thresholds/{id}", rt.thresholdHandler.UpdateThreshold)
+			r.Delete("/thresholds/{id}", rt.thresholdHandler.DeleteThreshold)
+
+			// Goals
+			r.Get("/goals", rt.goalHandler.ListGoals)
+			r.Post("/goals", rt.goalHandler.CreateGoal)
+			r.Get("/goals/{id}", rt.goalHandler.GetGoal)
+			r.Put("/goals/{id}", rt.goalHandler.UpdateGoal)
+			r.Delete("/goals/{id}", rt.goalHandler.DeleteGoal)
+
+			// Records
+			r.Get("/records", rt.recordHandler.ListRecordTables)
+			r.Post("/records/import", rt.recordHandler.ImportRecords)
+			r.Post("/records/import/csv", rt.recordHandler.ImportRecordsCSV)
+			r.Get("/records/comparison", rt.recordHandler.CompareRecords)
+			r.Get("/records/{id}", rt.recordHandler.GetRecordTable)
+			r.Delete("/records/{id}", rt.recordHandler.DeleteRecordTable)
+
+			// Ranking snapshots
+			r.Get("/rankings", rt.rankingHandler.ListRankings)
+			r.Post("/rankings/import", rt.rankingHandler.ImportRanking)
+			r.Post("/rankings/import/csv", rt.rankingHandler.ImportRankingCSV)
+			r.Get("/rankings/{id}", rt.rankingHandler.GetRanking)
+			r.Delete("/rankings/{id}", rt.rankingHandler.DeleteRanking)
+
+			// Reference swimmers
+			r.Get("/reference-swimmers", rt.referenceHandler.ListReferenceSwimmers)
+			r.Post("/reference-swimmers/import", rt.referenceHandler.ImportReferenceSwimmer)
+			r.Get("/reference-swimmers/{id}", rt.referenceHandler.GetReferenceSwimmer)
+			r.Delete("/reference-swimmers/{id}", rt.referenceHandler.DeleteReferenceSwimmer)
+			r.Get("/reference-swimmers/{id}/comparison", rt.referenceHandler.CompareReferenceSwimmer)
 
 			// Progress
 			r.Get("/progress/{event}", rt.progressHandler.GetProgressData)
+			r.Get("/progress/{event}/prediction", rt.progressHandler.GetPrediction)
+
+			// Event strength profile
+			r.Get("/analytics/strength", rt.strengthHandler.GetStrengthProfile)
+
+			// Improvement statistics
+			r.Get("/analytics/improvement", rt.progressHandler.GetImprovementStatistics)
+
+			// Reaction time analysis
+			r.Get("/reaction-times", rt.reactionHandler.GetReactionTimes)
+
+			// Para points
+			r.Get("/para-points", rt.paraHandler.GetParaPoints)
+			r.Get("/para-points/base-times", rt.paraHandler.ListBaseTimes)
+			r.Post("/para-points/base-times", rt.paraHandler.ImportBaseTimes)
+
+			// World Aquatics points
+			r.Get("/points", rt.pointsHandler.RankEvents)
+			r.Get("/points/base-times", rt.pointsHandler.ListBaseTimes)
+			r.Post("/points/base-times", rt.pointsHandler.ImportBaseTimes)
+
+			// Age-group points tables
+			r.Get("/points-tables", rt.tableHandler.ListTables)
+			r.Get("/points-tables/{name}/times", rt.tableHandler.ScoreTimes)
+			r.Get("/points-tables/{name}/profile", rt.tableHandler.GetProfile)
 
 			// Data export/import
 			r.Get("/data/export", rt.exportHandler.ExportAllData)
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Category is the competition category a swimmer or standard belongs to.
+type Category string
+
+const (
+	CategoryAgeGroup Category = "age_group"
+	CategoryMasters  Category = "masters"
+)
+
+// IsValid checks if the category is valid.
+func (c Category) IsValid() bool {
+	switch c {
+	case CategoryAgeGroup, CategoryMasters:
+		return true
+	default:
+		return false
+	}
+}
+
+// AgeScheme returns the age grouping used by the category.
+func (c Category) AgeScheme() AgeScheme {
+	if c == CategoryMasters {
+		return MastersScheme{}
+	}
+	return YouthScheme{}
+}
+
+// AgeScheme maps a swimmer's age to the age groups of a competition category.
+type AgeScheme interface {
+	// AgeGroupAt returns the age group a swimmer belongs to on the given date.
+	AgeGroupAt(birthDate, date time.Time) AgeGroup
+	// IsValid reports whether the age group belongs to the scheme.
+	IsValid(ag AgeGroup) bool
+	// Previous returns the age group before ag, or empty string if none.
+	Previous(ag AgeGroup) AgeGroup
+	// Next returns the age group after ag, or empty string if none.
+	Next(ag AgeGroup) AgeGroup
+}
+
+// YouthScheme is the Swimming Canada age group scheme (10U through OPEN).
+type YouthScheme struct{}
+
+// AgeGroupAt uses the swimmer's actual age on the date.
+func (YouthScheme) AgeGroupAt(birthDate, date time.Time) AgeGroup {
+	return AgeGroupFromAge(AgeAtDate(birthDate, date))
+}
+
+// IsValid reports whether ag is a youth age group.
+func (YouthScheme) IsValid(ag AgeGroup) bool {
+	switch ag {
+	case AgeGroup10U, AgeGroup11_12, AgeGroup13_14, AgeGroup15_17, AgeGroupOpen:
+		return true
+	default:
+		return false
+	}
+}
+
+// Previous returns the youth age group before ag.
+func (YouthScheme) Previous(ag AgeGroup) AgeGroup {
+	return PreviousAgeGroup(ag)
+}
+
+// Next returns the youth age group after ag.
+func (YouthScheme) Next(ag AgeGroup) AgeGroup {
+	return NextAgeGroup(ag)
+}
+
+// Masters bands are five years wide, starting at 18-24 (a seven-year band)
+// and continuing 25-29, 30-34, ... up to 100-104.
+const (
+	MastersMinAge      = 18
+	mastersFirstBandTo = 24
+	mastersBandWidth   = 5
+	mastersMaxBandFrom = 100
+)
+
+// MastersScheme groups swimmers into masters age bands using their
+// age as of December 31 of the competition year.
+type MastersScheme struct{}
+
+// AgeGroupAt returns the masters band for the swimmer's age as of December 31.
+// Swimmers younger than 18 have no masters band and get an empty string.
+func (MastersScheme) AgeGroupAt(birthDate, date time.Time) AgeGroup {
+	return MastersAgeGroupFromAge(AgeAtCompetition(birthDate, date))
+}
+
+// IsValid reports whether ag is a masters band.
+func (MastersScheme) IsValid(ag AgeGroup) bool {
+	from, ok := mastersBandStart(ag)
+	return ok && MastersAgeGroupFromAge(from) == ag
+}
+
+// Previous returns the masters band before ag.
+func (MastersScheme) Previous(ag AgeGroup) AgeGroup {
+	from, ok := mastersBandStart(ag)
+	if !ok || from <= MastersMinAge {
+		return ""
+	}
+	return MastersAgeGroupFromAge(from - 1)
+}
+
+// Next returns the masters band after ag.
+func (MastersScheme) Next(ag AgeGroup) AgeGroup {
+	from, ok := mastersBandStart(ag)
+	if !ok || from >= mastersMaxBandFrom {
+		return ""
+	}
+	if from == MastersMinAge {
+		return MastersAgeGroupFromAge(mastersFirstBandTo + 1)
+	}
+	return MastersAgeGroupFromAge(from + mastersBandWidth)
+}
+
+// MastersAgeGroupFromAge determines the masters band for an age.
+func MastersAgeGroupFromAge(age int) AgeGroup {
+	switch {
+	case age < MastersMinAge:
+		return ""
+	case age <= mastersFirstBandTo:
+		return AgeGroup(fmt.Sprintf("%d-%d", MastersMinAge, mastersFirstBandTo))
+	}
+	from := age - age%mastersBandWidth
+	if from > mastersMaxBandFrom {
+		from = mastersMaxBandFrom
+	}
+	return AgeGroup(fmt.Sprintf("%d-%d", from, from+mastersBandWidth-1))
+}
+
+// mastersBandStart parses the lower bound of a masters band.
+func mastersBandStart(ag AgeGroup) (int, bool) {
+	var from, to int
+	if n, err := fmt.Sscanf(string(ag), "%d-%d", &from, &to); err != nil || n != 2 {
+		return 0, false
+	}
+	return from, true
+}
+
+// NextAgeUp returns the first date after from on which a swimmer moves into a
+// different age group of the scheme, and that age group. Ages change on
+// birthdays or, under an age-as-of-December-31 rule, on January 1, so only
+// those dates are checked. It returns false if the swimmer never moves up.
+func NextAgeUp(scheme AgeScheme, birthDate, from time.Time) (time.Time, AgeGroup, bool) {
+	current := scheme.AgeGroupAt(birthDate, from)
+	for year := from.Year(); year <= birthDate.Year()+maxAgeUpAge; year++ {
+		birthday := time.Date(year, birthDate.Month(), birthDate.Day(), 0, 0, 0, 0, time.UTC)
+		newYear := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		for _, date := range []time.Time{birthday, newYear} {
+			if !date.After(from) {
+				continue
+			}
+			if ag := scheme.AgeGroupAt(birthDate, date); ag != current && ag != "" {
+				return date, ag, true
+			}
+		}
+	}
+	return time.Time{}, "", false
+}
+
+// maxAgeUpAge bounds the search for the next age group.
+const maxAgeUpAge = 110
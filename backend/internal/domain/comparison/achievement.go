@@ -0,0 +1,249 @@
+package comparison
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// Achievement is the first swim that met a standard in an event, judged
+// against the standard time of the swimmer's age group on the day of the swim.
+type Achievement struct {
+	Event          string `json:"event"`
+	TimeID         string `json:"time_id"`
+	TimeMS         int    `json:"time_ms"`
+	TimeFormatted  string `json:"time_formatted"`
+	MeetName       string `json:"meet_name"`
+	Date           string `json:"date"`
+	AgeGroup       string `json:"age_group"`
+	StandardTimeMS int    `json:"standard_time_ms"`
+}
+
+// StandardAchievements lists the events in which a standard has been met,
+// in catalogue order.
+type StandardAchievements struct {
+	StandardID   uuid.UUID     `json:"standard_id"`
+	StandardName string        `json:"standard_name"`
+	CourseType   string        `json:"course_type"`
+	Achievements []Achievement `json:"achievements"`
+}
+
+// AchievementFeedItem is one entry in the chronological feed of achievements.
+type AchievementFeedItem struct {
+	StandardID   uuid.UUID `json:"standard_id"`
+	StandardName string    `json:"standard_name"`
+	CourseType   string    `json:"course_type"`
+	Achievement
+}
+
+// AchievementTimeline is the history of when each standard was first met.
+type AchievementTimeline struct {
+	SwimmerID  string                 `json:"swimmer_id"`
+	CourseType *string                `json:"course_type,omitempty"`
+	Standards  []StandardAchievements `json:"standards"`
+	Feed       []AchievementFeedItem  `json:"feed"`
+}
+
+// GetAchievements finds, for each standard and event, the first swim that met
+// the standard, judged by the age group that applied on the day of the swim.
+// Without standard IDs, every standard that applies to the swimmer's gender is
+// used, optionally limited to one course type. The feed lists all
+// achievements from the oldest to the most recent.
+func (s *ComparisonService) GetAchievements(ctx context.Context, swimmerID uuid.UUID, standardIDs []uuid.UUID, courseType *string) (*AchievementTimeline, error) {
+	if courseType != nil && !domain.CourseType(*courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", *courseType)
+	}
+
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+
+	standards, err := s.trackedStandards(ctx, swimmer, standardIDs, courseType, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.timeRepo.ListTimeHistory(ctx, swimmerID, courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+
+	timeline := &AchievementTimeline{
+		SwimmerID:  swimmerID.String(),
+		CourseType: courseType,
+		Standards:  make([]StandardAchievements, 0, len(standards)),
+		Feed:       []AchievementFeedItem{},
+	}
+	for i := range standards {
+		standard := &standards[i]
+		qualifying, err := s.loadQualifyingTimes(ctx, standard, swimmer)
+		if err != nil {
+			return nil, err
+		}
+
+		achieved := qualifying.firstAchievements(history, swimmer.BirthDate.Time)
+		entry := StandardAchievements{
+			StandardID:   standard.ID,
+			StandardName: standard.Name,
+			CourseType:   standard.CourseType,
+			Achievements: make([]Achievement, 0, len(achieved)),
+		}
+		for _, achievement := range achieved {
+			entry.Achievements = append(entry.Achievements, achievement)
+			timeline.Feed = append(timeline.Feed, AchievementFeedItem{
+				StandardID:   standard.ID,
+				StandardName: standard.Name,
+				CourseType:   standard.CourseType,
+				Achievement:  achievement,
+			})
+		}
+		sort.Slice(entry.Achievements, func(i, j int) bool {
+			return domain.EventSortOrder(entry.Achievements[i].Event) < domain.EventSortOrder(entry.Achievements[j].Event)
+		})
+		timeline.Standards = append(timeline.Standards, entry)
+	}
+
+	sort.Slice(timeline.Feed, func(i, j int) bool {
+		a, b := timeline.Feed[i], timeline.Feed[j]
+		if a.Date != b.Date {
+			return a.Date < b.Date
+		}
+		if oa, ob := domain.EventSortOrder(a.Event), domain.EventSortOrder(b.Event); oa != ob {
+			return oa < ob
+		}
+		return a.StandardName < b.StandardName
+	})
+
+	return timeline, nil
+}
+
+// trackedStandards returns the standards with the given IDs, or, without IDs,
+// every standard that applies to the swimmer's gender, optionally limited to a
+// course type and category. Requested standards must match both filters.
+func (s *ComparisonService) trackedStandards(ctx context.Context, swimmer *db.Swimmer, standardIDs []uuid.UUID, courseType, category *string) ([]db.TimeStandard, error) {
+	if len(standardIDs) == 0 {
+		standards, err := s.standardRepo.List(ctx, postgres.ListStandardsParams{
+			CourseType:    courseType,
+			Category:      category,
+			SwimmerGender: &swimmer.Gender,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list standards: %w", err)
+		}
+		return standards, nil
+	}
+
+	var standards []db.TimeStandard
+	seen := make(map[uuid.UUID]bool, len(standardIDs))
+	for _, id := range standardIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		standard, err := s.standardRepo.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("get standard: %w", err)
+		}
+		if !domain.Gender(standard.Gender).AppliesTo(domain.Gender(swimmer.Gender)) {
+			return nil, fmt.Errorf("validation: a %s standard does not apply to a %s swimmer", standard.Gender, swimmer.Gender)
+		}
+		if courseType != nil && standard.CourseType != *courseType {
+			return nil, fmt.Errorf("validation: standard %s is for %s course, not %s", standard.Name, standard.CourseType, *courseType)
+		}
+		if category != nil && standard.Category != *category {
+			return nil, fmt.Errorf("validation: standard %s is for the %s category, not %s", standard.Name, standard.Category, *category)
+		}
+		standards = append(standards, *standard)
+	}
+	return standards, nil
+}
+
+// qualifyingTimes holds a standard's qualifying times resolved for one swimmer.
+type qualifyingTimes struct {
+	standard *db.TimeStandard
+	scheme   domain.AgeScheme
+	times    map[string]map[string]int32
+	policy   domain.ManualTimePolicy
+
+	manualAdjustmentMS        int
+	semiAutomaticAdjustmentMS int
+}
+
+// loadQualifyingTimes loads the qualifying times of a standard for a swimmer.
+func (s *ComparisonService) loadQualifyingTimes(ctx context.Context, standard *db.TimeStandard, swimmer *db.Swimmer) (*qualifyingTimes, error) {
+	standardTimes, err := s.standardRepo.ListTimes(ctx, standard.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get standard times: %w", err)
+	}
+	return &qualifyingTimes{
+		standard:                  standard,
+		scheme:                    domain.Category(standard.Category).AgeScheme(),
+		times:                     buildStandardTimesMap(standardTimes, swimmerSportClasses(swimmer)),
+		policy:                    domain.ManualTimePolicy(standard.ManualTimePolicy),
+		manualAdjustmentMS:        int(swimmer.ManualAdjustmentMs),
+		semiAutomaticAdjustmentMS: int(swimmer.SemiAutomaticAdjustmentMs),
+	}, nil
+}
+
+// swimTime returns the time to compare against the standard, applying its
+// policy for manual and semi-automatic times. It returns false when the time
+// is not eligible for the standard.
+func (q *qualifyingTimes) swimTime(timeMS int, timingMethod string) (int, bool) {
+	method := domain.TimingMethod(timingMethod)
+	if method == domain.TimingElectronic {
+		return timeMS, true
+	}
+	switch q.policy {
+	case domain.ManualTimeIneligible:
+		return 0, false
+	case domain.ManualTimeAdjust:
+		if method == domain.TimingManual {
+			return timeMS + q.manualAdjustmentMS, true
+		}
+		return timeMS + q.semiAutomaticAdjustmentMS, true
+	default:
+		return timeMS, true
+	}
+}
+
+// firstAchievements finds, per event, the first swim in the chronological
+// history that met the standard for the swimmer's age group at the time.
+func (q *qualifyingTimes) firstAchievements(history []db.ListTimeHistoryRow, birthDate time.Time) map[string]Achievement {
+	achievements := make(map[string]Achievement)
+	for _, row := range history {
+		if _, done := achievements[row.Event]; done || !row.Date.Valid {
+			continue
+		}
+		if row.CourseType != q.standard.CourseType {
+			continue
+		}
+		swimTime, eligible := q.swimTime(int(row.TimeMs), row.TimingMethod)
+		if !eligible {
+			continue
+		}
+		ageGroup := string(q.scheme.AgeGroupAt(birthDate, row.Date.Time))
+		standardTime, usedAgeGroup, ok := getStandardTime(q.times, row.Event, ageGroup)
+		if !ok || swimTime > int(standardTime) {
+			continue
+		}
+		achievements[row.Event] = Achievement{
+			Event:          row.Event,
+			TimeID:         row.ID.String(),
+			TimeMS:         swimTime,
+			TimeFormatted:  domain.FormatTime(swimTime),
+			MeetName:       row.MeetName,
+			Date:           row.Date.Time.Format("2006-01-02"),
+			AgeGroup:       usedAgeGroup,
+			StandardTimeMS: int(standardTime),
+		}
+	}
+	return achievements
+}
@@ -0,0 +1,267 @@
+package comparison
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// improvementWindowMonths is the look-back used for the recent improvement rate.
+const improvementWindowMonths = 12
+
+// daysPerMonth converts day counts to months for improvement rates.
+const daysPerMonth = 365.25 / 12
+
+// AgeUpTarget is the cut a swimmer faces in an event after moving up an age group.
+// DropMS is the time the swimmer must drop to meet it; MonthsNeeded and
+// ProjectedTimeMS extrapolate the recent improvement rate, and OnTrack reports
+// whether the cut is met by the age-up date at that rate.
+type AgeUpTarget struct {
+	Rank                     int       `json:"rank"`
+	Event                    string    `json:"event"`
+	StandardID               uuid.UUID `json:"standard_id"`
+	StandardName             string    `json:"standard_name"`
+	CourseType               string    `json:"course_type"`
+	CurrentAgeGroup          *string   `json:"current_age_group,omitempty"`
+	CurrentStandardTimeMS    *int      `json:"current_standard_time_ms,omitempty"`
+	NewAgeGroup              string    `json:"new_age_group"`
+	NewStandardTimeMS        int       `json:"new_standard_time_ms"`
+	NewStandardTimeFormatted string    `json:"new_standard_time_formatted"`
+	SwimmerTimeMS            *int      `json:"swimmer_time_ms"`
+	SwimmerTimeFormatted     *string   `json:"swimmer_time_formatted"`
+	DropMS                   *int      `json:"drop_ms"`
+	DropFormatted            *string   `json:"drop_formatted"`
+	DropPercent              *float64  `json:"drop_percent"`
+	Achieved                 bool      `json:"achieved"`
+	MonthlyImprovementMS     *int      `json:"monthly_improvement_ms"`
+	ProjectedTimeMS          *int      `json:"projected_time_ms"`
+	MonthsNeeded             *float64  `json:"months_needed"`
+	OnTrack                  bool      `json:"on_track"`
+}
+
+// AgeUpPlan lists the new cuts a swimmer faces after the next age-up,
+// ranked from the most to the least achievable.
+type AgeUpPlan struct {
+	SwimmerName     string        `json:"swimmer_name"`
+	Category        string        `json:"category"`
+	CurrentAgeGroup string        `json:"current_age_group"`
+	NextAgeGroup    *string       `json:"next_age_group"`
+	AgeUpDate       *string       `json:"age_up_date"`
+	DaysUntilAgeUp  *int          `json:"days_until_age_up"`
+	Targets         []AgeUpTarget `json:"targets"`
+}
+
+// PlanAgeUp works out when the swimmer moves up an age group under the age
+// rule of their category, and lists for each event and tracked standard the
+// cut in the new age group and the drop required. Without standard IDs, every
+// standard of the swimmer's category that applies to their gender is tracked.
+// Targets are ranked by achievability: cuts already met first, then by the
+// months needed at the swimmer's improvement over the last year, then by the
+// relative drop.
+func (s *ComparisonService) PlanAgeUp(ctx context.Context, swimmerID uuid.UUID, standardIDs []uuid.UUID, courseType *string) (*AgeUpPlan, error) {
+	if courseType != nil && !domain.CourseType(*courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", *courseType)
+	}
+
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+
+	now := time.Now()
+	scheme := domain.Category(swimmer.Category).AgeScheme()
+	birthDate := swimmer.BirthDate.Time
+
+	plan := &AgeUpPlan{
+		SwimmerName:     swimmer.Name,
+		Category:        swimmer.Category,
+		CurrentAgeGroup: string(scheme.AgeGroupAt(birthDate, now)),
+		Targets:         []AgeUpTarget{},
+	}
+
+	ageUpDate, nextAgeGroup, ok := domain.NextAgeUp(scheme, birthDate, now)
+	if !ok {
+		return plan, nil
+	}
+	next := string(nextAgeGroup)
+	date := ageUpDate.Format("2006-01-02")
+	days := int(math.Ceil(ageUpDate.Sub(now).Hours() / 24))
+	plan.NextAgeGroup = &next
+	plan.AgeUpDate = &date
+	plan.DaysUntilAgeUp = &days
+	monthsUntilAgeUp := float64(days) / daysPerMonth
+
+	standards, err := s.trackedStandards(ctx, swimmer, standardIDs, courseType, &swimmer.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.timeRepo.ListTimeHistory(ctx, swimmerID, courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+	rates := improvementRates(history, now)
+
+	for i := range standards {
+		standard := &standards[i]
+		qualifying, err := s.loadQualifyingTimes(ctx, standard, swimmer)
+		if err != nil {
+			return nil, err
+		}
+
+		pbs, err := s.timeRepo.GetAdjustedPersonalBests(ctx, personalBestParams(standard, swimmer, standard.CourseType))
+		if err != nil {
+			return nil, fmt.Errorf("get personal bests: %w", err)
+		}
+		pbMap := make(map[string]db.GetAdjustedPersonalBestsRow, len(pbs))
+		for _, pb := range pbs {
+			pbMap[pb.Event] = pb
+		}
+
+		for _, event := range domain.EventsForCourse(domain.CourseType(standard.CourseType)) {
+			code := string(event.Code)
+			newTime, newAgeGroup, hasStandard := getStandardTime(qualifying.times, code, next)
+			if !hasStandard {
+				continue
+			}
+
+			target := AgeUpTarget{
+				Event:                    code,
+				StandardID:               standard.ID,
+				StandardName:             standard.Name,
+				CourseType:               standard.CourseType,
+				NewAgeGroup:              newAgeGroup,
+				NewStandardTimeMS:        int(newTime),
+				NewStandardTimeFormatted: domain.FormatTime(int(newTime)),
+			}
+			if currentTime, currentAgeGroup, ok := getStandardTime(qualifying.times, code, plan.CurrentAgeGroup); ok {
+				currentMS := int(currentTime)
+				target.CurrentAgeGroup = &currentAgeGroup
+				target.CurrentStandardTimeMS = &currentMS
+			}
+
+			if pb, ok := pbMap[code]; ok {
+				swimmerTime := int(pb.AdjustedTimeMs)
+				swimmerTimeFormatted := domain.FormatTime(swimmerTime)
+				target.SwimmerTimeMS = &swimmerTime
+				target.SwimmerTimeFormatted = &swimmerTimeFormatted
+
+				drop := swimmerTime - int(newTime)
+				dropFormatted := domain.FormatDifference(drop)
+				dropPercent := float64(drop) / float64(swimmerTime) * 100
+				target.DropMS = &drop
+				target.DropFormatted = &dropFormatted
+				target.DropPercent = &dropPercent
+				target.Achieved = drop <= 0
+
+				if rate, ok := rates[standard.CourseType+"|"+code]; ok {
+					monthly := int(math.Round(rate))
+					projected := swimmerTime - int(math.Round(rate*monthsUntilAgeUp))
+					target.MonthlyImprovementMS = &monthly
+					target.ProjectedTimeMS = &projected
+					if !target.Achieved && rate > 0 {
+						months := float64(drop) / rate
+						target.MonthsNeeded = &months
+					}
+				}
+				target.OnTrack = target.Achieved || (target.MonthsNeeded != nil && *target.MonthsNeeded <= monthsUntilAgeUp)
+			}
+
+			plan.Targets = append(plan.Targets, target)
+		}
+	}
+
+	sort.SliceStable(plan.Targets, func(i, j int) bool {
+		return moreAchievable(plan.Targets[i], plan.Targets[j])
+	})
+	for i := range plan.Targets {
+		plan.Targets[i].Rank = i + 1
+	}
+
+	return plan, nil
+}
+
+// moreAchievable orders age-up targets: met cuts, then cuts with a projected
+// number of months (fewest first), then the rest by relative drop; targets
+// without a time come last.
+func moreAchievable(a, b AgeUpTarget) bool {
+	if (a.DropMS == nil) != (b.DropMS == nil) {
+		return a.DropMS != nil
+	}
+	if a.DropMS == nil {
+		return domain.EventSortOrder(a.Event) < domain.EventSortOrder(b.Event)
+	}
+	if a.Achieved != b.Achieved {
+		return a.Achieved
+	}
+	if !a.Achieved {
+		if (a.MonthsNeeded == nil) != (b.MonthsNeeded == nil) {
+			return a.MonthsNeeded != nil
+		}
+		if a.MonthsNeeded != nil && *a.MonthsNeeded != *b.MonthsNeeded {
+			return *a.MonthsNeeded < *b.MonthsNeeded
+		}
+	}
+	return *a.DropPercent < *b.DropPercent
+}
+
+// improvementRates estimates, per course and event, how many milliseconds the
+// swimmer has dropped per month: the best time before the look-back window
+// (or the first swim, if the swimmer started within it) against the best time
+// now. Events with a single swim or no elapsed time have no rate; rates never
+// go below zero.
+func improvementRates(history []db.ListTimeHistoryRow, now time.Time) map[string]float64 {
+	windowStart := now.AddDate(0, -improvementWindowMonths, 0)
+
+	type eventHistory struct {
+		first     db.ListTimeHistoryRow
+		baseline  int32
+		hasBase   bool
+		best      int32
+		swimCount int
+	}
+	events := make(map[string]*eventHistory)
+	for _, row := range history {
+		if !row.Date.Valid || row.Date.Time.After(now) {
+			continue
+		}
+		key := row.CourseType + "|" + row.Event
+		h, ok := events[key]
+		if !ok {
+			h = &eventHistory{first: row, best: row.TimeMs}
+			events[key] = h
+		}
+		h.swimCount++
+		if row.TimeMs < h.best {
+			h.best = row.TimeMs
+		}
+		if row.Date.Time.Before(windowStart) && (!h.hasBase || row.TimeMs < h.baseline) {
+			h.baseline = row.TimeMs
+			h.hasBase = true
+		}
+	}
+
+	rates := make(map[string]float64, len(events))
+	for key, h := range events {
+		if h.swimCount < 2 {
+			continue
+		}
+		baseline, since := h.baseline, windowStart
+		if !h.hasBase {
+			baseline, since = h.first.TimeMs, h.first.Date.Time
+		}
+		months := now.Sub(since).Hours() / 24 / daysPerMonth
+		if months <= 0 {
+			continue
+		}
+		rates[key] = math.Max(0, float64(baseline-h.best)/months)
+	}
+	return rates
+}
@@ -0,0 +1,305 @@
+package comparison
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/domain/goal"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// GoalTracker keeps goal statuses up to date and reports the remaining gap to
+// each goal. It observes the time service so that statuses change as soon as
+// a time is added, edited or removed.
+type GoalTracker struct {
+	comparisonService *ComparisonService
+	goalService       *goal.Service
+}
+
+// NewGoalTracker creates a new goal tracker.
+func NewGoalTracker(comparisonService *ComparisonService, goalService *goal.Service) *GoalTracker {
+	return &GoalTracker{
+		comparisonService: comparisonService,
+		goalService:       goalService,
+	}
+}
+
+// GoalProgress is a goal with the time to beat and the swimmer's gap to it.
+// For a standard goal, GoalTimeMS is the cut of the swimmer's current age
+// group. GapMS is the swimmer's best time minus the goal time; zero or less
+// means the goal time has been met.
+type GoalProgress struct {
+	goal.Goal
+	StandardName      *string  `json:"standard_name,omitempty"`
+	GoalTimeMS        *int     `json:"goal_time_ms"`
+	GoalTimeFormatted *string  `json:"goal_time_formatted"`
+	GoalAgeGroup      *string  `json:"goal_age_group,omitempty"`
+	BestTimeMS        *int     `json:"best_time_ms"`
+	BestTimeFormatted *string  `json:"best_time_formatted"`
+	GapMS             *int     `json:"gap_ms"`
+	GapFormatted      *string  `json:"gap_formatted"`
+	GapPercent        *float64 `json:"gap_percent"`
+	DaysLeft          *int     `json:"days_left,omitempty"`
+
+	achievedOn *time.Time
+}
+
+// GoalSummary counts goals by status.
+type GoalSummary struct {
+	Active   int `json:"active"`
+	Achieved int `json:"achieved"`
+	Missed   int `json:"missed"`
+}
+
+// GoalList represents a list of goals with their progress.
+type GoalList struct {
+	Goals   []GoalProgress `json:"goals"`
+	Summary GoalSummary    `json:"summary"`
+}
+
+// TimesChanged re-evaluates the swimmer's goals after their times changed
+// and stores the statuses that changed. Statuses are only stored on writes;
+// List and Get report the evaluated status without storing it.
+func (t *GoalTracker) TimesChanged(ctx context.Context, swimmerID uuid.UUID) error {
+	goals, err := t.goalService.List(ctx, swimmerID)
+	if err != nil {
+		return fmt.Errorf("list goals: %w", err)
+	}
+	progress, err := t.evaluate(ctx, swimmerID, goals)
+	if err != nil {
+		return err
+	}
+	return t.recordStatuses(ctx, goals, progress)
+}
+
+// Track evaluates a goal that was just created or updated, stores its status
+// if it changed and returns its progress.
+func (t *GoalTracker) Track(ctx context.Context, id uuid.UUID) (*GoalProgress, error) {
+	g, err := t.goalService.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	goals := []goal.Goal{*g}
+	progress, err := t.evaluate(ctx, g.SwimmerID, goals)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.recordStatuses(ctx, goals, progress); err != nil {
+		return nil, err
+	}
+	return &progress[0], nil
+}
+
+// recordStatuses stores the evaluated statuses that differ from the stored
+// ones.
+func (t *GoalTracker) recordStatuses(ctx context.Context, goals []goal.Goal, progress []GoalProgress) error {
+	for i, p := range progress {
+		if !statusChanged(goals[i], p.Status, p.AchievedTimeID) {
+			continue
+		}
+		if err := t.goalService.RecordStatus(ctx, p.ID, p.Status, p.AchievedTimeID, p.achievedOn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// List returns a swimmer's goals with their progress, optionally limited to
+// one status. The summary counts all goals.
+func (t *GoalTracker) List(ctx context.Context, swimmerID uuid.UUID, status *goal.Status) (*GoalList, error) {
+	if status != nil && !status.IsValid() {
+		return nil, fmt.Errorf("validation: status must be 'active', 'achieved' or 'missed'")
+	}
+
+	goals, err := t.goalService.List(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("list goals: %w", err)
+	}
+	progress, err := t.evaluate(ctx, swimmerID, goals)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &GoalList{Goals: []GoalProgress{}}
+	for _, p := range progress {
+		switch p.Status {
+		case goal.StatusActive:
+			list.Summary.Active++
+		case goal.StatusAchieved:
+			list.Summary.Achieved++
+		case goal.StatusMissed:
+			list.Summary.Missed++
+		}
+		if status == nil || p.Status == *status {
+			list.Goals = append(list.Goals, p)
+		}
+	}
+	return list, nil
+}
+
+// Get returns one goal with its progress.
+func (t *GoalTracker) Get(ctx context.Context, id uuid.UUID) (*GoalProgress, error) {
+	g, err := t.goalService.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := t.evaluate(ctx, g.SwimmerID, []goal.Goal{*g})
+	if err != nil {
+		return nil, err
+	}
+	return &progress[0], nil
+}
+
+// evaluate works out the status of each of the given goals of a swimmer
+// without storing it. A goal is achieved by the first swim on or
+// before its deadline that meets the target time, or the standard for the
+// swimmer's age group on the day of the swim. An unmet goal is missed once
+// its deadline has passed.
+func (t *GoalTracker) evaluate(ctx context.Context, swimmerID uuid.UUID, goals []goal.Goal) ([]GoalProgress, error) {
+	if len(goals) == 0 {
+		return []GoalProgress{}, nil
+	}
+
+	s := t.comparisonService
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+	history, err := s.timeRepo.ListTimeHistory(ctx, swimmerID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	// Standards and their first achievements are shared by the goals set on
+	// the same standard
+	qualifying := make(map[uuid.UUID]*qualifyingTimes)
+	achievements := make(map[uuid.UUID]map[string]Achievement)
+
+	progress := make([]GoalProgress, 0, len(goals))
+	for _, g := range goals {
+		deadline, hasDeadline := g.DeadlineDate()
+		onTime := func(date time.Time) bool {
+			return !hasDeadline || !date.After(deadline)
+		}
+
+		p := GoalProgress{Goal: g}
+		var achieved *db.ListTimeHistoryRow
+		best := -1
+
+		if g.StandardID != nil {
+			q, ok := qualifying[*g.StandardID]
+			if !ok {
+				standard, err := s.standardRepo.Get(ctx, *g.StandardID)
+				if err != nil {
+					return nil, fmt.Errorf("get standard: %w", err)
+				}
+				q, err = s.loadQualifyingTimes(ctx, standard, swimmer)
+				if err != nil {
+					return nil, err
+				}
+				qualifying[*g.StandardID] = q
+				achievements[*g.StandardID] = q.firstAchievements(history, swimmer.BirthDate.Time)
+			}
+			p.StandardName = &q.standard.Name
+
+			ageGroup := string(q.scheme.AgeGroupAt(swimmer.BirthDate.Time, now))
+			if standardTime, usedAgeGroup, ok := getStandardTime(q.times, g.Event, ageGroup); ok {
+				goalTime := int(standardTime)
+				p.GoalTimeMS = &goalTime
+				p.GoalAgeGroup = &usedAgeGroup
+			}
+
+			for _, row := range history {
+				if row.Event != g.Event || row.CourseType != g.CourseType || !row.Date.Valid {
+					continue
+				}
+				swimTime, eligible := q.swimTime(int(row.TimeMs), row.TimingMethod)
+				if !eligible {
+					continue
+				}
+				if best < 0 || swimTime < best {
+					best = swimTime
+				}
+			}
+			if a, ok := achievements[*g.StandardID][g.Event]; ok {
+				for i := range history {
+					if history[i].ID.String() == a.TimeID && onTime(history[i].Date.Time) {
+						achieved = &history[i]
+					}
+				}
+			}
+		} else {
+			p.GoalTimeMS = g.TargetTimeMS
+			for i, row := range history {
+				if row.Event != g.Event || row.CourseType != g.CourseType || !row.Date.Valid {
+					continue
+				}
+				if best < 0 || int(row.TimeMs) < best {
+					best = int(row.TimeMs)
+				}
+				if achieved == nil && int(row.TimeMs) <= *g.TargetTimeMS && onTime(row.Date.Time) {
+					achieved = &history[i]
+				}
+			}
+		}
+
+		p.Status = goal.StatusActive
+		p.AchievedTimeID = nil
+		p.AchievedDate = nil
+		switch {
+		case achieved != nil:
+			date := achieved.Date.Time.Format("2006-01-02")
+			p.Status = goal.StatusAchieved
+			p.AchievedTimeID = &achieved.ID
+			p.AchievedDate = &date
+			p.achievedOn = &achieved.Date.Time
+		case hasDeadline && deadline.Before(today):
+			p.Status = goal.StatusMissed
+		}
+
+		if p.GoalTimeMS != nil {
+			formatted := domain.FormatTime(*p.GoalTimeMS)
+			p.GoalTimeFormatted = &formatted
+		}
+		if best >= 0 {
+			bestFormatted := domain.FormatTime(best)
+			p.BestTimeMS = &best
+			p.BestTimeFormatted = &bestFormatted
+			if p.GoalTimeMS != nil {
+				gap := best - *p.GoalTimeMS
+				gapFormatted := domain.FormatDifference(gap)
+				gapPercent := float64(gap) / float64(*p.GoalTimeMS) * 100
+				p.GapMS = &gap
+				p.GapFormatted = &gapFormatted
+				p.GapPercent = &gapPercent
+			}
+		}
+		if p.Status == goal.StatusActive && hasDeadline {
+			days := int(math.Round(deadline.Sub(today).Hours() / 24))
+			p.DaysLeft = &days
+		}
+
+		progress = append(progress, p)
+	}
+	return progress, nil
+}
+
+// statusChanged reports whether a goal's stored status differs from the
+// evaluated one.
+func statusChanged(g goal.Goal, status goal.Status, achievedTimeID *uuid.UUID) bool {
+	if g.Status != status {
+		return true
+	}
+	if (g.AchievedTimeID == nil) != (achievedTimeID == nil) {
+		return true
+	}
+	return achievedTimeID != nil && *g.AchievedTimeID != *achievedTimeID
+}
@@ -0,0 +1,203 @@
+package comparison
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/domain/ladder"
+)
+
+// LadderProgressService reports a swimmer's progress up standard ladders.
+type LadderProgressService struct {
+	comparisonService *ComparisonService
+	ladderService     *ladder.Service
+}
+
+// NewLadderProgressService creates a new ladder progress service.
+func NewLadderProgressService(comparisonService *ComparisonService, ladderService *ladder.Service) *LadderProgressService {
+	return &LadderProgressService{
+		comparisonService: comparisonService,
+		ladderService:     ladderService,
+	}
+}
+
+// LadderTierStatus is the swimmer's status against one tier in an event.
+// FirstAchieved is the first swim that met the tier, using the swimmer's age
+// group at the time; it is set even if the tier is no longer met at the
+// current age group.
+type LadderTierStatus struct {
+	Position              int              `json:"position"`
+	StandardID            uuid.UUID        `json:"standard_id"`
+	Label                 string           `json:"label"`
+	Status                ComparisonStatus `json:"status"`
+	StandardTimeMS        *int             `json:"standard_time_ms"`
+	StandardTimeFormatted *string          `json:"standard_time_formatted"`
+	DifferenceMS          *int             `json:"difference_ms"`
+	DifferenceFormatted   *string          `json:"difference_formatted"`
+	DifferencePercent     *float64         `json:"difference_percent"`
+	FirstAchieved         *Achievement     `json:"first_achieved,omitempty"`
+}
+
+// LadderEventProgress is the swimmer's progress up the ladder in one event.
+// CurrentTier is the highest tier achieved at the current age group and
+// NextTier the tier above it, with the gap in its difference fields.
+type LadderEventProgress struct {
+	Event                string             `json:"event"`
+	SwimmerTimeMS        *int               `json:"swimmer_time_ms"`
+	SwimmerTimeFormatted *string            `json:"swimmer_time_formatted"`
+	CurrentTier          *LadderTierStatus  `json:"current_tier"`
+	NextTier             *LadderTierStatus  `json:"next_tier"`
+	Tiers                []LadderTierStatus `json:"tiers"`
+}
+
+// LadderTierSummary counts the events in which a tier has been reached.
+type LadderTierSummary struct {
+	ladder.Tier
+	EventsReached int `json:"events_reached"`
+}
+
+// LadderProgress is a swimmer's progress up a ladder in every event it covers.
+type LadderProgress struct {
+	LadderID         uuid.UUID             `json:"ladder_id"`
+	LadderName       string                `json:"ladder_name"`
+	CourseType       string                `json:"course_type"`
+	SwimmerName      string                `json:"swimmer_name"`
+	ThresholdPercent float64               `json:"threshold_percent"`
+	Tiers            []LadderTierSummary   `json:"tiers"`
+	Events           []LadderEventProgress `json:"events"`
+}
+
+// GetProgress reports, per event, the tier reached, the next tier and when
+// each tier was first achieved. Only events with a time in at least one tier are listed.
+func (s *LadderProgressService) GetProgress(ctx context.Context, swimmerID, ladderID uuid.UUID, thresholdPercent *float64) (*LadderProgress, error) {
+	lad, err := s.ladderService.Get(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+
+	swimmer, err := s.comparisonService.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+
+	history, err := s.comparisonService.timeRepo.ListTimeHistory(ctx, swimmerID, &lad.CourseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+
+	threshold := DefaultThresholdPercent
+	if thresholdPercent != nil {
+		threshold = *thresholdPercent
+	}
+
+	// Compare against every tier and find when each tier was first met
+	comparisons := make([]map[string]EventComparison, len(lad.Tiers))
+	achievements := make([]map[string]Achievement, len(lad.Tiers))
+	for i, tier := range lad.Tiers {
+		result, err := s.comparisonService.Compare(ctx, swimmerID, tier.StandardID, lad.CourseType, &threshold, nil)
+		if err != nil {
+			return nil, err
+		}
+		comparisons[i] = make(map[string]EventComparison, len(result.Comparisons))
+		for _, comp := range result.Comparisons {
+			comparisons[i][comp.Event] = comp
+		}
+
+		standard, err := s.comparisonService.standardRepo.Get(ctx, tier.StandardID)
+		if err != nil {
+			return nil, fmt.Errorf("get standard: %w", err)
+		}
+		qualifying, err := s.comparisonService.loadQualifyingTimes(ctx, standard, swimmer)
+		if err != nil {
+			return nil, err
+		}
+		achievements[i] = qualifying.firstAchievements(history, swimmer.BirthDate.Time)
+	}
+
+	progress := &LadderProgress{
+		LadderID:         lad.ID,
+		LadderName:       lad.Name,
+		CourseType:       lad.CourseType,
+		SwimmerName:      swimmer.Name,
+		ThresholdPercent: threshold,
+		Tiers:            make([]LadderTierSummary, len(lad.Tiers)),
+		Events:           []LadderEventProgress{},
+	}
+	for i, tier := range lad.Tiers {
+		progress.Tiers[i] = LadderTierSummary{Tier: tier}
+	}
+
+	for _, event := range domain.EventsForCourse(domain.CourseType(lad.CourseType)) {
+		code := string(event.Code)
+		row := LadderEventProgress{
+			Event: code,
+			Tiers: make([]LadderTierStatus, 0, len(lad.Tiers)),
+		}
+
+		current := -1
+		hasStandard := false
+		rowAdjusted := false
+		for i, tier := range lad.Tiers {
+			comp, ok := comparisons[i][code]
+			if !ok {
+				comp = EventComparison{Event: code, Status: StatusNoStandard}
+			}
+			if comp.StandardTimeMS == nil {
+				comp.Status = StatusNoStandard
+			} else {
+				hasStandard = true
+			}
+			// Prefer a personal best without a hand-timing adjustment for the row
+			if comp.SwimmerTimeMS != nil && (row.SwimmerTimeMS == nil || (rowAdjusted && comp.AdjustmentMS == nil)) {
+				rowAdjusted = comp.AdjustmentMS != nil
+				row.SwimmerTimeMS = comp.SwimmerTimeMS
+				row.SwimmerTimeFormatted = comp.SwimmerTimeFormatted
+			}
+
+			status := LadderTierStatus{
+				Position:              tier.Position,
+				StandardID:            tier.StandardID,
+				Label:                 tier.Label,
+				Status:                comp.Status,
+				StandardTimeMS:        comp.StandardTimeMS,
+				StandardTimeFormatted: comp.StandardTimeFormatted,
+				DifferenceMS:          comp.DifferenceMS,
+				DifferenceFormatted:   comp.DifferenceFormatted,
+				DifferencePercent:     comp.DifferencePercent,
+			}
+			if achievement, ok := achievements[i][code]; ok {
+				status.FirstAchieved = &achievement
+			}
+			if status.Status == StatusAchieved {
+				current = i
+			}
+			row.Tiers = append(row.Tiers, status)
+		}
+		if !hasStandard {
+			continue
+		}
+
+		if current >= 0 {
+			currentTier := row.Tiers[current]
+			row.CurrentTier = &currentTier
+			for i := 0; i <= current; i++ {
+				if row.Tiers[i].StandardTimeMS != nil {
+					progress.Tiers[i].EventsReached++
+				}
+			}
+		}
+		for i := current + 1; i < len(row.Tiers); i++ {
+			if row.Tiers[i].StandardTimeMS != nil {
+				nextTier := row.Tiers[i]
+				row.NextTier = &nextTier
+				break
+			}
+		}
+		progress.Events = append(progress.Events, row)
+	}
+
+	return progress, nil
+}
@@ -0,0 +1,306 @@
+package comparison
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/domain/threshold"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// MatrixStandard describes one standard (column) of a qualification matrix.
+type MatrixStandard struct {
+	ID               uuid.UUID         `json:"id"`
+	Name             string            `json:"name"`
+	Category         string            `json:"category"`
+	ManualTimePolicy string            `json:"manual_time_policy"`
+	AgeGroup         string            `json:"age_group"`
+	Summary          ComparisonSummary `json:"summary"`
+}
+
+// MatrixCell is the comparison of one event against one standard.
+type MatrixCell struct {
+	StandardID            uuid.UUID        `json:"standard_id"`
+	Status                ComparisonStatus `json:"status"`
+	AgeGroup              string           `json:"age_group"`
+	SwimmerTimeMS         *int             `json:"swimmer_time_ms"`
+	StandardTimeMS        *int             `json:"standard_time_ms"`
+	StandardTimeFormatted *string          `json:"standard_time_formatted"`
+	DifferenceMS          *int             `json:"difference_ms"`
+	DifferenceFormatted   *string          `json:"difference_formatted"`
+	DifferencePercent     *float64         `json:"difference_percent"`
+}
+
+// MatrixTarget points at a standard for an event, with the swimmer's gap to it.
+// DifferenceMS is nil when the swimmer has no time in the event.
+type MatrixTarget struct {
+	StandardID            uuid.UUID `json:"standard_id"`
+	StandardName          string    `json:"standard_name"`
+	StandardTimeMS        int       `json:"standard_time_ms"`
+	StandardTimeFormatted string    `json:"standard_time_formatted"`
+	DifferenceMS          *int      `json:"difference_ms,omitempty"`
+	DifferenceFormatted   *string   `json:"difference_formatted,omitempty"`
+}
+
+// MatrixEvent is one event (row) of a qualification matrix. Cells are in the
+// order of the matrix standards; a cell's swimmer time includes any hand-timing
+// adjustment required by its standard. HighestAchieved is the fastest standard the
+// swimmer has achieved, and NextTarget the slowest standard not yet achieved.
+type MatrixEvent struct {
+	Event                string        `json:"event"`
+	SwimmerTimeMS        *int          `json:"swimmer_time_ms"`
+	SwimmerTimeFormatted *string       `json:"swimmer_time_formatted"`
+	MeetName             *string       `json:"meet_name"`
+	Date                 *string       `json:"date"`
+	Cells                []MatrixCell  `json:"cells"`
+	HighestAchieved      *MatrixTarget `json:"highest_achieved"`
+	NextTarget           *MatrixTarget `json:"next_target"`
+}
+
+// ComparisonMatrix compares a swimmer's personal bests against several standards.
+// SwimmerAgeGroup is the age group of the swimmer's own category; each
+// standard reports the age group of its category that it is compared on.
+type ComparisonMatrix struct {
+	CourseType       string           `json:"course_type"`
+	SwimmerName      string           `json:"swimmer_name"`
+	SwimmerAgeGroup  string           `json:"swimmer_age_group"`
+	ThresholdPercent float64          `json:"threshold_percent"`
+	Standards        []MatrixStandard `json:"standards"`
+	Events           []MatrixEvent    `json:"events"`
+}
+
+// CompareMatrix compares a swimmer's personal bests against several standards at once.
+// Without standard IDs, every standard for the course type that applies to the
+// swimmer's gender is used. The swimmer and their times are loaded once and
+// each standard is evaluated on its own age scheme and hand-timing policy.
+func (s *ComparisonService) CompareMatrix(ctx context.Context, swimmerID uuid.UUID, standardIDs []uuid.UUID, courseType string, thresholdPercent *float64) (*ComparisonMatrix, error) {
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+
+	standards, err := s.trackedStandards(ctx, swimmer, standardIDs, &courseType, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.timeRepo.ListTimeHistory(ctx, swimmerID, &courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+
+	thresholdRows, err := s.thresholdRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list thresholds: %w", err)
+	}
+	thresholds := make([]threshold.Threshold, len(thresholdRows))
+	for i, row := range thresholdRows {
+		thresholds[i] = threshold.ToThreshold(row)
+	}
+
+	defaultThreshold := DefaultThresholdPercent
+	if thresholdPercent != nil {
+		defaultThreshold = *thresholdPercent
+	}
+
+	now := time.Now()
+	matrix := &ComparisonMatrix{
+		CourseType:       courseType,
+		SwimmerName:      swimmer.Name,
+		SwimmerAgeGroup:  string(domain.Category(swimmer.Category).AgeScheme().AgeGroupAt(swimmer.BirthDate.Time, now)),
+		ThresholdPercent: defaultThreshold,
+		Standards:        make([]MatrixStandard, 0, len(standards)),
+	}
+
+	events := domain.EventsForCourse(domain.CourseType(courseType))
+	cells := make([][]matrixCell, len(standards))
+	for i := range standards {
+		standard := &standards[i]
+		qualifying, err := s.loadQualifyingTimes(ctx, standard, swimmer)
+		if err != nil {
+			return nil, err
+		}
+		resolver := threshold.NewResolver(thresholdsForStandard(thresholds, standard.ID), defaultThreshold)
+		ageGroup := string(qualifying.scheme.AgeGroupAt(swimmer.BirthDate.Time, now))
+
+		bests := qualifying.bestTimes(history)
+		column := MatrixStandard{
+			ID:               standard.ID,
+			Name:             standard.Name,
+			Category:         standard.Category,
+			ManualTimePolicy: standard.ManualTimePolicy,
+			AgeGroup:         ageGroup,
+		}
+		cells[i] = make([]matrixCell, len(events))
+		for j, event := range events {
+			cell := qualifying.eventCell(event.Code, ageGroup, bests, resolver)
+			switch cell.Status {
+			case StatusAchieved:
+				column.Summary.Achieved++
+			case StatusAlmost:
+				column.Summary.Almost++
+			case StatusNotAchieved:
+				column.Summary.NotAchieved++
+			case StatusNoTime:
+				column.Summary.NoTime++
+			}
+			column.Summary.TotalEvents++
+			cells[i][j] = cell
+		}
+		matrix.Standards = append(matrix.Standards, column)
+	}
+
+	matrix.Events = make([]MatrixEvent, len(events))
+	for j, event := range events {
+		row := MatrixEvent{
+			Event: string(event.Code),
+			Cells: make([]MatrixCell, 0, len(standards)),
+		}
+		rowAdjusted := false
+		for i := range standards {
+			cell := cells[i][j]
+			// Prefer a personal best without a hand-timing adjustment for the row
+			if cell.best != nil && (row.SwimmerTimeMS == nil || (rowAdjusted && !cell.best.adjusted())) {
+				rowAdjusted = cell.best.adjusted()
+				swimmerTime := cell.best.timeMS
+				swimmerTimeFormatted := domain.FormatTime(swimmerTime)
+				meetName := cell.best.row.MeetName
+				date := cell.best.row.Date.Time.Format("Jan 2, 2006")
+				row.SwimmerTimeMS = &swimmerTime
+				row.SwimmerTimeFormatted = &swimmerTimeFormatted
+				row.MeetName = &meetName
+				row.Date = &date
+			}
+			row.Cells = append(row.Cells, cell.MatrixCell)
+
+			if cell.StandardTimeMS == nil {
+				continue
+			}
+			target := &MatrixTarget{
+				StandardID:            cell.StandardID,
+				StandardName:          standards[i].Name,
+				StandardTimeMS:        *cell.StandardTimeMS,
+				StandardTimeFormatted: *cell.StandardTimeFormatted,
+				DifferenceMS:          cell.DifferenceMS,
+				DifferenceFormatted:   cell.DifferenceFormatted,
+			}
+			switch cell.Status {
+			case StatusAchieved:
+				if row.HighestAchieved == nil || target.StandardTimeMS < row.HighestAchieved.StandardTimeMS {
+					row.HighestAchieved = target
+				}
+			case StatusAlmost, StatusNotAchieved, StatusNoTime:
+				if row.NextTarget == nil || target.StandardTimeMS > row.NextTarget.StandardTimeMS {
+					row.NextTarget = target
+				}
+			}
+		}
+		matrix.Events[j] = row
+	}
+
+	return matrix, nil
+}
+
+// thresholdsForStandard returns the thresholds that apply to a standard,
+// including those set for every standard.
+func thresholdsForStandard(thresholds []threshold.Threshold, standardID uuid.UUID) []threshold.Threshold {
+	applicable := make([]threshold.Threshold, 0, len(thresholds))
+	for _, t := range thresholds {
+		if t.StandardID == nil || *t.StandardID == standardID {
+			applicable = append(applicable, t)
+		}
+	}
+	return applicable
+}
+
+// qualifyingSwim is a swim with the time that counts for a standard.
+type qualifyingSwim struct {
+	row    db.ListTimeHistoryRow
+	timeMS int
+}
+
+// adjusted reports whether a hand-timing adjustment was added to the swim.
+func (b *qualifyingSwim) adjusted() bool {
+	return b.timeMS != int(b.row.TimeMs)
+}
+
+// bestTimes returns the fastest eligible swim per event in the standard's
+// course, preferring the most recent swim on a tie.
+func (q *qualifyingTimes) bestTimes(history []db.ListTimeHistoryRow) map[string]qualifyingSwim {
+	bests := make(map[string]qualifyingSwim)
+	for _, row := range history {
+		if row.CourseType != q.standard.CourseType || !row.Date.Valid {
+			continue
+		}
+		swimTime, eligible := q.swimTime(int(row.TimeMs), row.TimingMethod)
+		if !eligible {
+			continue
+		}
+		if best, ok := bests[row.Event]; ok && best.timeMS < swimTime {
+			continue
+		}
+		bests[row.Event] = qualifyingSwim{row: row, timeMS: swimTime}
+	}
+	return bests
+}
+
+// matrixCell is a matrix cell with the swim it was judged on.
+type matrixCell struct {
+	MatrixCell
+	best *qualifyingSwim
+}
+
+// eventCell compares the swimmer's best in an event against the standard
+// time of the age group, falling back to OPEN.
+func (q *qualifyingTimes) eventCell(event domain.EventCode, ageGroup string, bests map[string]qualifyingSwim, resolver *threshold.Resolver) matrixCell {
+	cell := matrixCell{MatrixCell: MatrixCell{
+		StandardID: q.standard.ID,
+		AgeGroup:   ageGroup,
+	}}
+	best, hasBest := bests[string(event)]
+	if hasBest {
+		cell.best = &best
+		swimmerTime := best.timeMS
+		cell.SwimmerTimeMS = &swimmerTime
+	}
+
+	stdTimeMS, usedAgeGroup, hasStandard := getStandardTime(q.times, string(event), ageGroup)
+	if !hasStandard {
+		cell.Status = StatusNoStandard
+		if !hasBest {
+			cell.Status = StatusNoTime
+		}
+		return cell
+	}
+	cell.AgeGroup = usedAgeGroup
+	standardTime := int(stdTimeMS)
+	standardTimeFormatted := domain.FormatTime(standardTime)
+	cell.StandardTimeMS = &standardTime
+	cell.StandardTimeFormatted = &standardTimeFormatted
+	if !hasBest {
+		cell.Status = StatusNoTime
+		return cell
+	}
+
+	diff := best.timeMS - standardTime
+	diffFormatted := domain.FormatDifference(diff)
+	diffPercent := float64(diff) / float64(standardTime) * 100
+	cell.DifferenceMS = &diff
+	cell.DifferenceFormatted = &diffFormatted
+	cell.DifferencePercent = &diffPercent
+
+	applied := resolver.Resolve(event)
+	switch {
+	case diff <= 0:
+		cell.Status = StatusAchieved
+	case applied.IsAlmost(diff, standardTime):
+		cell.Status = StatusAlmost
+	default:
+		cell.Status = StatusNotAchieved
+	}
+	return cell
+}
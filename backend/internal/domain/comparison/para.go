@@ -0,0 +1,241 @@
+package comparison
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// ParaPointsService scores para-swimmers' personal bests against
+// World Para Swimming base times.
+type ParaPointsService struct {
+	timeRepo     *postgres.TimeRepository
+	swimmerRepo  *postgres.SwimmerRepository
+	baseTimeRepo *postgres.ParaBaseTimeRepository
+}
+
+// NewParaPointsService creates a new para points service.
+func NewParaPointsService(
+	timeRepo *postgres.TimeRepository,
+	swimmerRepo *postgres.SwimmerRepository,
+	baseTimeRepo *postgres.ParaBaseTimeRepository,
+) *ParaPointsService {
+	return &ParaPointsService{
+		timeRepo:     timeRepo,
+		swimmerRepo:  swimmerRepo,
+		baseTimeRepo: baseTimeRepo,
+	}
+}
+
+// EventParaPoints is the para points score of a personal best.
+// SportClass, BaseTimeMS and Points are omitted when the swimmer has no
+// class for the stroke or no base time is loaded for the class and event.
+type EventParaPoints struct {
+	Event         string  `json:"event"`
+	SportClass    *string `json:"sport_class,omitempty"`
+	TimeMS        int     `json:"time_ms"`
+	TimeFormatted string  `json:"time_formatted"`
+	TimeID        string  `json:"time_id"`
+	MeetName      string  `json:"meet"`
+	Date          string  `json:"date"`
+	BaseTimeMS    *int    `json:"base_time_ms,omitempty"`
+	Points        *int    `json:"points,omitempty"`
+}
+
+// ParaPointsResult lists para points for each event with a personal best.
+type ParaPointsResult struct {
+	SwimmerID  string            `json:"swimmer_id"`
+	CourseType string            `json:"course_type"`
+	Gender     string            `json:"gender"`
+	Events     []EventParaPoints `json:"events"`
+}
+
+// ParaBaseTimeInput is a World Para Swimming base time table for one course
+// and gender, keyed as sport class -> event -> time (e.g. "S9" -> "50FR" -> "27.84").
+type ParaBaseTimeInput struct {
+	CourseType string                       `json:"course_type"`
+	Gender     string                       `json:"gender"`
+	Times      map[string]map[string]string `json:"times"`
+}
+
+// ParaBaseTime is a single base time.
+type ParaBaseTime struct {
+	SportClass    string `json:"sport_class"`
+	Event         string `json:"event"`
+	Gender        string `json:"gender"`
+	CourseType    string `json:"course_type"`
+	TimeMS        int    `json:"time_ms"`
+	TimeFormatted string `json:"time_formatted"`
+}
+
+// ParaBaseTimeList represents a list of base times.
+type ParaBaseTimeList struct {
+	BaseTimes []ParaBaseTime `json:"base_times"`
+}
+
+// GetParaPoints scores a swimmer's personal bests in a course type.
+func (s *ParaPointsService) GetParaPoints(ctx context.Context, swimmerID uuid.UUID, courseType string) (*ParaPointsResult, error) {
+	if !domain.CourseType(courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", courseType)
+	}
+
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+
+	baseTimes, err := s.baseTimeRepo.List(ctx, &courseType, &swimmer.Gender)
+	if err != nil {
+		return nil, err
+	}
+	// sport_class -> event -> base time
+	baseMap := make(map[string]map[string]int32)
+	for _, bt := range baseTimes {
+		if baseMap[bt.SportClass] == nil {
+			baseMap[bt.SportClass] = make(map[string]int32)
+		}
+		baseMap[bt.SportClass][bt.Event] = bt.TimeMs
+	}
+
+	pbs, err := s.timeRepo.GetPersonalBests(ctx, swimmerID, courseType)
+	if err != nil {
+		return nil, fmt.Errorf("get personal bests: %w", err)
+	}
+
+	classes := swimmerSportClasses(swimmer)
+	events := make([]EventParaPoints, len(pbs))
+	for i, pb := range pbs {
+		pbView := toPersonalBest(pb)
+		events[i] = EventParaPoints{
+			Event:         pbView.Event,
+			TimeMS:        pbView.TimeMS,
+			TimeFormatted: pbView.TimeFormatted,
+			TimeID:        pbView.TimeID,
+			MeetName:      pbView.MeetName,
+			Date:          pbView.Date,
+		}
+
+		class := classes.ForEvent(domain.EventCode(pb.Event))
+		if class == "" {
+			continue
+		}
+		sportClass := string(class)
+		events[i].SportClass = &sportClass
+
+		if base, ok := baseMap[sportClass][pb.Event]; ok {
+			baseTime := int(base)
+			points := domain.ParaPoints(baseTime, pbView.TimeMS)
+			events[i].BaseTimeMS = &baseTime
+			events[i].Points = &points
+		}
+	}
+
+	return &ParaPointsResult{
+		SwimmerID:  swimmerID.String(),
+		CourseType: courseType,
+		Gender:     swimmer.Gender,
+		Events:     events,
+	}, nil
+}
+
+// ListBaseTimes lists the loaded base times, optionally filtered by course type and gender.
+func (s *ParaPointsService) ListBaseTimes(ctx context.Context, courseType, gender *string) (*ParaBaseTimeList, error) {
+	rows, err := s.baseTimeRepo.List(ctx, courseType, gender)
+	if err != nil {
+		return nil, err
+	}
+	list := &ParaBaseTimeList{BaseTimes: make([]ParaBaseTime, len(rows))}
+	for i, row := range rows {
+		list.BaseTimes[i] = toParaBaseTime(&row)
+	}
+	return list, nil
+}
+
+// ImportBaseTimes replaces the base time table for a course type and gender.
+func (s *ParaPointsService) ImportBaseTimes(ctx context.Context, input ParaBaseTimeInput) (*ParaBaseTimeList, error) {
+	input.CourseType = strings.TrimSpace(input.CourseType)
+	input.Gender = strings.TrimSpace(input.Gender)
+	if !domain.CourseType(input.CourseType).IsPool() {
+		return nil, errors.New("validation: course_type must be '25m' or '50m'")
+	}
+	if input.Gender != "female" && input.Gender != "male" {
+		return nil, errors.New("validation: gender must be 'female' or 'male'")
+	}
+	if len(input.Times) == 0 {
+		return nil, errors.New("validation: no base times defined")
+	}
+
+	// Validate everything before replacing the table
+	params := make([]db.UpsertParaBaseTimeParams, 0)
+	for rawClass, events := range input.Times {
+		class := domain.SportClass(strings.ToUpper(strings.TrimSpace(rawClass)))
+		if !class.IsValid() {
+			return nil, fmt.Errorf("validation: invalid sport class: %s", rawClass)
+		}
+		for code, timeStr := range events {
+			event, ok := domain.LookupEvent(domain.EventCode(code))
+			if !ok {
+				return nil, fmt.Errorf("validation: invalid event code: %s", code)
+			}
+			if domain.SportClassFamilyForStroke(event.Stroke) != class.Family() {
+				return nil, fmt.Errorf("validation: sport class %s does not apply to %s", class, code)
+			}
+			timeMS, err := domain.ParseTime(timeStr)
+			if err != nil || timeMS <= 0 {
+				return nil, fmt.Errorf("validation: %s/%s: invalid time '%s'", class, code, timeStr)
+			}
+			params = append(params, db.UpsertParaBaseTimeParams{
+				SportClass: string(class),
+				Event:      code,
+				Gender:     input.Gender,
+				CourseType: input.CourseType,
+				TimeMs:     int32(timeMS),
+			})
+		}
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i].SportClass != params[j].SportClass {
+			return params[i].SportClass < params[j].SportClass
+		}
+		return params[i].Event < params[j].Event
+	})
+
+	rows, err := s.baseTimeRepo.ReplaceTable(ctx, input.CourseType, input.Gender, params)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &ParaBaseTimeList{BaseTimes: make([]ParaBaseTime, 0, len(rows))}
+	for i := range rows {
+		list.BaseTimes = append(list.BaseTimes, toParaBaseTime(&rows[i]))
+	}
+	return list, nil
+}
+
+// swimmerSportClasses returns the swimmer's para-swimming classification.
+func swimmerSportClasses(swimmer *db.Swimmer) domain.SportClasses {
+	return domain.SportClasses{
+		S:  domain.SportClass(swimmer.SportClassS.String),
+		SB: domain.SportClass(swimmer.SportClassSb.String),
+		SM: domain.SportClass(swimmer.SportClassSm.String),
+	}
+}
+
+func toParaBaseTime(row *db.ParaBaseTime) ParaBaseTime {
+	return ParaBaseTime{
+		SportClass:    row.SportClass,
+		Event:         row.Event,
+		Gender:        row.Gender,
+		CourseType:    row.CourseType,
+		TimeMS:        int(row.TimeMs),
+		TimeFormatted: domain.FormatTime(int(row.TimeMs)),
+	}
+}
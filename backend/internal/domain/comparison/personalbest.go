@@ -4,22 +4,28 @@ package comparison
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/bpg/swimstats/backend/internal/domain"
+	timeservice "github.com/bpg/swimstats/backend/internal/domain/time"
+	"github.com/bpg/swimstats/backend/internal/store/db"
 	"github.com/bpg/swimstats/backend/internal/store/postgres"
 )
 
 // PersonalBestService provides personal best business logic.
 type PersonalBestService struct {
-	timeRepo *postgres.TimeRepository
+	timeRepo    *postgres.TimeRepository
+	swimmerRepo *postgres.SwimmerRepository
 }
 
 // NewPersonalBestService creates a new personal best service.
-func NewPersonalBestService(timeRepo *postgres.TimeRepository) *PersonalBestService {
+func NewPersonalBestService(timeRepo *postgres.TimeRepository, swimmerRepo *postgres.SwimmerRepository) *PersonalBestService {
 	return &PersonalBestService{
-		timeRepo: timeRepo,
+		timeRepo:    timeRepo,
+		swimmerRepo: swimmerRepo,
 	}
 }
 
@@ -31,52 +37,91 @@ type PersonalBest struct {
 	TimeID        string `json:"time_id"`
 	MeetName      string `json:"meet"`
 	Date          string `json:"date"`
+	TimingMethod  string `json:"timing_method"`
+	// Points are World Aquatics points, set when a base time is loaded.
+	Points *int `json:"points,omitempty"`
+	// OpenWaterConditions are reported for open-water personal bests.
+	timeservice.OpenWaterConditions
+
+	// BestElectronic is the fastest electronically timed swim, reported when
+	// requested and the overall best was hand-timed.
+	BestElectronic *PersonalBest `json:"best_electronic,omitempty"`
+}
+
+// PersonalBestOptions controls optional parts of the personal best report.
+type PersonalBestOptions struct {
+	IncludeBestElectronic bool
+	// AsOf, when set, reports the personal bests as they stood on that date.
+	AsOf *time.Time
 }
 
 // PersonalBestList represents a list of personal bests.
 type PersonalBestList struct {
 	CourseType    string         `json:"course_type"`
+	AsOf          *string        `json:"as_of,omitempty"`
 	PersonalBests []PersonalBest `json:"personal_bests"`
 }
 
 // GetPersonalBests retrieves all personal bests for a swimmer in a course type.
-func (s *PersonalBestService) GetPersonalBests(ctx context.Context, swimmerID uuid.UUID, courseType string) (*PersonalBestList, error) {
+func (s *PersonalBestService) GetPersonalBests(ctx context.Context, swimmerID uuid.UUID, courseType string, opts PersonalBestOptions) (*PersonalBestList, error) {
 	// Validate course type
 	if !domain.CourseType(courseType).IsValid() {
 		return nil, fmt.Errorf("invalid course type: %s", courseType)
 	}
 
-	rows, err := s.timeRepo.GetPersonalBests(ctx, swimmerID, courseType)
+	rows, err := s.timeRepo.ListPersonalBests(ctx, postgres.PersonalBestsParams{
+		SwimmerID:  swimmerID,
+		CourseType: courseType,
+		AsOf:       opts.AsOf,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("get personal bests: %w", err)
 	}
 
 	pbs := make([]PersonalBest, len(rows))
 	for i, row := range rows {
-		date := ""
-		if row.MeetDate.Valid {
-			date = row.MeetDate.Time.Format("2006-01-02")
-		}
+		pbs[i] = toPersonalBest(row)
+	}
 
-		pbs[i] = PersonalBest{
-			Event:         row.Event,
-			TimeMS:        int(row.TimeMs),
-			TimeFormatted: domain.FormatTime(int(row.TimeMs)),
-			TimeID:        row.ID.String(),
-			MeetName:      row.MeetName,
-			Date:          date,
+	if opts.IncludeBestElectronic {
+		electronic, err := s.timeRepo.ListPersonalBests(ctx, postgres.PersonalBestsParams{
+			SwimmerID:    swimmerID,
+			CourseType:   courseType,
+			TimingMethod: string(domain.TimingElectronic),
+			AsOf:         opts.AsOf,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get electronic personal bests: %w", err)
+		}
+		byEvent := make(map[string]db.GetPersonalBestsRow, len(electronic))
+		for _, row := range electronic {
+			byEvent[row.Event] = row
+		}
+		for i := range pbs {
+			if pbs[i].TimingMethod == string(domain.TimingElectronic) {
+				continue
+			}
+			if row, ok := byEvent[pbs[i].Event]; ok {
+				best := toPersonalBest(row)
+				pbs[i].BestElectronic = &best
+			}
 		}
 	}
 
-	return &PersonalBestList{
+	list := &PersonalBestList{
 		CourseType:    courseType,
 		PersonalBests: pbs,
-	}, nil
+	}
+	if opts.AsOf != nil {
+		asOf := opts.AsOf.Format("2006-01-02")
+		list.AsOf = &asOf
+	}
+	return list, nil
 }
 
 // GetPersonalBestsByStroke returns personal bests organized by stroke.
 func (s *PersonalBestService) GetPersonalBestsByStroke(ctx context.Context, swimmerID uuid.UUID, courseType string) (map[string][]PersonalBest, error) {
-	list, err := s.GetPersonalBests(ctx, swimmerID, courseType)
+	list, err := s.GetPersonalBests(ctx, swimmerID, courseType, PersonalBestOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +136,209 @@ func (s *PersonalBestService) GetPersonalBestsByStroke(ctx context.Context, swim
 	return byStroke, nil
 }
 
+// PersonalBestStep is a swim that was a personal best when it was swum.
+// The improvement fields compare it with the previous personal best and are
+// omitted for the first swim in an event.
+type PersonalBestStep struct {
+	TimeID                string   `json:"time_id"`
+	TimeMS                int      `json:"time_ms"`
+	TimeFormatted         string   `json:"time_formatted"`
+	MeetName              string   `json:"meet"`
+	Date                  string   `json:"date"`
+	TimingMethod          string   `json:"timing_method"`
+	ImprovementMS         *int     `json:"improvement_ms,omitempty"`
+	ImprovementFormatted  *string  `json:"improvement_formatted,omitempty"`
+	ImprovementPercent    *float64 `json:"improvement_percent,omitempty"`
+	DaysSincePreviousBest *int     `json:"days_since_previous_best,omitempty"`
+}
+
+// EventPersonalBestHistory is the personal best progression in one event,
+// oldest first.
+type EventPersonalBestHistory struct {
+	Event       string             `json:"event"`
+	Progression []PersonalBestStep `json:"progression"`
+}
+
+// PersonalBestHistory is the personal best progression across events.
+type PersonalBestHistory struct {
+	CourseType string                     `json:"course_type"`
+	Events     []EventPersonalBestHistory `json:"events"`
+}
+
+// GetPersonalBestHistory returns every swim that was a personal best when it
+// was swum, per event in a course type, optionally for a single event. A swim
+// equal to the standing personal best does not count as a new one.
+func (s *PersonalBestService) GetPersonalBestHistory(ctx context.Context, swimmerID uuid.UUID, courseType string, event *string) (*PersonalBestHistory, error) {
+	if !domain.CourseType(courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", courseType)
+	}
+	if event != nil && !domain.EventCode(*event).IsValid() {
+		return nil, fmt.Errorf("validation: invalid event: %s", *event)
+	}
+
+	history, err := s.timeRepo.ListTimeHistory(ctx, swimmerID, &courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+
+	byEvent := make(map[string][]PersonalBestStep)
+	previous := make(map[string]db.ListTimeHistoryRow)
+	for _, row := range history {
+		if !row.Date.Valid || (event != nil && row.Event != *event) {
+			continue
+		}
+		step := PersonalBestStep{
+			TimeID:        row.ID.String(),
+			TimeMS:        int(row.TimeMs),
+			TimeFormatted: domain.FormatTime(int(row.TimeMs)),
+			MeetName:      row.MeetName,
+			Date:          row.Date.Time.Format("2006-01-02"),
+			TimingMethod:  row.TimingMethod,
+		}
+		if prev, ok := previous[row.Event]; ok {
+			if row.TimeMs >= prev.TimeMs {
+				continue
+			}
+			improvement := int(prev.TimeMs - row.TimeMs)
+			formatted := domain.FormatTime(improvement)
+			percent := float64(improvement) / float64(prev.TimeMs) * 100
+			days := int(row.Date.Time.Sub(prev.Date.Time).Hours() / 24)
+			step.ImprovementMS = &improvement
+			step.ImprovementFormatted = &formatted
+			step.ImprovementPercent = &percent
+			step.DaysSincePreviousBest = &days
+		}
+		previous[row.Event] = row
+		byEvent[row.Event] = append(byEvent[row.Event], step)
+	}
+
+	result := &PersonalBestHistory{
+		CourseType: courseType,
+		Events:     make([]EventPersonalBestHistory, 0, len(byEvent)),
+	}
+	for e, steps := range byEvent {
+		result.Events = append(result.Events, EventPersonalBestHistory{Event: e, Progression: steps})
+	}
+	sort.Slice(result.Events, func(i, j int) bool {
+		return domain.EventSortOrder(result.Events[i].Event) < domain.EventSortOrder(result.Events[j].Event)
+	})
+	return result, nil
+}
+
+// AgeGroupBest is the fastest swim in an event while in one age group.
+type AgeGroupBest struct {
+	AgeGroup       string `json:"age_group"`
+	TimeID         string `json:"time_id"`
+	TimeMS         int    `json:"time_ms"`
+	TimeFormatted  string `json:"time_formatted"`
+	MeetName       string `json:"meet"`
+	Date           string `json:"date"`
+	IsPersonalBest bool   `json:"is_pb"`
+}
+
+// EventAgeGroupBests lists the age-group bests in one event and course,
+// youngest age group first.
+type EventAgeGroupBests struct {
+	Event      string         `json:"event"`
+	CourseType string         `json:"course_type"`
+	AgeGroups  []AgeGroupBest `json:"age_groups"`
+}
+
+// AgeGroupBestList lists age-group bests across events.
+type AgeGroupBestList struct {
+	CourseType      *string              `json:"course_type,omitempty"`
+	CurrentAgeGroup string               `json:"current_age_group"`
+	Events          []EventAgeGroupBests `json:"events"`
+}
+
+// GetAgeGroupBests returns the best time per event and course in each age
+// group the swimmer swam in, optionally for a single course type. The age
+// group of a swim is the one of the swimmer's category that applied on the
+// event date.
+func (s *PersonalBestService) GetAgeGroupBests(ctx context.Context, swimmerID uuid.UUID, courseType *string) (*AgeGroupBestList, error) {
+	if courseType != nil && !domain.CourseType(*courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", *courseType)
+	}
+
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+	history, err := s.timeRepo.ListTimeHistory(ctx, swimmerID, courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+
+	scheme := domain.Category(swimmer.Category).AgeScheme()
+	type eventKey struct{ courseType, event string }
+	byEvent := make(map[eventKey]*EventAgeGroupBests)
+	fastest := make(map[eventKey]int32)
+	for key, row := range timeservice.AgeGroupBests(history, swimmer.BirthDate.Time, scheme) {
+		ek := eventKey{key.CourseType, key.Event}
+		e, ok := byEvent[ek]
+		if !ok {
+			e = &EventAgeGroupBests{Event: key.Event, CourseType: key.CourseType}
+			byEvent[ek] = e
+		}
+		e.AgeGroups = append(e.AgeGroups, AgeGroupBest{
+			AgeGroup:      string(key.AgeGroup),
+			TimeID:        row.ID.String(),
+			TimeMS:        int(row.TimeMs),
+			TimeFormatted: domain.FormatTime(int(row.TimeMs)),
+			MeetName:      row.MeetName,
+			Date:          row.Date.Time.Format("2006-01-02"),
+		})
+		if best, ok := fastest[ek]; !ok || row.TimeMs < best {
+			fastest[ek] = row.TimeMs
+		}
+	}
+
+	list := &AgeGroupBestList{
+		CourseType:      courseType,
+		CurrentAgeGroup: string(scheme.AgeGroupAt(swimmer.BirthDate.Time, time.Now())),
+		Events:          make([]EventAgeGroupBests, 0, len(byEvent)),
+	}
+	for ek, e := range byEvent {
+		for i := range e.AgeGroups {
+			e.AgeGroups[i].IsPersonalBest = int32(e.AgeGroups[i].TimeMS) == fastest[ek]
+		}
+		sort.Slice(e.AgeGroups, func(i, j int) bool {
+			mi, _ := domain.AgeGroupBounds(domain.AgeGroup(e.AgeGroups[i].AgeGroup))
+			mj, _ := domain.AgeGroupBounds(domain.AgeGroup(e.AgeGroups[j].AgeGroup))
+			return mi < mj
+		})
+		list.Events = append(list.Events, *e)
+	}
+	sort.Slice(list.Events, func(i, j int) bool {
+		if list.Events[i].CourseType != list.Events[j].CourseType {
+			return list.Events[i].CourseType < list.Events[j].CourseType
+		}
+		return domain.EventSortOrder(list.Events[i].Event) < domain.EventSortOrder(list.Events[j].Event)
+	})
+	return list, nil
+}
+
 // IsPersonalBest checks if a given time would be a new personal best.
 func (s *PersonalBestService) IsPersonalBest(ctx context.Context, swimmerID uuid.UUID, courseType, event string, timeMS int, excludeTimeID *uuid.UUID) (bool, error) {
 	return s.timeRepo.IsPersonalBest(ctx, swimmerID, courseType, event, int32(timeMS), excludeTimeID)
 }
+
+func toPersonalBest(row db.GetPersonalBestsRow) PersonalBest {
+	date := ""
+	if row.MeetDate.Valid {
+		date = row.MeetDate.Time.Format("2006-01-02")
+	}
+
+	return PersonalBest{
+		Event:         row.Event,
+		TimeMS:        int(row.TimeMs),
+		TimeFormatted: domain.FormatTime(int(row.TimeMs)),
+		TimeID:        row.ID.String(),
+		MeetName:      row.MeetName,
+		Date:          date,
+		TimingMethod:  row.TimingMethod,
+		Points:        timeservice.PointsFromBaseTime(row.BaseTimeMs, row.TimeMs),
+
+		OpenWaterConditions: timeservice.NewOpenWaterConditions(row.WaterTemperatureC, row.Wetsuit),
+	}
+}
@@ -0,0 +1,189 @@
+package comparison
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// forecastYears is how far ahead the date a standard is reached is searched.
+const forecastYears = 5
+
+// StandardForecast estimates when a standard is reached in an event. The cut
+// is that of the swimmer's age group on each day, so an age-up is taken into
+// account. LikelyDate is the first day the predicted time meets the cut and
+// EarliestDate the first day the lower edge of the band does.
+type StandardForecast struct {
+	StandardID     uuid.UUID `json:"standard_id"`
+	StandardName   string    `json:"standard_name"`
+	AgeGroup       string    `json:"age_group"`
+	StandardTimeMS int       `json:"standard_time_ms"`
+	Achieved       bool      `json:"achieved"`
+	LikelyDate     *string   `json:"likely_date"`
+	EarliestDate   *string   `json:"earliest_date"`
+	LikelyAgeGroup *string   `json:"likely_age_group,omitempty"`
+}
+
+// Prediction is the time predicted for an event on a date from the trend of
+// the swimmer's times against age, with a prediction band.
+type Prediction struct {
+	Event                  string            `json:"event"`
+	CourseType             string            `json:"course_type"`
+	Model                  string            `json:"model"`
+	SampleSize             int               `json:"sample_size"`
+	RMSEMS                 int               `json:"rmse_ms"`
+	BestTimeMS             int               `json:"best_time_ms"`
+	BestTimeFormatted      string            `json:"best_time_formatted"`
+	Date                   string            `json:"date"`
+	PredictedTimeMS        int               `json:"predicted_time_ms"`
+	PredictedTimeFormatted string            `json:"predicted_time_formatted"`
+	LowerMS                int               `json:"lower_ms"`
+	UpperMS                int               `json:"upper_ms"`
+	Confidence             float64           `json:"confidence"`
+	Standard               *StandardForecast `json:"standard,omitempty"`
+}
+
+// Predict fits a trend to the swimmer's times in an event and course and
+// predicts the time on a date, one year ahead by default. With a standard,
+// it also estimates when the standard is likely to be reached.
+func (s *ComparisonService) Predict(ctx context.Context, swimmerID uuid.UUID, event, courseType string, date *time.Time, model domain.TrendModel, standardID *uuid.UUID) (*Prediction, error) {
+	if !domain.CourseType(courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", courseType)
+	}
+	if !domain.EventCode(event).IsValid() {
+		return nil, fmt.Errorf("validation: invalid event: %s", event)
+	}
+	if !model.IsValid() {
+		return nil, fmt.Errorf("validation: model must be 'auto', 'log' or 'exponential'")
+	}
+
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+	birthDate := swimmer.BirthDate.Time
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	target := today.AddDate(1, 0, 0)
+	if date != nil {
+		if !date.After(birthDate) {
+			return nil, errors.New("validation: date must be after the swimmer's birth date")
+		}
+		target = *date
+	}
+
+	history, err := s.timeRepo.ListTimeHistory(ctx, swimmerID, &courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+	samples := trendSamples(history, event)
+	trend, err := domain.FitTrend(model, birthDate, samples)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotEnoughSwims) {
+			return nil, fmt.Errorf("validation: %w", err)
+		}
+		return nil, err
+	}
+
+	best := samples[0].TimeMS
+	for _, sample := range samples {
+		best = min(best, sample.TimeMS)
+	}
+	estimate := trend.Predict(target)
+	prediction := &Prediction{
+		Event:                  event,
+		CourseType:             courseType,
+		Model:                  string(trend.Model),
+		SampleSize:             trend.SampleSize(),
+		RMSEMS:                 trend.RMSE(),
+		BestTimeMS:             best,
+		BestTimeFormatted:      domain.FormatTime(best),
+		Date:                   target.Format("2006-01-02"),
+		PredictedTimeMS:        estimate.TimeMS,
+		PredictedTimeFormatted: domain.FormatTime(estimate.TimeMS),
+		LowerMS:                estimate.LowerMS,
+		UpperMS:                estimate.UpperMS,
+		Confidence:             domain.TrendConfidence,
+	}
+
+	if standardID == nil {
+		return prediction, nil
+	}
+
+	standards, err := s.trackedStandards(ctx, swimmer, []uuid.UUID{*standardID}, &courseType, nil)
+	if err != nil {
+		return nil, err
+	}
+	q, err := s.loadQualifyingTimes(ctx, &standards[0], swimmer)
+	if err != nil {
+		return nil, err
+	}
+	forecast, err := forecastStandard(trend, q, event, birthDate, best, today)
+	if err != nil {
+		return nil, err
+	}
+	prediction.Standard = forecast
+	return prediction, nil
+}
+
+// forecastStandard walks forward day by day until the predicted time, and the
+// lower edge of its band, meet the cut of the age group on that day.
+func forecastStandard(trend *domain.Trend, q *qualifyingTimes, event string, birthDate time.Time, best int, today time.Time) (*StandardForecast, error) {
+	ageGroup := string(q.scheme.AgeGroupAt(birthDate, today))
+	standardTime, usedAgeGroup, ok := getStandardTime(q.times, event, ageGroup)
+	if !ok {
+		return nil, fmt.Errorf("validation: standard %s has no time for %s", q.standard.Name, event)
+	}
+
+	forecast := &StandardForecast{
+		StandardID:     q.standard.ID,
+		StandardName:   q.standard.Name,
+		AgeGroup:       usedAgeGroup,
+		StandardTimeMS: int(standardTime),
+	}
+	todayFormatted := today.Format("2006-01-02")
+	if best <= int(standardTime) {
+		forecast.Achieved = true
+		forecast.LikelyDate = &todayFormatted
+		forecast.EarliestDate = &todayFormatted
+		forecast.LikelyAgeGroup = &usedAgeGroup
+		return forecast, nil
+	}
+
+	end := today.AddDate(forecastYears, 0, 0)
+	for day := today.AddDate(0, 0, 1); !day.After(end) && forecast.LikelyDate == nil; day = day.AddDate(0, 0, 1) {
+		cut, cutAgeGroup, ok := getStandardTime(q.times, event, string(q.scheme.AgeGroupAt(birthDate, day)))
+		if !ok {
+			continue
+		}
+		estimate := trend.Predict(day)
+		formatted := day.Format("2006-01-02")
+		if forecast.EarliestDate == nil && estimate.LowerMS <= int(cut) {
+			forecast.EarliestDate = &formatted
+		}
+		if estimate.TimeMS <= int(cut) {
+			forecast.LikelyDate = &formatted
+			forecast.LikelyAgeGroup = &cutAgeGroup
+		}
+	}
+	return forecast, nil
+}
+
+// trendSamples returns the swims in one event as trend samples.
+func trendSamples(history []db.ListTimeHistoryRow, event string) []domain.TrendSample {
+	var samples []domain.TrendSample
+	for _, row := range history {
+		if row.Event != event || !row.Date.Valid {
+			continue
+		}
+		samples = append(samples, domain.TrendSample{Date: row.Date.Time, TimeMS: int(row.TimeMs)})
+	}
+	return samples
+}
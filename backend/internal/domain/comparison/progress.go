@@ -2,24 +2,29 @@ package comparison
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/bpg/swimstats/backend/internal/domain"
+	timeservice "github.com/bpg/swimstats/backend/internal/domain/time"
+	"github.com/bpg/swimstats/backend/internal/store/db"
 	"github.com/bpg/swimstats/backend/internal/store/postgres"
 )
 
 // ProgressService provides time progression business logic.
 type ProgressService struct {
-	timeRepo *postgres.TimeRepository
+	timeRepo    *postgres.TimeRepository
+	swimmerRepo *postgres.SwimmerRepository
 }
 
 // NewProgressService creates a new progress service.
-func NewProgressService(timeRepo *postgres.TimeRepository) *ProgressService {
+func NewProgressService(timeRepo *postgres.TimeRepository, swimmerRepo *postgres.SwimmerRepository) *ProgressService {
 	return &ProgressService{
-		timeRepo: timeRepo,
+		timeRepo:    timeRepo,
+		swimmerRepo: swimmerRepo,
 	}
 }
 
@@ -33,9 +38,33 @@ type ProgressDataPoint struct {
 	MeetName       string `json:"meet_name"`
 	Event          string `json:"event"`
 	IsPersonalBest bool   `json:"is_pb"`
+	// Points are World Aquatics points, set when a base time is loaded.
+	Points *int `json:"points,omitempty"`
+	// OpenWaterConditions are reported for open-water swims.
+	timeservice.OpenWaterConditions
+}
+
+// TrendPoint is the fitted time on the date of a data point, with its
+// prediction band.
+type TrendPoint struct {
+	Date          string `json:"date"`
+	TimeMS        int    `json:"time_ms"`
+	TimeFormatted string `json:"time_formatted"`
+	LowerMS       int    `json:"lower_ms"`
+	UpperMS       int    `json:"upper_ms"`
+}
+
+// TrendLine is the curve fitted to the data points against the swimmer's age.
+type TrendLine struct {
+	Model      string       `json:"model"`
+	SampleSize int          `json:"sample_size"`
+	RMSEMS     int          `json:"rmse_ms"`
+	Confidence float64      `json:"confidence"`
+	Points     []TrendPoint `json:"points"`
 }
 
 // ProgressData represents the complete progress data for an event.
+// Trend is omitted when there are too few swims to fit one.
 type ProgressData struct {
 	SwimmerID  string              `json:"swimmer_id"`
 	Event      string              `json:"event"`
@@ -43,9 +72,11 @@ type ProgressData struct {
 	StartDate  *string             `json:"start_date,omitempty"`
 	EndDate    *string             `json:"end_date,omitempty"`
 	DataPoints []ProgressDataPoint `json:"data_points"`
+	Trend      *TrendLine          `json:"trend,omitempty"`
 }
 
-// GetProgressData retrieves time progression data for visualization.
+// GetProgressData retrieves time progression data for visualization, with a
+// trend line fitted to the data points using the given model.
 func (s *ProgressService) GetProgressData(
 	ctx context.Context,
 	swimmerID uuid.UUID,
@@ -53,6 +84,7 @@ func (s *ProgressService) GetProgressData(
 	event string,
 	startDate *time.Time,
 	endDate *time.Time,
+	model domain.TrendModel,
 ) (*ProgressData, error) {
 	// Validate inputs
 	if !domain.CourseType(courseType).IsValid() {
@@ -61,6 +93,9 @@ func (s *ProgressService) GetProgressData(
 	if !domain.EventCode(event).IsValid() {
 		return nil, fmt.Errorf("invalid event: %s", event)
 	}
+	if !model.IsValid() {
+		return nil, fmt.Errorf("validation: trend model must be 'auto', 'log' or 'exponential'")
+	}
 
 	// Query progress data
 	rows, err := s.timeRepo.GetProgressData(ctx, swimmerID, courseType, event, startDate, endDate)
@@ -85,6 +120,9 @@ func (s *ProgressService) GetProgressData(
 			MeetName:       row.MeetName,
 			Event:          row.Event,
 			IsPersonalBest: row.IsPb,
+			Points:         timeservice.PointsFromBaseTime(row.BaseTimeMs, row.TimeMs),
+
+			OpenWaterConditions: timeservice.NewOpenWaterConditions(row.WaterTemperatureC, row.Wetsuit),
 		}
 	}
 
@@ -105,5 +143,57 @@ func (s *ProgressService) GetProgressData(
 		result.EndDate = &end
 	}
 
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+	trend, err := trendLine(rows, swimmer.BirthDate.Time, model)
+	if err != nil {
+		return nil, err
+	}
+	result.Trend = trend
+
 	return result, nil
 }
+
+// trendLine fits a trend to the progress rows and evaluates it on each date
+// with a swim. It returns nil when there are too few swims.
+func trendLine(rows []db.GetProgressDataRow, birthDate time.Time, model domain.TrendModel) (*TrendLine, error) {
+	samples := make([]domain.TrendSample, 0, len(rows))
+	for _, row := range rows {
+		if row.Date.Valid {
+			samples = append(samples, domain.TrendSample{Date: row.Date.Time, TimeMS: int(row.TimeMs)})
+		}
+	}
+	trend, err := domain.FitTrend(model, birthDate, samples)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotEnoughSwims) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	line := &TrendLine{
+		Model:      string(trend.Model),
+		SampleSize: trend.SampleSize(),
+		RMSEMS:     trend.RMSE(),
+		Confidence: domain.TrendConfidence,
+		Points:     []TrendPoint{},
+	}
+	seen := make(map[time.Time]bool, len(samples))
+	for _, sample := range samples {
+		if seen[sample.Date] {
+			continue
+		}
+		seen[sample.Date] = true
+		estimate := trend.Predict(sample.Date)
+		line.Points = append(line.Points, TrendPoint{
+			Date:          sample.Date.Format("2006-01-02"),
+			TimeMS:        estimate.TimeMS,
+			TimeFormatted: domain.FormatTime(estimate.TimeMS),
+			LowerMS:       estimate.LowerMS,
+			UpperMS:       estimate.UpperMS,
+		})
+	}
+	return line, nil
+}
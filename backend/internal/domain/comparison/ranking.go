@@ -0,0 +1,229 @@
+package comparison
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/domain/ranking"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// RankingTargetPlaces are the places reported as targets in a ranking: the
+// top 8, 16 and 24, matching the A, B and C finals.
+var RankingTargetPlaces = []int{8, 16, 24}
+
+// RankingComparisonService places a swimmer's personal bests in imported
+// ranking lists.
+type RankingComparisonService struct {
+	comparisonService *ComparisonService
+	rankingService    *ranking.Service
+}
+
+// NewRankingComparisonService creates a new ranking comparison service.
+func NewRankingComparisonService(comparisonService *ComparisonService, rankingService *ranking.Service) *RankingComparisonService {
+	return &RankingComparisonService{
+		comparisonService: comparisonService,
+		rankingService:    rankingService,
+	}
+}
+
+// RankingTarget is the time needed to place in the top N of a ranking list.
+// GapMS is how much the personal best must drop, zero once the place is reached.
+type RankingTarget struct {
+	Place         int     `json:"place"`
+	TimeMS        int     `json:"time_ms"`
+	TimeFormatted string  `json:"time_formatted"`
+	Achieved      bool    `json:"achieved"`
+	GapMS         *int    `json:"gap_ms"`
+	GapFormatted  *string `json:"gap_formatted"`
+}
+
+// SnapshotRanking is where the swimmer's personal best would place in one
+// ranking snapshot. Rank counts the listed times strictly faster than the
+// personal best, so a tie shares the rank; a rank past the end of the list
+// means the time would not make it. Percentile is the share of the listed
+// times that the personal best equals or beats.
+type SnapshotRanking struct {
+	SnapshotID           uuid.UUID       `json:"snapshot_id"`
+	Source               string          `json:"source"`
+	Season               string          `json:"season"`
+	Event                string          `json:"event"`
+	CourseType           string          `json:"course_type"`
+	AgeGroup             string          `json:"age_group"`
+	ListSize             int             `json:"list_size"`
+	SwimmerTimeMS        *int            `json:"swimmer_time_ms"`
+	SwimmerTimeFormatted *string         `json:"swimmer_time_formatted"`
+	SwimmerDate          *string         `json:"swimmer_date"`
+	Rank                 *int            `json:"rank"`
+	InList               bool            `json:"in_list"`
+	Percentile           *float64        `json:"percentile"`
+	Targets              []RankingTarget `json:"targets"`
+}
+
+// RankingComparison places the swimmer's personal bests in every ranking
+// snapshot that applies.
+type RankingComparison struct {
+	SwimmerID string            `json:"swimmer_id"`
+	Rankings  []SnapshotRanking `json:"rankings"`
+}
+
+// CompareRankings places the swimmer's personal bests in the ranking
+// snapshots of the swimmer's gender, optionally for a single season or
+// course type. A snapshot applies when its age group is the one of the
+// swimmer's category at the start of that season, or open. The personal best used is the fastest
+// time swum up to the end of the snapshot's season.
+func (s *RankingComparisonService) CompareRankings(ctx context.Context, swimmerID uuid.UUID, season, courseType *string) (*RankingComparison, error) {
+	filter := ranking.Filter{}
+	if season != nil {
+		if _, _, err := domain.SeasonDates(*season); err != nil {
+			return nil, fmt.Errorf("validation: %w", err)
+		}
+		filter.Season = *season
+	}
+	if courseType != nil {
+		if !domain.CourseType(*courseType).IsValid() {
+			return nil, fmt.Errorf("validation: invalid course type: %s", *courseType)
+		}
+		filter.CourseType = *courseType
+	}
+
+	swimmer, err := s.comparisonService.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+	filter.Gender = swimmer.Gender
+
+	list, err := s.rankingService.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Personal bests by season and course, then by event
+	type bestsKey struct{ season, courseType string }
+	bests := make(map[bestsKey]map[string]db.GetPersonalBestsRow)
+
+	scheme := domain.Category(swimmer.Category).AgeScheme()
+	comparison := &RankingComparison{
+		SwimmerID: swimmerID.String(),
+		Rankings:  make([]SnapshotRanking, 0, len(list.Snapshots)),
+	}
+	for _, snapshot := range list.Snapshots {
+		start, end, err := domain.SeasonDates(snapshot.Season)
+		if err != nil {
+			continue
+		}
+		ageGroup := scheme.AgeGroupAt(swimmer.BirthDate.Time, start)
+		if domain.AgeGroup(snapshot.AgeGroup) != ageGroup && domain.AgeGroup(snapshot.AgeGroup) != domain.AgeGroupOpen {
+			continue
+		}
+
+		key := bestsKey{snapshot.Season, snapshot.CourseType}
+		byEvent, ok := bests[key]
+		if !ok {
+			params := postgres.PersonalBestsParams{SwimmerID: swimmerID, CourseType: snapshot.CourseType}
+			if end.Before(time.Now()) {
+				params.AsOf = &end
+			}
+			rows, err := s.comparisonService.timeRepo.ListPersonalBests(ctx, params)
+			if err != nil {
+				return nil, err
+			}
+			byEvent = make(map[string]db.GetPersonalBestsRow, len(rows))
+			for _, row := range rows {
+				byEvent[row.Event] = row
+			}
+			bests[key] = byEvent
+		}
+
+		withEntries, err := s.rankingService.Get(ctx, snapshot.ID)
+		if err != nil {
+			return nil, err
+		}
+		var best *db.GetPersonalBestsRow
+		if row, ok := byEvent[snapshot.Event]; ok {
+			best = &row
+		}
+		comparison.Rankings = append(comparison.Rankings, rankSnapshot(withEntries, best))
+	}
+
+	sort.SliceStable(comparison.Rankings, func(i, j int) bool {
+		a, b := comparison.Rankings[i], comparison.Rankings[j]
+		if a.Season != b.Season {
+			return a.Season > b.Season
+		}
+		if a.CourseType != b.CourseType {
+			return a.CourseType < b.CourseType
+		}
+		if a.Event != b.Event {
+			return domain.EventSortOrder(a.Event) < domain.EventSortOrder(b.Event)
+		}
+		return a.Source < b.Source
+	})
+	return comparison, nil
+}
+
+// rankSnapshot places a personal best, if any, in a snapshot whose entries
+// are sorted fastest first.
+func rankSnapshot(snapshot *ranking.SnapshotWithEntries, best *db.GetPersonalBestsRow) SnapshotRanking {
+	n := len(snapshot.Entries)
+	sr := SnapshotRanking{
+		SnapshotID: snapshot.ID,
+		Source:     snapshot.Source,
+		Season:     snapshot.Season,
+		Event:      snapshot.Event,
+		CourseType: snapshot.CourseType,
+		AgeGroup:   snapshot.AgeGroup,
+		ListSize:   n,
+		Targets:    []RankingTarget{},
+	}
+
+	var bestMS int
+	if best != nil {
+		bestMS = int(best.TimeMs)
+		formatted := domain.FormatTime(bestMS)
+		sr.SwimmerTimeMS = &bestMS
+		sr.SwimmerTimeFormatted = &formatted
+		date := best.MeetDate.Time
+		if best.EventDate.Valid {
+			date = best.EventDate.Time
+		}
+		dateStr := date.Format("2006-01-02")
+		sr.SwimmerDate = &dateStr
+
+		faster := sort.Search(n, func(i int) bool { return snapshot.Entries[i].TimeMS >= bestMS })
+		rank := faster + 1
+		sr.Rank = &rank
+		sr.InList = rank <= n
+		if n > 0 {
+			percentile := float64(n-faster) / float64(n) * 100
+			sr.Percentile = &percentile
+		}
+	}
+
+	for _, place := range RankingTargetPlaces {
+		if place > n {
+			break
+		}
+		entry := snapshot.Entries[place-1]
+		target := RankingTarget{
+			Place:         place,
+			TimeMS:        entry.TimeMS,
+			TimeFormatted: entry.TimeFormatted,
+		}
+		if best != nil {
+			gap := max(bestMS-entry.TimeMS, 0)
+			formatted := domain.FormatDifference(gap)
+			target.Achieved = gap == 0
+			target.GapMS = &gap
+			target.GapFormatted = &formatted
+		}
+		sr.Targets = append(sr.Targets, target)
+	}
+	return sr
+}
@@ -0,0 +1,185 @@
+package comparison
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// ReactionTimeService provides start reaction time analysis.
+type ReactionTimeService struct {
+	timeRepo *postgres.TimeRepository
+}
+
+// NewReactionTimeService creates a new reaction time service.
+func NewReactionTimeService(timeRepo *postgres.TimeRepository) *ReactionTimeService {
+	return &ReactionTimeService{
+		timeRepo: timeRepo,
+	}
+}
+
+// ReactionTimeStats summarizes a group of reaction times.
+// Average and best ignore readings in the false-start range.
+type ReactionTimeStats struct {
+	Count        int  `json:"count"`
+	AverageMS    *int `json:"average_ms,omitempty"`
+	BestMS       *int `json:"best_ms,omitempty"`
+	FlaggedCount int  `json:"flagged_count"`
+}
+
+// SeasonReactionTimes holds reaction time statistics for one season.
+type SeasonReactionTimes struct {
+	Season string `json:"season"`
+	ReactionTimeStats
+	// ChangeMS is the change in average from the previous season; negative is quicker.
+	ChangeMS *int `json:"change_ms,omitempty"`
+}
+
+// StrokeReactionTimes holds reaction time statistics for one stroke.
+type StrokeReactionTimes struct {
+	Stroke string `json:"stroke"`
+	ReactionTimeStats
+	Seasons []SeasonReactionTimes `json:"seasons"`
+}
+
+// ReactionTimePoint is a single recorded reaction time.
+type ReactionTimePoint struct {
+	TimeID          string `json:"time_id"`
+	Event           string `json:"event"`
+	Stroke          string `json:"stroke"`
+	ReactionTimeMS  int    `json:"reaction_time_ms"`
+	Date            string `json:"date"`
+	Season          string `json:"season"`
+	MeetName        string `json:"meet"`
+	CourseType      string `json:"course_type"`
+	FalseStartRange bool   `json:"false_start_range"`
+}
+
+// ReactionTimeAnalysis is the complete reaction time analysis for a swimmer.
+type ReactionTimeAnalysis struct {
+	SwimmerID             string                `json:"swimmer_id"`
+	CourseType            *string               `json:"course_type,omitempty"`
+	FalseStartThresholdMS int                   `json:"false_start_threshold_ms"`
+	Overall               ReactionTimeStats     `json:"overall"`
+	ByStroke              []StrokeReactionTimes `json:"by_stroke"`
+	BySeason              []SeasonReactionTimes `json:"by_season"`
+	Trend                 []ReactionTimePoint   `json:"trend"`
+	Flagged               []ReactionTimePoint   `json:"flagged"`
+}
+
+// GetAnalysis summarizes a swimmer's reaction times by stroke and season.
+func (s *ReactionTimeService) GetAnalysis(ctx context.Context, swimmerID uuid.UUID, courseType *string) (*ReactionTimeAnalysis, error) {
+	if courseType != nil && !domain.CourseType(*courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", *courseType)
+	}
+
+	rows, err := s.timeRepo.ListReactionTimes(ctx, swimmerID, courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list reaction times: %w", err)
+	}
+
+	// Rows are ordered by date, so the trend is already chronological
+	points := make([]ReactionTimePoint, len(rows))
+	flagged := []ReactionTimePoint{}
+	for i, row := range rows {
+		date := ""
+		season := ""
+		if row.Date.Valid {
+			date = row.Date.Time.Format("2006-01-02")
+			season = domain.SeasonForDate(row.Date.Time)
+		}
+
+		points[i] = ReactionTimePoint{
+			TimeID:          row.ID.String(),
+			Event:           row.Event,
+			Stroke:          domain.EventCode(row.Event).Stroke(),
+			ReactionTimeMS:  int(row.ReactionTimeMs),
+			Date:            date,
+			Season:          season,
+			MeetName:        row.MeetName,
+			CourseType:      row.CourseType,
+			FalseStartRange: domain.IsFalseStartRange(int(row.ReactionTimeMs)),
+		}
+		if points[i].FalseStartRange {
+			flagged = append(flagged, points[i])
+		}
+	}
+
+	byStroke := []StrokeReactionTimes{}
+	for _, stroke := range domain.Strokes {
+		var strokePoints []ReactionTimePoint
+		for _, p := range points {
+			if p.Stroke == stroke.Name() {
+				strokePoints = append(strokePoints, p)
+			}
+		}
+		if len(strokePoints) == 0 {
+			continue
+		}
+		byStroke = append(byStroke, StrokeReactionTimes{
+			Stroke:            stroke.Name(),
+			ReactionTimeStats: reactionTimeStats(strokePoints),
+			Seasons:           seasonReactionTimes(strokePoints),
+		})
+	}
+
+	return &ReactionTimeAnalysis{
+		SwimmerID:             swimmerID.String(),
+		CourseType:            courseType,
+		FalseStartThresholdMS: domain.FalseStartThresholdMS,
+		Overall:               reactionTimeStats(points),
+		ByStroke:              byStroke,
+		BySeason:              seasonReactionTimes(points),
+		Trend:                 points,
+		Flagged:               flagged,
+	}, nil
+}
+
+// seasonReactionTimes groups chronologically ordered points by season.
+func seasonReactionTimes(points []ReactionTimePoint) []SeasonReactionTimes {
+	seasons := []SeasonReactionTimes{}
+	start := 0
+	for i := 1; i <= len(points); i++ {
+		if i < len(points) && points[i].Season == points[start].Season {
+			continue
+		}
+		season := SeasonReactionTimes{
+			Season:            points[start].Season,
+			ReactionTimeStats: reactionTimeStats(points[start:i]),
+		}
+		if n := len(seasons); n > 0 && season.AverageMS != nil && seasons[n-1].AverageMS != nil {
+			change := *season.AverageMS - *seasons[n-1].AverageMS
+			season.ChangeMS = &change
+		}
+		seasons = append(seasons, season)
+		start = i
+	}
+	return seasons
+}
+
+// reactionTimeStats computes count, average and best for a set of points.
+func reactionTimeStats(points []ReactionTimePoint) ReactionTimeStats {
+	stats := ReactionTimeStats{Count: len(points)}
+	sum, valid := 0, 0
+	for _, p := range points {
+		if p.FalseStartRange {
+			stats.FlaggedCount++
+			continue
+		}
+		sum += p.ReactionTimeMS
+		valid++
+		if stats.BestMS == nil || p.ReactionTimeMS < *stats.BestMS {
+			best := p.ReactionTimeMS
+			stats.BestMS = &best
+		}
+	}
+	if valid > 0 {
+		avg := (sum + valid/2) / valid
+		stats.AverageMS = &avg
+	}
+	return stats
+}
@@ -8,15 +8,17 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/domain/threshold"
 	"github.com/bpg/swimstats/backend/internal/store/db"
 	"github.com/bpg/swimstats/backend/internal/store/postgres"
 )
 
 // ComparisonService provides time comparison business logic.
 type ComparisonService struct {
-	timeRepo     *postgres.TimeRepository
-	standardRepo *postgres.StandardRepository
-	swimmerRepo  *postgres.SwimmerRepository
+	timeRepo      *postgres.TimeRepository
+	standardRepo  *postgres.StandardRepository
+	swimmerRepo   *postgres.SwimmerRepository
+	thresholdRepo *postgres.ThresholdRepository
 }
 
 // NewComparisonService creates a new comparison service.
@@ -24,11 +26,13 @@ func NewComparisonService(
 	timeRepo *postgres.TimeRepository,
 	standardRepo *postgres.StandardRepository,
 	swimmerRepo *postgres.SwimmerRepository,
+	thresholdRepo *postgres.ThresholdRepository,
 ) *ComparisonService {
 	return &ComparisonService{
-		timeRepo:     timeRepo,
-		standardRepo: standardRepo,
-		swimmerRepo:  swimmerRepo,
+		timeRepo:      timeRepo,
+		standardRepo:  standardRepo,
+		swimmerRepo:   swimmerRepo,
+		thresholdRepo: thresholdRepo,
 	}
 }
 
@@ -55,9 +59,19 @@ type EventComparison struct {
 	DifferenceFormatted   *string          `json:"difference_formatted"`
 	DifferencePercent     *float64         `json:"difference_percent"`
 	AgeGroup              string           `json:"age_group"`
+	SportClass            *string          `json:"sport_class,omitempty"`
 	MeetName              *string          `json:"meet_name"`
 	Date                  *string          `json:"date"`
 
+	// Threshold is the "almost there" threshold used for this event and where
+	// it came from; it is set when the standard has a time for the event.
+	Threshold *threshold.Applied `json:"threshold,omitempty"`
+
+	// Timing of the swimmer's time; AdjustmentMS is set when a hand-timing
+	// adjustment was added before comparing (already included in SwimmerTimeMS).
+	TimingMethod *string `json:"timing_method,omitempty"`
+	AdjustmentMS *int    `json:"adjustment_ms,omitempty"`
+
 	// Adjacent age groups
 	PrevAgeGroup              *string `json:"prev_age_group,omitempty"`
 	PrevStandardTimeMS        *int    `json:"prev_standard_time_ms,omitempty"`
@@ -81,32 +95,60 @@ type ComparisonSummary struct {
 
 // ComparisonResult represents the full comparison result.
 type ComparisonResult struct {
-	StandardID       uuid.UUID         `json:"standard_id"`
-	StandardName     string            `json:"standard_name"`
-	CourseType       string            `json:"course_type"`
-	SwimmerName      string            `json:"swimmer_name"`
-	SwimmerAgeGroup  string            `json:"swimmer_age_group"`
-	ThresholdPercent float64           `json:"threshold_percent"`
-	Comparisons      []EventComparison `json:"comparisons"`
-	Summary          ComparisonSummary `json:"summary"`
+	StandardID      uuid.UUID `json:"standard_id"`
+	StandardName    string    `json:"standard_name"`
+	CourseType      string    `json:"course_type"`
+	SwimmerName     string    `json:"swimmer_name"`
+	SwimmerAgeGroup string    `json:"swimmer_age_group"`
+	// AsOfDate is set when the comparison is evaluated as it stood on a past date.
+	AsOfDate         *string `json:"as_of_date,omitempty"`
+	ThresholdPercent float64 `json:"threshold_percent"`
+	// ThresholdResolution lists the threshold sources from the most to the
+	// least specific; each comparison reports the source it used.
+	ThresholdResolution []threshold.Source `json:"threshold_resolution"`
+	ManualTimePolicy    string             `json:"manual_time_policy"`
+	Category            string             `json:"category"`
+	Comparisons         []EventComparison  `json:"comparisons"`
+	Summary             ComparisonSummary  `json:"summary"`
 }
 
 // DefaultThresholdPercent is the default "almost there" threshold.
 const DefaultThresholdPercent = 3.0
 
 // Compare compares a swimmer's personal bests against a standard.
-func (s *ComparisonService) Compare(ctx context.Context, swimmerID, standardID uuid.UUID, courseType string, thresholdPercent *float64) (*ComparisonResult, error) {
+// thresholdPercent is the default "almost there" threshold; thresholds set for
+// the standard, an event or a distance bucket take precedence over it.
+// When asOf is set, the comparison is evaluated as it stood on that date: only
+// times swum up to that date count and the age group is the one that applied then.
+func (s *ComparisonService) Compare(ctx context.Context, swimmerID, standardID uuid.UUID, courseType string, thresholdPercent *float64, asOf *time.Time) (*ComparisonResult, error) {
 	// Get swimmer
 	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
 	if err != nil {
 		return nil, fmt.Errorf("get swimmer: %w", err)
 	}
 
+	evaluationDate := time.Now()
+	var asOfDate *string
+	if asOf != nil {
+		if asOf.After(evaluationDate) {
+			return nil, fmt.Errorf("validation: date must not be in the future")
+		}
+		if asOf.Before(swimmer.BirthDate.Time) {
+			return nil, fmt.Errorf("validation: date must not be before the swimmer's birth date")
+		}
+		evaluationDate = *asOf
+		formatted := asOf.Format("2006-01-02")
+		asOfDate = &formatted
+	}
+
 	// Get standard
 	standard, err := s.standardRepo.Get(ctx, standardID)
 	if err != nil {
 		return nil, fmt.Errorf("get standard: %w", err)
 	}
+	if !domain.Gender(standard.Gender).AppliesTo(domain.Gender(swimmer.Gender)) {
+		return nil, fmt.Errorf("validation: a %s standard does not apply to a %s swimmer", standard.Gender, swimmer.Gender)
+	}
 
 	// Get standard times
 	standardTimes, err := s.standardRepo.ListTimes(ctx, standardID)
@@ -114,56 +156,74 @@ func (s *ComparisonService) Compare(ctx context.Context, swimmerID, standardID u
 		return nil, fmt.Errorf("get standard times: %w", err)
 	}
 
-	// Build standard times map: event -> age_group -> time_ms
-	stdTimesMap := make(map[string]map[string]int32)
-	for _, st := range standardTimes {
-		if stdTimesMap[st.Event] == nil {
-			stdTimesMap[st.Event] = make(map[string]int32)
-		}
-		stdTimesMap[st.Event][st.AgeGroup] = st.TimeMs
-	}
+	classes := swimmerSportClasses(swimmer)
+	stdTimesMap := buildStandardTimesMap(standardTimes, classes)
 
-	// Get swimmer's personal bests for this course type
-	pbs, err := s.timeRepo.GetPersonalBests(ctx, swimmerID, courseType)
+	// Get swimmer's personal bests for this course type, applying the
+	// standard's policy for manual and semi-automatic times
+	pbParams := personalBestParams(standard, swimmer, courseType)
+	pbParams.AsOf = asOf
+	pbs, err := s.timeRepo.GetAdjustedPersonalBests(ctx, pbParams)
 	if err != nil {
 		return nil, fmt.Errorf("get personal bests: %w", err)
 	}
 
 	// Build PB map: event -> PB row
-	pbMap := make(map[string]db.GetPersonalBestsRow)
+	pbMap := make(map[string]db.GetAdjustedPersonalBestsRow)
 	for _, pb := range pbs {
 		pbMap[pb.Event] = pb
 	}
 
 	// Determine threshold
-	threshold := DefaultThresholdPercent
+	defaultThreshold := DefaultThresholdPercent
 	if thresholdPercent != nil {
-		threshold = *thresholdPercent
+		defaultThreshold = *thresholdPercent
+	}
+	thresholdRows, err := s.thresholdRepo.ListForStandard(ctx, standardID)
+	if err != nil {
+		return nil, fmt.Errorf("get thresholds: %w", err)
+	}
+	thresholds := make([]threshold.Threshold, len(thresholdRows))
+	for i, row := range thresholdRows {
+		thresholds[i] = threshold.ToThreshold(row)
 	}
+	resolver := threshold.NewResolver(thresholds, defaultThreshold)
 
-	// Calculate swimmer's current age group for display
-	currentAge := domain.AgeAtDate(swimmer.BirthDate.Time, time.Now())
-	currentAgeGroup := string(domain.AgeGroupFromAge(currentAge))
+	// Calculate swimmer's age group on the evaluation date using the standard's
+	// age scheme, so masters standards are matched on masters age bands
+	scheme := domain.Category(standard.Category).AgeScheme()
+	currentAgeGroup := string(scheme.AgeGroupAt(swimmer.BirthDate.Time, evaluationDate))
 
-	// Build comparisons for all events
-	allEvents := domain.ValidEventCodes
+	// Build comparisons for every catalogued event swum in this course
+	allEvents := domain.EventsForCourse(domain.CourseType(courseType))
 	comparisons := make([]EventComparison, 0, len(allEvents))
 	summary := ComparisonSummary{}
 
-	for _, event := range allEvents {
+	for _, catalogued := range allEvents {
+		event := catalogued.Code
 		comp := EventComparison{
 			Event:    string(event),
 			AgeGroup: currentAgeGroup,
 		}
+		if class := classes.ForEvent(event); class != "" {
+			sportClass := string(class)
+			comp.SportClass = &sportClass
+		}
 
 		pb, hasPB := pbMap[string(event)]
 
 		if hasPB {
-			swimmerTime := int(pb.TimeMs)
+			swimmerTime := int(pb.AdjustedTimeMs)
 			swimmerTimeFormatted := domain.FormatTime(swimmerTime)
 			comp.SwimmerTimeMS = &swimmerTime
 			comp.SwimmerTimeFormatted = &swimmerTimeFormatted
 
+			timingMethod := pb.TimingMethod
+			comp.TimingMethod = &timingMethod
+			if adjustment := int(pb.AdjustedTimeMs - pb.TimeMs); adjustment > 0 {
+				comp.AdjustmentMS = &adjustment
+			}
+
 			meetName := pb.MeetName
 			comp.MeetName = &meetName
 
@@ -187,7 +247,7 @@ func (s *ComparisonService) Compare(ctx context.Context, swimmerID, standardID u
 
 				// Calculate difference
 				diff := swimmerTime - standardTime
-				diffFormatted := formatDifference(diff)
+				diffFormatted := domain.FormatDifference(diff)
 				comp.DifferenceMS = &diff
 				comp.DifferenceFormatted = &diffFormatted
 
@@ -196,11 +256,13 @@ func (s *ComparisonService) Compare(ctx context.Context, swimmerID, standardID u
 				comp.DifferencePercent = &diffPercent
 
 				// Determine status
+				applied := resolver.Resolve(event)
+				comp.Threshold = &applied
 				switch {
 				case diff <= 0:
 					comp.Status = StatusAchieved
 					summary.Achieved++
-				case diffPercent <= threshold:
+				case applied.IsAlmost(diff, standardTime):
 					comp.Status = StatusAlmost
 					summary.Almost++
 				default:
@@ -212,7 +274,7 @@ func (s *ComparisonService) Compare(ctx context.Context, swimmerID, standardID u
 			}
 
 			// Check previous age group (relative to current age)
-			prevAG := domain.PreviousAgeGroup(domain.AgeGroup(currentAgeGroup))
+			prevAG := scheme.Previous(domain.AgeGroup(currentAgeGroup))
 			if prevAG != "" {
 				prevStdTimeMS, hasPrevStandard := getStandardTimeExact(stdTimesMap, string(event), string(prevAG))
 				if hasPrevStandard {
@@ -228,7 +290,7 @@ func (s *ComparisonService) Compare(ctx context.Context, swimmerID, standardID u
 			}
 
 			// Check next age group (relative to current age)
-			nextAG := domain.NextAgeGroup(domain.AgeGroup(currentAgeGroup))
+			nextAG := scheme.Next(domain.AgeGroup(currentAgeGroup))
 			if nextAG != "" {
 				nextStdTimeMS, hasNextStandard := getStandardTimeExact(stdTimesMap, string(event), string(nextAG))
 				if hasNextStandard {
@@ -254,10 +316,13 @@ func (s *ComparisonService) Compare(ctx context.Context, swimmerID, standardID u
 				standardTimeFormatted := domain.FormatTime(standardTime)
 				comp.StandardTimeMS = &standardTime
 				comp.StandardTimeFormatted = &standardTimeFormatted
+
+				applied := resolver.Resolve(event)
+				comp.Threshold = &applied
 			}
 
 			// Check previous age group (even without PB)
-			prevAG := domain.PreviousAgeGroup(domain.AgeGroup(currentAgeGroup))
+			prevAG := scheme.Previous(domain.AgeGroup(currentAgeGroup))
 			if prevAG != "" {
 				prevStdTimeMS, hasPrevStandard := getStandardTimeExact(stdTimesMap, string(event), string(prevAG))
 				if hasPrevStandard {
@@ -271,7 +336,7 @@ func (s *ComparisonService) Compare(ctx context.Context, swimmerID, standardID u
 			}
 
 			// Check next age group (even without PB)
-			nextAG := domain.NextAgeGroup(domain.AgeGroup(currentAgeGroup))
+			nextAG := scheme.Next(domain.AgeGroup(currentAgeGroup))
 			if nextAG != "" {
 				nextStdTimeMS, hasNextStandard := getStandardTimeExact(stdTimesMap, string(event), string(nextAG))
 				if hasNextStandard {
@@ -293,17 +358,59 @@ func (s *ComparisonService) Compare(ctx context.Context, swimmerID, standardID u
 	}
 
 	return &ComparisonResult{
-		StandardID:       standardID,
-		StandardName:     standard.Name,
-		CourseType:       courseType,
-		SwimmerName:      swimmer.Name,
-		SwimmerAgeGroup:  currentAgeGroup,
-		ThresholdPercent: threshold,
-		Comparisons:      comparisons,
-		Summary:          summary,
+		StandardID:          standardID,
+		StandardName:        standard.Name,
+		CourseType:          courseType,
+		SwimmerName:         swimmer.Name,
+		SwimmerAgeGroup:     currentAgeGroup,
+		AsOfDate:            asOfDate,
+		ThresholdPercent:    defaultThreshold,
+		ThresholdResolution: threshold.ResolutionOrder,
+		ManualTimePolicy:    standard.ManualTimePolicy,
+		Category:            standard.Category,
+		Comparisons:         comparisons,
+		Summary:             summary,
 	}, nil
 }
 
+// personalBestParams returns the parameters to fetch a swimmer's personal
+// bests in a course, applying the standard's policy for hand-recorded times.
+func personalBestParams(standard *db.TimeStandard, swimmer *db.Swimmer, courseType string) postgres.AdjustedPersonalBestsParams {
+	params := postgres.AdjustedPersonalBestsParams{
+		SwimmerID:        swimmer.ID,
+		CourseType:       courseType,
+		IncludeHandTimed: true,
+	}
+	switch domain.ManualTimePolicy(standard.ManualTimePolicy) {
+	case domain.ManualTimeAdjust:
+		params.ManualAdjustmentMS = swimmer.ManualAdjustmentMs
+		params.SemiAutomaticAdjustmentMS = swimmer.SemiAutomaticAdjustmentMs
+	case domain.ManualTimeIneligible:
+		params.IncludeHandTimed = false
+	}
+	return params
+}
+
+// buildStandardTimesMap builds the map event -> age_group -> time_ms. Times for the
+// swimmer's sport class take precedence over times that apply to everyone,
+// and times for other sport classes are ignored.
+func buildStandardTimesMap(standardTimes []db.StandardTime, classes domain.SportClasses) map[string]map[string]int32 {
+	stdTimesMap := make(map[string]map[string]int32)
+	for _, st := range standardTimes {
+		if st.SportClass != "" && domain.SportClass(st.SportClass) != classes.ForEvent(domain.EventCode(st.Event)) {
+			continue
+		}
+		if stdTimesMap[st.Event] == nil {
+			stdTimesMap[st.Event] = make(map[string]int32)
+		}
+		if _, exists := stdTimesMap[st.Event][st.AgeGroup]; exists && st.SportClass == "" {
+			continue
+		}
+		stdTimesMap[st.Event][st.AgeGroup] = st.TimeMs
+	}
+	return stdTimesMap
+}
+
 // getStandardTime looks up a standard time, trying the specific age group first,
 // then falling back to OPEN if not found. Returns the time, the age group that was used, and whether found.
 func getStandardTime(stdTimesMap map[string]map[string]int32, event, ageGroup string) (int32, string, bool) {
@@ -329,20 +436,3 @@ func getStandardTimeExact(stdTimesMap map[string]map[string]int32, event, ageGro
 	}
 	return 0, false
 }
-
-// formatDifference formats a time difference in milliseconds.
-func formatDifference(diffMS int) string {
-	if diffMS == 0 {
-		return "0.00"
-	}
-
-	prefix := ""
-	if diffMS < 0 {
-		prefix = "-"
-		diffMS = -diffMS
-	} else {
-		prefix = "+"
-	}
-
-	return prefix + domain.FormatTime(diffMS)
-}
@@ -0,0 +1,238 @@
+package comparison
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// recentSwims is how many of the latest swims in an event measure consistency.
+const recentSwims = 5
+
+// mostImprovedCount is how many events are listed as most improved.
+const mostImprovedCount = 5
+
+// EventStatistics summarizes the improvement in one event and course. Drops
+// are positive when the swimmer got faster. SeasonDropMS compares the season
+// best with the best before the season, or with the first swim of the season
+// for events first swum this season. RecentSpreadMS is the standard deviation
+// of the latest swims.
+type EventStatistics struct {
+	Event                string   `json:"event"`
+	CourseType           string   `json:"course_type"`
+	SwimCount            int      `json:"swim_count"`
+	MeetCount            int      `json:"meet_count"`
+	FirstTimeMS          int      `json:"first_time_ms"`
+	FirstDate            string   `json:"first_date"`
+	BestTimeMS           int      `json:"best_time_ms"`
+	BestTimeFormatted    string   `json:"best_time_formatted"`
+	BestDate             string   `json:"best_date"`
+	LatestTimeMS         int      `json:"latest_time_ms"`
+	LatestDate           string   `json:"latest_date"`
+	TotalDropMS          int      `json:"total_drop_ms"`
+	TotalDropFormatted   string   `json:"total_drop_formatted"`
+	TotalDropPercent     float64  `json:"total_drop_percent"`
+	SeasonDropMS         *int     `json:"season_drop_ms"`
+	SeasonDropPercent    *float64 `json:"season_drop_percent"`
+	AverageDropPerMeetMS *int     `json:"average_drop_per_meet_ms"`
+	RecentSwimCount      int      `json:"recent_swim_count"`
+	RecentSpreadMS       *int     `json:"recent_spread_ms"`
+	RecentSpreadPercent  *float64 `json:"recent_spread_percent"`
+	SeasonSwimCount      int      `json:"season_swim_count"`
+	SeasonBestTimeMS     *int     `json:"season_best_time_ms"`
+	SeasonBestFormatted  *string  `json:"season_best_formatted"`
+}
+
+// ImprovedEvent is an entry in a most-improved ranking.
+type ImprovedEvent struct {
+	Rank        int     `json:"rank"`
+	Event       string  `json:"event"`
+	CourseType  string  `json:"course_type"`
+	DropMS      int     `json:"drop_ms"`
+	DropPercent float64 `json:"drop_percent"`
+}
+
+// ImprovementStatistics aggregates improvement per event and course from the
+// swimmer's time history.
+type ImprovementStatistics struct {
+	SwimmerID              string            `json:"swimmer_id"`
+	CourseType             *string           `json:"course_type,omitempty"`
+	Season                 string            `json:"season"`
+	TotalSwims             int               `json:"total_swims"`
+	Events                 []EventStatistics `json:"events"`
+	MostImproved           []ImprovedEvent   `json:"most_improved"`
+	MostImprovedThisSeason []ImprovedEvent   `json:"most_improved_this_season"`
+}
+
+// GetImprovementStatistics computes per event and course the total drop since
+// the first swim, the drop this season, the average drop per meet, the swim
+// count and the spread of recent swims, and ranks the most improved events by
+// relative drop.
+func (s *ProgressService) GetImprovementStatistics(ctx context.Context, swimmerID uuid.UUID, courseType *string) (*ImprovementStatistics, error) {
+	if courseType != nil && !domain.CourseType(*courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", *courseType)
+	}
+
+	history, err := s.timeRepo.ListTimeHistory(ctx, swimmerID, courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+
+	season := domain.SeasonForDate(time.Now())
+	seasonStart, _, err := domain.SeasonDates(season)
+	if err != nil {
+		return nil, fmt.Errorf("current season: %w", err)
+	}
+	stats := &ImprovementStatistics{
+		SwimmerID:              swimmerID.String(),
+		CourseType:             courseType,
+		Season:                 season,
+		Events:                 []EventStatistics{},
+		MostImproved:           []ImprovedEvent{},
+		MostImprovedThisSeason: []ImprovedEvent{},
+	}
+
+	byEvent := make(map[string][]db.ListTimeHistoryRow)
+	var keys []string
+	for _, row := range history {
+		if !row.Date.Valid {
+			continue
+		}
+		key := row.CourseType + "|" + row.Event
+		if _, ok := byEvent[key]; !ok {
+			keys = append(keys, key)
+		}
+		byEvent[key] = append(byEvent[key], row)
+		stats.TotalSwims++
+	}
+
+	for _, key := range keys {
+		stats.Events = append(stats.Events, eventStatistics(byEvent[key], seasonStart))
+	}
+	sort.SliceStable(stats.Events, func(i, j int) bool {
+		if stats.Events[i].CourseType != stats.Events[j].CourseType {
+			return stats.Events[i].CourseType < stats.Events[j].CourseType
+		}
+		return domain.EventSortOrder(stats.Events[i].Event) < domain.EventSortOrder(stats.Events[j].Event)
+	})
+
+	var allTime, thisSeason []ImprovedEvent
+	for _, e := range stats.Events {
+		if e.TotalDropMS > 0 {
+			allTime = append(allTime, ImprovedEvent{Event: e.Event, CourseType: e.CourseType, DropMS: e.TotalDropMS, DropPercent: e.TotalDropPercent})
+		}
+		if e.SeasonDropMS != nil && *e.SeasonDropMS > 0 {
+			thisSeason = append(thisSeason, ImprovedEvent{Event: e.Event, CourseType: e.CourseType, DropMS: *e.SeasonDropMS, DropPercent: *e.SeasonDropPercent})
+		}
+	}
+	stats.MostImproved = append(stats.MostImproved, rankImproved(allTime)...)
+	stats.MostImprovedThisSeason = append(stats.MostImprovedThisSeason, rankImproved(thisSeason)...)
+
+	return stats, nil
+}
+
+// eventStatistics summarizes the chronological swims in one event and course.
+func eventStatistics(swims []db.ListTimeHistoryRow, seasonStart time.Time) EventStatistics {
+	first, latest := swims[0], swims[len(swims)-1]
+	e := EventStatistics{
+		Event:        first.Event,
+		CourseType:   first.CourseType,
+		SwimCount:    len(swims),
+		FirstTimeMS:  int(first.TimeMs),
+		FirstDate:    first.Date.Time.Format("2006-01-02"),
+		LatestTimeMS: int(latest.TimeMs),
+		LatestDate:   latest.Date.Time.Format("2006-01-02"),
+	}
+
+	best := first
+	meets := make(map[uuid.UUID]bool)
+	var priorBest, seasonFirst, seasonBest *db.ListTimeHistoryRow
+	for i := range swims {
+		swim := &swims[i]
+		meets[swim.MeetID] = true
+		if swim.TimeMs < best.TimeMs {
+			best = *swim
+		}
+		if swim.Date.Time.Before(seasonStart) {
+			if priorBest == nil || swim.TimeMs < priorBest.TimeMs {
+				priorBest = swim
+			}
+			continue
+		}
+		e.SeasonSwimCount++
+		if seasonFirst == nil {
+			seasonFirst = swim
+		}
+		if seasonBest == nil || swim.TimeMs < seasonBest.TimeMs {
+			seasonBest = swim
+		}
+	}
+	e.MeetCount = len(meets)
+	e.BestTimeMS = int(best.TimeMs)
+	e.BestTimeFormatted = domain.FormatTime(e.BestTimeMS)
+	e.BestDate = best.Date.Time.Format("2006-01-02")
+	e.TotalDropMS = e.FirstTimeMS - e.BestTimeMS
+	e.TotalDropFormatted = domain.FormatTime(e.TotalDropMS)
+	e.TotalDropPercent = float64(e.TotalDropMS) / float64(e.FirstTimeMS) * 100
+
+	if e.MeetCount > 1 {
+		average := int(math.Round(float64(e.TotalDropMS) / float64(e.MeetCount-1)))
+		e.AverageDropPerMeetMS = &average
+	}
+
+	if seasonBest != nil {
+		seasonBestMS := int(seasonBest.TimeMs)
+		formatted := domain.FormatTime(seasonBestMS)
+		e.SeasonBestTimeMS = &seasonBestMS
+		e.SeasonBestFormatted = &formatted
+
+		baseline := seasonFirst
+		if priorBest != nil {
+			baseline = priorBest
+		}
+		drop := int(baseline.TimeMs) - seasonBestMS
+		percent := float64(drop) / float64(baseline.TimeMs) * 100
+		e.SeasonDropMS = &drop
+		e.SeasonDropPercent = &percent
+	}
+
+	recent := swims[max(0, len(swims)-recentSwims):]
+	e.RecentSwimCount = len(recent)
+	if len(recent) >= 2 {
+		var mean float64
+		for _, swim := range recent {
+			mean += float64(swim.TimeMs)
+		}
+		mean /= float64(len(recent))
+		var variance float64
+		for _, swim := range recent {
+			variance += (float64(swim.TimeMs) - mean) * (float64(swim.TimeMs) - mean)
+		}
+		spread := math.Sqrt(variance / float64(len(recent)-1))
+		spreadMS := int(math.Round(spread))
+		spreadPercent := spread / mean * 100
+		e.RecentSpreadMS = &spreadMS
+		e.RecentSpreadPercent = &spreadPercent
+	}
+
+	return e
+}
+
+// rankImproved orders events by relative drop and keeps the top entries.
+func rankImproved(events []ImprovedEvent) []ImprovedEvent {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].DropPercent > events[j].DropPercent
+	})
+	events = events[:min(mostImprovedCount, len(events))]
+	for i := range events {
+		events[i].Rank = i + 1
+	}
+	return events
+}
@@ -0,0 +1,373 @@
+package comparison
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// StrengthMethod is how personal bests are normalized across events.
+type StrengthMethod string
+
+const (
+	// StrengthStandard scores a time as the standard time over the swim time,
+	// in percent: 100 is exactly on the cut and higher is stronger.
+	StrengthStandard StrengthMethod = "standard"
+	// StrengthPoints scores a time in World Aquatics points.
+	StrengthPoints StrengthMethod = "points"
+)
+
+// strengthExtremes is how many events are listed as strongest and weakest.
+const strengthExtremes = 3
+
+// StrengthService compares a swimmer's events on a common scale.
+type StrengthService struct {
+	comparisonService *ComparisonService
+	pbService         *PersonalBestService
+}
+
+// NewStrengthService creates a new strength service.
+func NewStrengthService(comparisonService *ComparisonService, pbService *PersonalBestService) *StrengthService {
+	return &StrengthService{
+		comparisonService: comparisonService,
+		pbService:         pbService,
+	}
+}
+
+// EventStrength is the normalized score of the best time in an event.
+// Score and Rank are omitted when the event has no reference time.
+type EventStrength struct {
+	Rank              int      `json:"rank,omitempty"`
+	Event             string   `json:"event"`
+	Stroke            string   `json:"stroke"`
+	TimeMS            int      `json:"time_ms"`
+	TimeFormatted     string   `json:"time_formatted"`
+	Date              string   `json:"date"`
+	ReferenceTimeMS   *int     `json:"reference_time_ms,omitempty"`
+	ReferenceAgeGroup *string  `json:"reference_age_group,omitempty"`
+	Score             *float64 `json:"score,omitempty"`
+}
+
+// StrokeStrength is the average score of the scored events in a stroke.
+// RelativeScore is the difference from the average over all scored events,
+// and Change the difference from the previous season.
+type StrokeStrength struct {
+	Rank          int      `json:"rank"`
+	Stroke        string   `json:"stroke"`
+	Events        int      `json:"events"`
+	Score         float64  `json:"score"`
+	RelativeScore float64  `json:"relative_score"`
+	Change        *float64 `json:"change,omitempty"`
+}
+
+// SeasonStrength is the profile built from the season's best times.
+type SeasonStrength struct {
+	Season  string           `json:"season"`
+	Score   *float64         `json:"score"`
+	Events  []EventStrength  `json:"events"`
+	Strokes []StrokeStrength `json:"strokes"`
+}
+
+// StrengthProfile ranks a swimmer's events and strokes on a common scale and
+// shows how the profile shifted from season to season.
+type StrengthProfile struct {
+	SwimmerID    string           `json:"swimmer_id"`
+	CourseType   string           `json:"course_type"`
+	Method       StrengthMethod   `json:"method"`
+	StandardID   *uuid.UUID       `json:"standard_id,omitempty"`
+	StandardName *string          `json:"standard_name,omitempty"`
+	Score        *float64         `json:"score"`
+	Events       []EventStrength  `json:"events"`
+	Strokes      []StrokeStrength `json:"strokes"`
+	Strongest    []EventStrength  `json:"strongest"`
+	Weakest      []EventStrength  `json:"weakest"`
+	Seasons      []SeasonStrength `json:"seasons"`
+}
+
+// strengthScorer scores a time in an event swum on a date.
+type strengthScorer func(event string, timeMS int, date time.Time) (reference *int, ageGroup *string, score *float64)
+
+// GetProfile normalizes the swimmer's personal bests in a course, either as a
+// percent of a standard or in World Aquatics points, and ranks events and
+// strokes from strongest to weakest. Against a standard, personal bests use
+// the cut of the swimmer's current age group and season bests the cut of the
+// age group on the day of the swim, and only times eligible under the
+// standard's manual-time policy count. Without a method, a standard is used when
+// one is given and points otherwise.
+func (s *StrengthService) GetProfile(ctx context.Context, swimmerID uuid.UUID, courseType string, method *StrengthMethod, standardID *uuid.UUID) (*StrengthProfile, error) {
+	if !domain.CourseType(courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", courseType)
+	}
+	m := StrengthPoints
+	if standardID != nil {
+		m = StrengthStandard
+	}
+	if method != nil {
+		m = *method
+	}
+	switch m {
+	case StrengthStandard:
+		if standardID == nil {
+			return nil, errors.New("validation: standard_id is required for the standard method")
+		}
+	case StrengthPoints:
+	default:
+		return nil, fmt.Errorf("validation: method must be 'standard' or 'points'")
+	}
+
+	c := s.comparisonService
+	swimmer, err := c.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+
+	profile := &StrengthProfile{
+		SwimmerID:  swimmerID.String(),
+		CourseType: courseType,
+		Method:     m,
+		Strongest:  []EventStrength{},
+		Weakest:    []EventStrength{},
+		Seasons:    []SeasonStrength{},
+	}
+
+	history, err := c.timeRepo.ListTimeHistory(ctx, swimmerID, &courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+
+	now := time.Now()
+	var score strengthScorer
+	var events []EventStrength
+	if m == StrengthStandard {
+		standards, err := c.trackedStandards(ctx, swimmer, []uuid.UUID{*standardID}, &courseType, nil)
+		if err != nil {
+			return nil, err
+		}
+		q, err := c.loadQualifyingTimes(ctx, &standards[0], swimmer)
+		if err != nil {
+			return nil, err
+		}
+		profile.StandardID = &q.standard.ID
+		profile.StandardName = &q.standard.Name
+		score = func(event string, timeMS int, date time.Time) (*int, *string, *float64) {
+			ageGroup := string(q.scheme.AgeGroupAt(swimmer.BirthDate.Time, date))
+			standardTime, usedAgeGroup, ok := getStandardTime(q.times, event, ageGroup)
+			if !ok {
+				return nil, nil, nil
+			}
+			reference := int(standardTime)
+			percent := float64(reference) / float64(timeMS) * 100
+			return &reference, &usedAgeGroup, &percent
+		}
+
+		// Only swims eligible for the standard count, with any hand-timing
+		// adjustment it requires
+		for event, best := range q.bestTimes(history) {
+			events = append(events, eventStrength(score, event, domain.EventCode(event).Stroke(), best.timeMS, best.row.Date.Time.Format("2006-01-02"), now))
+		}
+		history = q.eligibleSwims(history)
+	} else {
+		pbs, err := c.timeRepo.GetPersonalBests(ctx, swimmerID, courseType)
+		if err != nil {
+			return nil, fmt.Errorf("get personal bests: %w", err)
+		}
+		baseTimes := make(map[string]int, len(pbs))
+		for _, pb := range pbs {
+			if pb.BaseTimeMs.Valid {
+				baseTimes[pb.Event] = int(pb.BaseTimeMs.Int32)
+			}
+		}
+		score = func(event string, timeMS int, _ time.Time) (*int, *string, *float64) {
+			baseTime, ok := baseTimes[event]
+			if !ok {
+				return nil, nil, nil
+			}
+			points := float64(domain.WorldAquaticsPoints(baseTime, timeMS))
+			return &baseTime, nil, &points
+		}
+
+		// Current profile from personal bests
+		byStroke, err := s.pbService.GetPersonalBestsByStroke(ctx, swimmerID, courseType)
+		if err != nil {
+			return nil, err
+		}
+		for stroke, pbs := range byStroke {
+			for _, pb := range pbs {
+				events = append(events, eventStrength(score, pb.Event, stroke, pb.TimeMS, pb.Date, now))
+			}
+		}
+	}
+
+	profile.Events, profile.Strokes, profile.Score = rankStrengths(events)
+
+	var scored []EventStrength
+	for _, e := range profile.Events {
+		if e.Score != nil {
+			scored = append(scored, e)
+		}
+	}
+	profile.Strongest = append(profile.Strongest, scored[:min(strengthExtremes, len(scored))]...)
+	for i := len(scored) - 1; i >= 0 && len(profile.Weakest) < strengthExtremes; i-- {
+		profile.Weakest = append(profile.Weakest, scored[i])
+	}
+
+	// Season profiles from season bests
+	var previous map[string]StrokeStrength
+	for _, season := range seasonBests(history) {
+		var seasonEvents []EventStrength
+		for _, row := range season.bests {
+			date := row.Date.Time
+			seasonEvents = append(seasonEvents, eventStrength(score, row.Event, domain.EventCode(row.Event).Stroke(), int(row.TimeMs), date.Format("2006-01-02"), date))
+		}
+		ss := SeasonStrength{Season: season.season}
+		ss.Events, ss.Strokes, ss.Score = rankStrengths(seasonEvents)
+
+		current := make(map[string]StrokeStrength, len(ss.Strokes))
+		for i := range ss.Strokes {
+			stroke := &ss.Strokes[i]
+			if prev, ok := previous[stroke.Stroke]; ok {
+				change := stroke.Score - prev.Score
+				stroke.Change = &change
+			}
+			current[stroke.Stroke] = *stroke
+		}
+		previous = current
+		profile.Seasons = append(profile.Seasons, ss)
+	}
+
+	return profile, nil
+}
+
+// eventStrength scores one time.
+func eventStrength(score strengthScorer, event, stroke string, timeMS int, date string, scoredOn time.Time) EventStrength {
+	e := EventStrength{
+		Event:         event,
+		Stroke:        stroke,
+		TimeMS:        timeMS,
+		TimeFormatted: domain.FormatTime(timeMS),
+		Date:          date,
+	}
+	e.ReferenceTimeMS, e.ReferenceAgeGroup, e.Score = score(event, timeMS, scoredOn)
+	return e
+}
+
+// rankStrengths sorts events from strongest to weakest, with unscored events
+// last in catalogue order, and averages the scores per stroke. It returns the
+// overall average score, or nil when no event is scored.
+func rankStrengths(events []EventStrength) ([]EventStrength, []StrokeStrength, *float64) {
+	sort.SliceStable(events, func(i, j int) bool {
+		si, sj := events[i].Score, events[j].Score
+		if (si == nil) != (sj == nil) {
+			return si != nil
+		}
+		if si != nil && *si != *sj {
+			return *si > *sj
+		}
+		return domain.EventSortOrder(events[i].Event) < domain.EventSortOrder(events[j].Event)
+	})
+
+	totals := make(map[string]float64)
+	counts := make(map[string]int)
+	var strokeOrder []string
+	var total float64
+	var scored int
+	for i := range events {
+		if events[i].Score == nil {
+			continue
+		}
+		scored++
+		events[i].Rank = scored
+		total += *events[i].Score
+		if counts[events[i].Stroke] == 0 {
+			strokeOrder = append(strokeOrder, events[i].Stroke)
+		}
+		totals[events[i].Stroke] += *events[i].Score
+		counts[events[i].Stroke]++
+	}
+	if events == nil {
+		events = []EventStrength{}
+	}
+
+	strokes := make([]StrokeStrength, 0, len(strokeOrder))
+	if scored == 0 {
+		return events, strokes, nil
+	}
+	average := total / float64(scored)
+	for _, stroke := range strokeOrder {
+		score := totals[stroke] / float64(counts[stroke])
+		strokes = append(strokes, StrokeStrength{
+			Stroke:        stroke,
+			Events:        counts[stroke],
+			Score:         score,
+			RelativeScore: score - average,
+		})
+	}
+	sort.SliceStable(strokes, func(i, j int) bool {
+		return strokes[i].Score > strokes[j].Score
+	})
+	for i := range strokes {
+		strokes[i].Rank = i + 1
+	}
+	return events, strokes, &average
+}
+
+// eligibleSwims returns the swims in the history that are eligible for the
+// standard, with their times replaced by the times that count for it.
+func (q *qualifyingTimes) eligibleSwims(history []db.ListTimeHistoryRow) []db.ListTimeHistoryRow {
+	eligible := make([]db.ListTimeHistoryRow, 0, len(history))
+	for _, row := range history {
+		swimTime, ok := q.swimTime(int(row.TimeMs), row.TimingMethod)
+		if !ok {
+			continue
+		}
+		row.TimeMs = int32(swimTime)
+		eligible = append(eligible, row)
+	}
+	return eligible
+}
+
+// seasonBest holds the best swim per event in one season.
+type seasonBest struct {
+	season string
+	bests  []db.ListTimeHistoryRow
+}
+
+// seasonBests groups the chronological history by season and keeps the
+// fastest swim per event, oldest season first.
+func seasonBests(history []db.ListTimeHistoryRow) []seasonBest {
+	var seasons []seasonBest
+	index := make(map[string]int)
+	for _, row := range history {
+		if !row.Date.Valid {
+			continue
+		}
+		season := domain.SeasonForDate(row.Date.Time)
+		i, ok := index[season]
+		if !ok {
+			i = len(seasons)
+			index[season] = i
+			seasons = append(seasons, seasonBest{season: season})
+		}
+		found := false
+		for j := range seasons[i].bests {
+			if seasons[i].bests[j].Event == row.Event {
+				if row.TimeMs < seasons[i].bests[j].TimeMs {
+					seasons[i].bests[j] = row
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			seasons[i].bests = append(seasons[i].bests, row)
+		}
+	}
+	return seasons
+}
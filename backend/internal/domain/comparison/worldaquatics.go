@@ -0,0 +1,224 @@
+package comparison
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// WorldAquaticsPointsService scores swims against World Aquatics base times.
+type WorldAquaticsPointsService struct {
+	timeRepo     *postgres.TimeRepository
+	swimmerRepo  *postgres.SwimmerRepository
+	baseTimeRepo *postgres.WorldAquaticsBaseTimeRepository
+}
+
+// NewWorldAquaticsPointsService creates a new World Aquatics points service.
+func NewWorldAquaticsPointsService(
+	timeRepo *postgres.TimeRepository,
+	swimmerRepo *postgres.SwimmerRepository,
+	baseTimeRepo *postgres.WorldAquaticsBaseTimeRepository,
+) *WorldAquaticsPointsService {
+	return &WorldAquaticsPointsService{
+		timeRepo:     timeRepo,
+		swimmerRepo:  swimmerRepo,
+		baseTimeRepo: baseTimeRepo,
+	}
+}
+
+// EventPoints is the World Aquatics points score of a personal best.
+// Rank, BaseTimeMS and Points are omitted when no base time is loaded
+// for the event, course and gender.
+type EventPoints struct {
+	Rank          int    `json:"rank,omitempty"`
+	Event         string `json:"event"`
+	CourseType    string `json:"course_type"`
+	TimeMS        int    `json:"time_ms"`
+	TimeFormatted string `json:"time_formatted"`
+	TimeID        string `json:"time_id"`
+	MeetName      string `json:"meet"`
+	Date          string `json:"date"`
+	BaseTimeMS    *int   `json:"base_time_ms,omitempty"`
+	Points        *int   `json:"points,omitempty"`
+}
+
+// EventPointsRanking lists the swimmer's events from most to fewest points.
+// Events without a base time follow the scored events.
+type EventPointsRanking struct {
+	SwimmerID  string        `json:"swimmer_id"`
+	Gender     string        `json:"gender"`
+	CourseType *string       `json:"course_type,omitempty"`
+	Events     []EventPoints `json:"events"`
+}
+
+// WorldAquaticsBaseTimeInput is a World Aquatics base time table for one course
+// and gender, keyed as event -> time (e.g. "50FR" -> "23.61"). It uses the same
+// layout as standards files, so season and source keys are accepted and ignored.
+type WorldAquaticsBaseTimeInput struct {
+	CourseType string            `json:"course_type"`
+	Gender     string            `json:"gender"`
+	Times      map[string]string `json:"times"`
+}
+
+// WorldAquaticsBaseTime is a single base time.
+type WorldAquaticsBaseTime struct {
+	Event         string `json:"event"`
+	Gender        string `json:"gender"`
+	CourseType    string `json:"course_type"`
+	TimeMS        int    `json:"time_ms"`
+	TimeFormatted string `json:"time_formatted"`
+}
+
+// WorldAquaticsBaseTimeList represents a list of base times.
+type WorldAquaticsBaseTimeList struct {
+	BaseTimes []WorldAquaticsBaseTime `json:"base_times"`
+}
+
+// RankEvents ranks the swimmer's personal bests by World Aquatics points.
+// Without a course type, personal bests from both pool courses are ranked together.
+func (s *WorldAquaticsPointsService) RankEvents(ctx context.Context, swimmerID uuid.UUID, courseType *string) (*EventPointsRanking, error) {
+	courseTypes := []string{string(domain.Course25m), string(domain.Course50m)}
+	if courseType != nil {
+		if !domain.CourseType(*courseType).IsValid() {
+			return nil, fmt.Errorf("validation: invalid course type: %s", *courseType)
+		}
+		courseTypes = []string{*courseType}
+	}
+
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+
+	events := make([]EventPoints, 0)
+	for _, ct := range courseTypes {
+		pbs, err := s.timeRepo.GetPersonalBests(ctx, swimmerID, ct)
+		if err != nil {
+			return nil, fmt.Errorf("get personal bests: %w", err)
+		}
+		for _, pb := range pbs {
+			pbView := toPersonalBest(pb)
+			event := EventPoints{
+				Event:         pbView.Event,
+				CourseType:    ct,
+				TimeMS:        pbView.TimeMS,
+				TimeFormatted: pbView.TimeFormatted,
+				TimeID:        pbView.TimeID,
+				MeetName:      pbView.MeetName,
+				Date:          pbView.Date,
+				Points:        pbView.Points,
+			}
+			if pb.BaseTimeMs.Valid {
+				baseTime := int(pb.BaseTimeMs.Int32)
+				event.BaseTimeMS = &baseTime
+			}
+			events = append(events, event)
+		}
+	}
+
+	// Highest points first; unscored events last in catalogue order
+	sort.SliceStable(events, func(i, j int) bool {
+		pi, pj := events[i].Points, events[j].Points
+		if (pi == nil) != (pj == nil) {
+			return pi != nil
+		}
+		if pi != nil && *pi != *pj {
+			return *pi > *pj
+		}
+		return domain.EventSortOrder(events[i].Event) < domain.EventSortOrder(events[j].Event)
+	})
+	for i := range events {
+		if events[i].Points != nil {
+			events[i].Rank = i + 1
+		}
+	}
+
+	return &EventPointsRanking{
+		SwimmerID:  swimmerID.String(),
+		Gender:     swimmer.Gender,
+		CourseType: courseType,
+		Events:     events,
+	}, nil
+}
+
+// ListBaseTimes lists the loaded base times, optionally filtered by course type and gender.
+func (s *WorldAquaticsPointsService) ListBaseTimes(ctx context.Context, courseType, gender *string) (*WorldAquaticsBaseTimeList, error) {
+	rows, err := s.baseTimeRepo.List(ctx, courseType, gender)
+	if err != nil {
+		return nil, err
+	}
+	list := &WorldAquaticsBaseTimeList{BaseTimes: make([]WorldAquaticsBaseTime, len(rows))}
+	for i, row := range rows {
+		list.BaseTimes[i] = toWorldAquaticsBaseTime(&row)
+	}
+	return list, nil
+}
+
+// ImportBaseTimes replaces the base time table for a course type and gender.
+func (s *WorldAquaticsPointsService) ImportBaseTimes(ctx context.Context, input WorldAquaticsBaseTimeInput) (*WorldAquaticsBaseTimeList, error) {
+	input.CourseType = strings.TrimSpace(input.CourseType)
+	input.Gender = strings.TrimSpace(input.Gender)
+	if !domain.CourseType(input.CourseType).IsPool() {
+		return nil, errors.New("validation: course_type must be '25m' or '50m'")
+	}
+	if input.Gender != "female" && input.Gender != "male" {
+		return nil, errors.New("validation: gender must be 'female' or 'male'")
+	}
+	if len(input.Times) == 0 {
+		return nil, errors.New("validation: no base times defined")
+	}
+
+	// Validate everything before replacing the table
+	params := make([]db.UpsertWorldAquaticsBaseTimeParams, 0, len(input.Times))
+	for code, timeStr := range input.Times {
+		event, ok := domain.LookupEvent(domain.EventCode(code))
+		if !ok {
+			return nil, fmt.Errorf("validation: invalid event code: %s", code)
+		}
+		if !event.AllowsCourse(domain.CourseType(input.CourseType)) {
+			return nil, fmt.Errorf("validation: event %s is not swum in %s course", code, input.CourseType)
+		}
+		timeMS, err := domain.ParseTime(timeStr)
+		if err != nil {
+			return nil, fmt.Errorf("validation: %s: invalid time '%s'", code, timeStr)
+		}
+		params = append(params, db.UpsertWorldAquaticsBaseTimeParams{
+			Event:      code,
+			Gender:     input.Gender,
+			CourseType: input.CourseType,
+			TimeMs:     int32(timeMS),
+		})
+	}
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].Event < params[j].Event
+	})
+
+	rows, err := s.baseTimeRepo.ReplaceTable(ctx, input.CourseType, input.Gender, params)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &WorldAquaticsBaseTimeList{BaseTimes: make([]WorldAquaticsBaseTime, 0, len(rows))}
+	for i := range rows {
+		list.BaseTimes = append(list.BaseTimes, toWorldAquaticsBaseTime(&rows[i]))
+	}
+	return list, nil
+}
+
+func toWorldAquaticsBaseTime(row *db.WorldAquaticsBaseTime) WorldAquaticsBaseTime {
+	return WorldAquaticsBaseTime{
+		Event:         row.Event,
+		Gender:        row.Gender,
+		CourseType:    row.CourseType,
+		TimeMS:        int(row.TimeMs),
+		TimeFormatted: domain.FormatTime(int(row.TimeMs)),
+	}
+}
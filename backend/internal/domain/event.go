@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// Stroke identifies a swimming stroke by its event code suffix.
+type Stroke string
+
+const (
+	StrokeFreestyle    Stroke = "FR"
+	StrokeBackstroke   Stroke = "BK"
+	StrokeBreaststroke Stroke = "BR"
+	StrokeButterfly    Stroke = "FL"
+	StrokeMedley       Stroke = "IM"
+	StrokeOpenWater    Stroke = "OW"
+)
+
+// Strokes lists all strokes in display order.
+var Strokes = []Stroke{StrokeFreestyle, StrokeBackstroke, StrokeBreaststroke, StrokeButterfly, StrokeMedley, StrokeOpenWater}
+
+// IsValid checks if the stroke is valid.
+func (s Stroke) IsValid() bool {
+	return slices.Contains(Strokes, s)
+}
+
+// Name returns the display name of the stroke.
+func (s Stroke) Name() string {
+	switch s {
+	case StrokeFreestyle:
+		return "Freestyle"
+	case StrokeBackstroke:
+		return "Backstroke"
+	case StrokeBreaststroke:
+		return "Breaststroke"
+	case StrokeButterfly:
+		return "Butterfly"
+	case StrokeMedley:
+		return "Individual Medley"
+	case StrokeOpenWater:
+		return "Open Water"
+	default:
+		return "Unknown"
+	}
+}
+
+// Event describes an entry in the event catalogue.
+type Event struct {
+	Code        EventCode
+	Distance    int
+	Stroke      Stroke
+	CourseTypes []CourseType
+	Name        string
+	SortOrder   int
+}
+
+// AllowsCourse reports whether the event is swum in the given course type.
+func (e Event) AllowsCourse(courseType CourseType) bool {
+	return slices.Contains(e.CourseTypes, courseType)
+}
+
+// eventCatalogue holds the events loaded from the database. It is process
+// wide so that EventCode.IsValid and the other validators can consult it
+// without a service at hand. The process must therefore serve a single
+// database: a second event.Service loading another database would replace
+// the catalogue for both. Events changed by another process are only seen
+// after this one reloads the catalogue.
+var eventCatalogue struct {
+	mu     sync.RWMutex
+	events []Event
+	byCode map[EventCode]Event
+}
+
+// SetEventCatalogue replaces the catalogue of known events.
+// It is called when the catalogue is loaded or changed.
+func SetEventCatalogue(events []Event) {
+	sorted := slices.Clone(events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].SortOrder < sorted[j].SortOrder
+	})
+
+	byCode := make(map[EventCode]Event, len(sorted))
+	for _, event := range sorted {
+		byCode[event.Code] = event
+	}
+
+	eventCatalogue.mu.Lock()
+	defer eventCatalogue.mu.Unlock()
+	eventCatalogue.events = sorted
+	eventCatalogue.byCode = byCode
+}
+
+// CatalogueEvents returns all catalogued events in display order.
+func CatalogueEvents() []Event {
+	eventCatalogue.mu.RLock()
+	defer eventCatalogue.mu.RUnlock()
+	return slices.Clone(eventCatalogue.events)
+}
+
+// EventsForCourse returns the catalogued events swum in a course type, in display order.
+func EventsForCourse(courseType CourseType) []Event {
+	var events []Event
+	for _, event := range CatalogueEvents() {
+		if event.AllowsCourse(courseType) {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// LookupEvent returns the catalogued event for a code.
+func LookupEvent(code EventCode) (Event, bool) {
+	eventCatalogue.mu.RLock()
+	defer eventCatalogue.mu.RUnlock()
+	event, ok := eventCatalogue.byCode[code]
+	return event, ok
+}
+
+// EventSortOrder returns the catalogue position of an event, placing unknown events last.
+func EventSortOrder(code string) int {
+	if event, ok := LookupEvent(EventCode(code)); ok {
+		return event.SortOrder
+	}
+	return math.MaxInt
+}
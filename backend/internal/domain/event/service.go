@@ -0,0 +1,205 @@
+// Package event provides event catalogue domain logic.
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// codePattern restricts event codes to a distance followed by a stroke suffix.
+var codePattern = regexp.MustCompile(`^[0-9]+[A-Z]{2}$`)
+
+// Service provides event catalogue business logic.
+type Service struct {
+	repo *postgres.EventRepository
+}
+
+// NewService creates a new event service.
+func NewService(repo *postgres.EventRepository) *Service {
+	return &Service{repo: repo}
+}
+
+// Event represents a catalogued swimming event.
+type Event struct {
+	Code        string   `json:"code"`
+	Distance    int      `json:"distance"`
+	Stroke      string   `json:"stroke"`
+	StrokeName  string   `json:"stroke_name"`
+	CourseTypes []string `json:"course_types"`
+	Name        string   `json:"name"`
+	SortOrder   int      `json:"sort_order"`
+}
+
+// EventList represents a list of catalogued events.
+type EventList struct {
+	Events []Event `json:"events"`
+}
+
+// Input represents input for adding an event to the catalogue.
+type Input struct {
+	Code        string   `json:"code"`
+	Distance    int      `json:"distance"`
+	Stroke      string   `json:"stroke"`
+	CourseTypes []string `json:"course_types"`
+	Name        string   `json:"name,omitempty"`
+	// SortOrder defaults to after the last catalogued event.
+	SortOrder *int `json:"sort_order,omitempty"`
+}
+
+// Sanitize normalizes the event input.
+func (i *Input) Sanitize() {
+	i.Code = strings.ToUpper(domain.SanitizeString(i.Code))
+	i.Stroke = strings.ToUpper(domain.SanitizeString(i.Stroke))
+	i.Name = domain.SanitizeString(i.Name)
+	for j := range i.CourseTypes {
+		i.CourseTypes[j] = domain.SanitizeString(i.CourseTypes[j])
+	}
+	if i.Name == "" && i.Distance > 0 {
+		i.Name = fmt.Sprintf("%dm %s", i.Distance, domain.Stroke(i.Stroke).Name())
+	}
+}
+
+// Validate validates the event input. Call Sanitize() first.
+func (i Input) Validate() error {
+	if i.Code == "" {
+		return errors.New("code is required")
+	}
+	if len(i.Code) > 20 || !codePattern.MatchString(i.Code) {
+		return errors.New("code must be a distance followed by a stroke, e.g. '25FR'")
+	}
+	if i.Distance <= 0 {
+		return errors.New("distance must be positive")
+	}
+	if !domain.Stroke(i.Stroke).IsValid() {
+		return errors.New("stroke must be one of 'FR', 'BK', 'BR', 'FL', 'IM' or 'OW'")
+	}
+	if !strings.HasSuffix(i.Code, i.Stroke) {
+		return errors.New("code must end with the stroke")
+	}
+	if len(i.CourseTypes) == 0 {
+		return errors.New("at least one course type is required")
+	}
+	for _, ct := range i.CourseTypes {
+		if !domain.CourseType(ct).IsValid() {
+			return fmt.Errorf("invalid course type: %s", ct)
+		}
+	}
+	if len(i.Name) > 100 {
+		return errors.New("name must be at most 100 characters")
+	}
+	return nil
+}
+
+// Load reads the event catalogue from the database and makes it
+// available to validation and grouping throughout the domain. It replaces
+// the process-wide catalogue, see domain.SetEventCatalogue.
+func (s *Service) Load(ctx context.Context) error {
+	rows, err := s.repo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("load event catalogue: %w", err)
+	}
+
+	events := make([]domain.Event, len(rows))
+	for i := range rows {
+		events[i] = toDomainEvent(&rows[i])
+	}
+	domain.SetEventCatalogue(events)
+	return nil
+}
+
+// List returns the catalogued events, optionally limited to a course type.
+func (s *Service) List(courseType *string) (*EventList, error) {
+	var events []domain.Event
+	if courseType != nil {
+		if !domain.CourseType(*courseType).IsValid() {
+			return nil, fmt.Errorf("validation: invalid course type: %s", *courseType)
+		}
+		events = domain.EventsForCourse(domain.CourseType(*courseType))
+	} else {
+		events = domain.CatalogueEvents()
+	}
+
+	list := &EventList{Events: make([]Event, len(events))}
+	for i, e := range events {
+		list.Events[i] = toEvent(e)
+	}
+	return list, nil
+}
+
+// Create adds an event to the catalogue and reloads it.
+func (s *Service) Create(ctx context.Context, input Input) (*Event, error) {
+	input.Sanitize()
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("validation: %w", err)
+	}
+
+	if _, exists := domain.LookupEvent(domain.EventCode(input.Code)); exists {
+		return nil, fmt.Errorf("validation: an event with this code already exists")
+	}
+
+	sortOrder := 0
+	if input.SortOrder != nil {
+		sortOrder = *input.SortOrder
+	} else {
+		for _, e := range domain.CatalogueEvents() {
+			sortOrder = max(sortOrder, e.SortOrder+10)
+		}
+	}
+
+	row, err := s.repo.Create(ctx, db.CreateEventParams{
+		Code:        input.Code,
+		Distance:    int32(input.Distance),
+		Stroke:      input.Stroke,
+		CourseTypes: input.CourseTypes,
+		Name:        input.Name,
+		SortOrder:   int32(sortOrder),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create event: %w", err)
+	}
+
+	if err := s.Load(ctx); err != nil {
+		return nil, err
+	}
+
+	e := toEvent(toDomainEvent(row))
+	return &e, nil
+}
+
+func toDomainEvent(row *db.Event) domain.Event {
+	courseTypes := make([]domain.CourseType, len(row.CourseTypes))
+	for i, ct := range row.CourseTypes {
+		courseTypes[i] = domain.CourseType(ct)
+	}
+	return domain.Event{
+		Code:        domain.EventCode(row.Code),
+		Distance:    int(row.Distance),
+		Stroke:      domain.Stroke(row.Stroke),
+		CourseTypes: courseTypes,
+		Name:        row.Name,
+		SortOrder:   int(row.SortOrder),
+	}
+}
+
+func toEvent(e domain.Event) Event {
+	courseTypes := make([]string, len(e.CourseTypes))
+	for i, ct := range e.CourseTypes {
+		courseTypes[i] = string(ct)
+	}
+	return Event{
+		Code:        string(e.Code),
+		Distance:    e.Distance,
+		Stroke:      string(e.Stroke),
+		StrokeName:  e.Stroke.Name(),
+		CourseTypes: courseTypes,
+		Name:        e.Name,
+		SortOrder:   e.SortOrder,
+	}
+}
@@ -50,10 +50,16 @@ func (s *Service) ExportAll(ctx context.Context) (*ExportData, error) {
 	}
 
 	export.Swimmer = SwimmerExport{
-		Name:             swimmerData.Name,
-		BirthDate:        swimmerData.BirthDate,
-		Gender:           swimmerData.Gender,
-		ThresholdPercent: swimmerData.ThresholdPercent,
+		Name:                      swimmerData.Name,
+		BirthDate:                 swimmerData.BirthDate,
+		Gender:                    swimmerData.Gender,
+		ThresholdPercent:          swimmerData.ThresholdPercent,
+		ManualAdjustmentMS:        swimmerData.ManualAdjustmentMS,
+		SemiAutomaticAdjustmentMS: swimmerData.SemiAutomaticAdjustmentMS,
+		Category:                  swimmerData.Category,
+		SportClassS:               swimmerData.SportClassS,
+		SportClassSB:              swimmerData.SportClassSB,
+		SportClassSM:              swimmerData.SportClassSM,
 	}
 
 	// 2. Export meets with times
@@ -109,10 +115,21 @@ func (s *Service) ExportAll(ctx context.Context) (*ExportData, error) {
 
 		for _, t := range times {
 			timeExport := TimeExport{
-				Event:     t.Event,
-				Time:      domain.FormatTime(t.TimeMS),
-				EventDate: t.EventDate,
-				Notes:     t.Notes,
+				Event:         t.Event,
+				Time:          domain.FormatTime(t.TimeMS),
+				EventDate:     t.EventDate,
+				Notes:         t.Notes,
+				Heat:          t.Heat,
+				Lane:          t.Lane,
+				HeatPlace:     t.HeatPlace,
+				OverallPlace:  t.OverallPlace,
+				AgeGroupPlace: t.AgeGroupPlace,
+				PointsEarned:  t.PointsEarned,
+				TimingMethod:  t.TimingMethod,
+				ReactionTime:  t.ReactionTimeMS,
+
+				WaterTemperatureC: t.WaterTemperatureC,
+				Wetsuit:           t.Wetsuit,
 			}
 			meetExport.Times = append(meetExport.Times, timeExport)
 		}
@@ -141,6 +158,9 @@ func (s *Service) ExportAll(ctx context.Context) (*ExportData, error) {
 			CourseType:  std.CourseType,
 			Gender:      std.Gender,
 			Times:       make(map[string][]string),
+
+			ManualTimePolicy: std.ManualTimePolicy,
+			Category:         std.Category,
 		}
 
 		// Get all standard times for this standard
@@ -151,7 +171,11 @@ func (s *Service) ExportAll(ctx context.Context) (*ExportData, error) {
 
 		// Group times by event
 		for _, st := range standardWithTimes.Times {
-			timeStr := fmt.Sprintf("%s:%s", st.AgeGroup, st.TimeFormatted)
+			key := st.AgeGroup
+			if st.SportClass != "" {
+				key += "/" + st.SportClass
+			}
+			timeStr := fmt.Sprintf("%s:%s", key, st.TimeFormatted)
 			standardExport.Times[st.Event] = append(standardExport.Times[st.Event], timeStr)
 		}
 
@@ -17,8 +17,17 @@ type ExportData struct {
 type SwimmerExport struct {
 	Name             string  `json:"name"`
 	BirthDate        string  `json:"birth_date"`        // YYYY-MM-DD format
-	Gender           string  `json:"gender"`            // "female" or "male"
+	Gender           string  `json:"gender"`            // "female", "male" or "x"
 	ThresholdPercent float64 `json:"threshold_percent"` // "almost there" threshold percentage
+	// Adjustments added to manual/semi-automatic times when comparing against standards
+	ManualAdjustmentMS        int `json:"manual_adjustment_ms"`
+	SemiAutomaticAdjustmentMS int `json:"semi_automatic_adjustment_ms"`
+	// Category is "age_group" or "masters"
+	Category string `json:"category"`
+	// Para-swimming sport classes per stroke family (omitted when unclassified)
+	SportClassS  *string `json:"sport_class_s,omitempty"`
+	SportClassSB *string `json:"sport_class_sb,omitempty"`
+	SportClassSM *string `json:"sport_class_sm,omitempty"`
 }
 
 // MeetExport represents a meet with its associated times for export.
@@ -28,23 +37,38 @@ type MeetExport struct {
 	Country    string       `json:"country"`
 	StartDate  string       `json:"start_date"`  // YYYY-MM-DD format
 	EndDate    string       `json:"end_date"`    // YYYY-MM-DD format
-	CourseType string       `json:"course_type"` // "25m" or "50m"
+	CourseType string       `json:"course_type"` // "25m", "50m" or "open_water"
 	Times      []TimeExport `json:"times"`
 }
 
 // TimeExport represents a swim time for export.
 type TimeExport struct {
-	Event     string `json:"event"`      // Event code (e.g., "50FR", "100BK")
-	Time      string `json:"time"`       // Time in MM:SS.HH or SS.HH format
-	EventDate string `json:"event_date"` // YYYY-MM-DD format
-	Notes     string `json:"notes"`      // Optional notes
+	Event         string   `json:"event"`                      // Event code (e.g., "50FR", "100BK")
+	Time          string   `json:"time"`                       // Time in H:MM:SS.HH, MM:SS.HH or SS.HH format
+	EventDate     string   `json:"event_date"`                 // YYYY-MM-DD format
+	Notes         string   `json:"notes"`                      // Optional notes
+	Heat          *int     `json:"heat,omitempty"`             // Optional heat number
+	Lane          *int     `json:"lane,omitempty"`             // Optional lane number
+	HeatPlace     *int     `json:"heat_place,omitempty"`       // Optional place within the heat
+	OverallPlace  *int     `json:"overall_place,omitempty"`    // Optional overall place
+	AgeGroupPlace *int     `json:"age_group_place,omitempty"`  // Optional age-group place
+	PointsEarned  *float64 `json:"points_earned,omitempty"`    // Optional team/meet points
+	TimingMethod  string   `json:"timing_method"`              // "electronic", "semi_automatic" or "manual"
+	ReactionTime  *int     `json:"reaction_time_ms,omitempty"` // Optional start reaction time in ms
+
+	WaterTemperatureC *float64 `json:"water_temperature_c,omitempty"` // Optional open-water temperature in °C
+	Wetsuit           *bool    `json:"wetsuit,omitempty"`             // Optional open-water wetsuit use
 }
 
 // StandardExport represents a time standard for export (custom standards only).
 type StandardExport struct {
 	Name        string              `json:"name"`
 	Description string              `json:"description"`
-	CourseType  string              `json:"course_type"` // "25m" or "50m"
-	Gender      string              `json:"gender"`      // "female" or "male"
-	Times       map[string][]string `json:"times"`       // Event -> [age_group:time, ...]
+	CourseType  string              `json:"course_type"` // "25m", "50m" or "open_water"
+	Gender      string              `json:"gender"`      // "female", "male", "x" or "mixed"
+	Times       map[string][]string `json:"times"`       // Event -> [age_group[/sport_class]:time, ...]
+	// ManualTimePolicy is "accept", "adjust" or "ineligible"
+	ManualTimePolicy string `json:"manual_time_policy"`
+	// Category is "age_group" or "masters"
+	Category string `json:"category"`
 }
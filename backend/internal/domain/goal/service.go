@@ -0,0 +1,280 @@
+// Package goal provides goal domain logic. A goal is a target time or a
+// target standard in one event and course, optionally by a deadline.
+package goal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// Status is the state of a goal.
+type Status string
+
+const (
+	StatusActive   Status = "active"
+	StatusAchieved Status = "achieved"
+	StatusMissed   Status = "missed"
+)
+
+// IsValid checks if the status is valid.
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusActive, StatusAchieved, StatusMissed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Service provides goal business logic.
+type Service struct {
+	repo         *postgres.GoalRepository
+	standardRepo *postgres.StandardRepository
+	swimmerRepo  *postgres.SwimmerRepository
+}
+
+// NewService creates a new goal service.
+func NewService(repo *postgres.GoalRepository, standardRepo *postgres.StandardRepository, swimmerRepo *postgres.SwimmerRepository) *Service {
+	return &Service{
+		repo:         repo,
+		standardRepo: standardRepo,
+		swimmerRepo:  swimmerRepo,
+	}
+}
+
+// Goal represents a goal. Exactly one of TargetTimeMS and StandardID is set.
+type Goal struct {
+	ID             uuid.UUID  `json:"id"`
+	SwimmerID      uuid.UUID  `json:"-"`
+	Event          string     `json:"event"`
+	CourseType     string     `json:"course_type"`
+	TargetTimeMS   *int       `json:"target_time_ms,omitempty"`
+	StandardID     *uuid.UUID `json:"standard_id,omitempty"`
+	Deadline       *string    `json:"deadline,omitempty"`
+	Notes          string     `json:"notes,omitempty"`
+	Status         Status     `json:"status"`
+	AchievedTimeID *uuid.UUID `json:"achieved_time_id,omitempty"`
+	AchievedDate   *string    `json:"achieved_date,omitempty"`
+}
+
+// DeadlineDate returns the deadline as a date, if the goal has one.
+func (g Goal) DeadlineDate() (time.Time, bool) {
+	if g.Deadline == nil {
+		return time.Time{}, false
+	}
+	deadline, err := time.Parse("2006-01-02", *g.Deadline)
+	return deadline, err == nil
+}
+
+// Input represents input for creating or updating a goal.
+type Input struct {
+	Event        string     `json:"event"`
+	CourseType   string     `json:"course_type"`
+	TargetTimeMS *int       `json:"target_time_ms,omitempty"`
+	StandardID   *uuid.UUID `json:"standard_id,omitempty"`
+	Deadline     string     `json:"deadline,omitempty"` // YYYY-MM-DD
+	Notes        string     `json:"notes,omitempty"`
+}
+
+// Sanitize trims whitespace from string fields.
+func (i *Input) Sanitize() {
+	i.Event = strings.ToUpper(strings.TrimSpace(i.Event))
+	i.CourseType = strings.TrimSpace(i.CourseType)
+	i.Deadline = strings.TrimSpace(i.Deadline)
+	i.Notes = strings.TrimSpace(i.Notes)
+}
+
+// Validate validates the goal input. Call Sanitize() first.
+func (i Input) Validate() error {
+	if !domain.CourseType(i.CourseType).IsValid() {
+		return errors.New("course_type must be '25m', '50m' or 'open_water'")
+	}
+	event, ok := domain.LookupEvent(domain.EventCode(i.Event))
+	if !ok {
+		return fmt.Errorf("invalid event: %s", i.Event)
+	}
+	if !event.AllowsCourse(domain.CourseType(i.CourseType)) {
+		return fmt.Errorf("event %s is not swum in %s course", i.Event, i.CourseType)
+	}
+	if (i.TargetTimeMS == nil) == (i.StandardID == nil) {
+		return errors.New("exactly one of target_time_ms and standard_id is required")
+	}
+	if i.TargetTimeMS != nil && *i.TargetTimeMS <= 0 {
+		return errors.New("target_time_ms must be positive")
+	}
+	if i.Deadline != "" {
+		if _, err := time.Parse("2006-01-02", i.Deadline); err != nil {
+			return errors.New("deadline must be in YYYY-MM-DD format")
+		}
+	}
+	return nil
+}
+
+// Get retrieves a goal.
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*Goal, error) {
+	row, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	goal := ToGoal(*row)
+	return &goal, nil
+}
+
+// List retrieves a swimmer's goals, nearest deadline first.
+func (s *Service) List(ctx context.Context, swimmerID uuid.UUID) ([]Goal, error) {
+	rows, err := s.repo.List(ctx, swimmerID)
+	if err != nil {
+		return nil, err
+	}
+	goals := make([]Goal, len(rows))
+	for i, row := range rows {
+		goals[i] = ToGoal(row)
+	}
+	return goals, nil
+}
+
+// Create creates a new goal for a swimmer.
+func (s *Service) Create(ctx context.Context, swimmerID uuid.UUID, input Input) (*Goal, error) {
+	if err := s.validate(ctx, swimmerID, &input); err != nil {
+		return nil, err
+	}
+
+	row, err := s.repo.Create(ctx, db.CreateGoalParams{
+		SwimmerID:    swimmerID,
+		Event:        input.Event,
+		CourseType:   input.CourseType,
+		TargetTimeMs: postgres.IntOrNull(input.TargetTimeMS),
+		StandardID:   postgres.UUIDOrNull(input.StandardID),
+		Deadline:     postgres.DateOrNull(input.Deadline),
+		Notes:        postgres.TextOrNull(input.Notes),
+	})
+	if err != nil {
+		return nil, err
+	}
+	goal := ToGoal(*row)
+	return &goal, nil
+}
+
+// Update updates a goal.
+func (s *Service) Update(ctx context.Context, id uuid.UUID, input Input) (*Goal, error) {
+	existing, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validate(ctx, existing.SwimmerID, &input); err != nil {
+		return nil, err
+	}
+
+	row, err := s.repo.Update(ctx, db.UpdateGoalParams{
+		ID:           id,
+		Event:        input.Event,
+		CourseType:   input.CourseType,
+		TargetTimeMs: postgres.IntOrNull(input.TargetTimeMS),
+		StandardID:   postgres.UUIDOrNull(input.StandardID),
+		Deadline:     postgres.DateOrNull(input.Deadline),
+		Notes:        postgres.TextOrNull(input.Notes),
+	})
+	if err != nil {
+		return nil, err
+	}
+	goal := ToGoal(*row)
+	return &goal, nil
+}
+
+// Delete deletes a goal.
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.repo.Get(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// RecordStatus stores a goal's status and, for achieved goals, the swim that
+// achieved it.
+func (s *Service) RecordStatus(ctx context.Context, id uuid.UUID, status Status, achievedTimeID *uuid.UUID, achievedDate *time.Time) error {
+	var date pgtype.Date
+	if achievedDate != nil {
+		date = pgtype.Date{Time: *achievedDate, Valid: true}
+	}
+	return s.repo.UpdateStatus(ctx, db.UpdateGoalStatusParams{
+		ID:             id,
+		Status:         string(status),
+		AchievedTimeID: postgres.UUIDOrNull(achievedTimeID),
+		AchievedDate:   date,
+	})
+}
+
+// validate sanitizes and validates the input, checking that a target standard
+// exists, is for the goal's course and applies to the swimmer.
+func (s *Service) validate(ctx context.Context, swimmerID uuid.UUID, input *Input) error {
+	input.Sanitize()
+	if err := input.Validate(); err != nil {
+		return fmt.Errorf("validation: %w", err)
+	}
+	if input.StandardID == nil {
+		return nil
+	}
+
+	standard, err := s.standardRepo.Get(ctx, *input.StandardID)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			return errors.New("validation: standard not found")
+		}
+		return err
+	}
+	if standard.CourseType != input.CourseType {
+		return fmt.Errorf("validation: standard %s is for %s course, not %s", standard.Name, standard.CourseType, input.CourseType)
+	}
+
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return fmt.Errorf("get swimmer: %w", err)
+	}
+	if !domain.Gender(standard.Gender).AppliesTo(domain.Gender(swimmer.Gender)) {
+		return fmt.Errorf("validation: a %s standard does not apply to a %s swimmer", standard.Gender, swimmer.Gender)
+	}
+	return nil
+}
+
+// ToGoal converts a database row to a goal.
+func ToGoal(row db.Goal) Goal {
+	goal := Goal{
+		ID:         row.ID,
+		SwimmerID:  row.SwimmerID,
+		Event:      row.Event,
+		CourseType: row.CourseType,
+		Notes:      row.Notes.String,
+		Status:     Status(row.Status),
+	}
+	if row.TargetTimeMs.Valid {
+		target := int(row.TargetTimeMs.Int32)
+		goal.TargetTimeMS = &target
+	}
+	if row.StandardID.Valid {
+		id := uuid.UUID(row.StandardID.Bytes)
+		goal.StandardID = &id
+	}
+	if row.Deadline.Valid {
+		deadline := row.Deadline.Time.Format("2006-01-02")
+		goal.Deadline = &deadline
+	}
+	if row.AchievedTimeID.Valid {
+		id := uuid.UUID(row.AchievedTimeID.Bytes)
+		goal.AchievedTimeID = &id
+	}
+	if row.AchievedDate.Valid {
+		date := row.AchievedDate.Time.Format("2006-01-02")
+		goal.AchievedDate = &date
+	}
+	return goal
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/bpg/swimstats/backend/internal/domain"
 	"github.com/bpg/swimstats/backend/internal/domain/meet"
 	"github.com/bpg/swimstats/backend/internal/domain/standard"
 	"github.com/bpg/swimstats/backend/internal/domain/swimmer"
@@ -108,6 +109,10 @@ func (s *Service) ImportSwimmerData(ctx context.Context, data *ImportData) (*Imp
 					fmt.Sprintf("Meet %s (ID: %s): %d duplicate event(s) skipped", meetData.Name, meetID, skipped))
 			}
 		}
+
+		if swimmerUUID, err := uuid.Parse(swimmerID); err == nil {
+			s.timeService.TimesImported(ctx, swimmerUUID)
+		}
 	}
 
 	// 3. Replace custom standards if present in import data
@@ -153,8 +158,8 @@ func (s *Service) parseSwimmer(data *SwimmerData) (*ParsedSwimmer, error) {
 		return nil, fmt.Errorf("swimmer name is required")
 	}
 
-	if gender != "female" && gender != "male" {
-		return nil, fmt.Errorf("gender must be 'female' or 'male', got: %s", gender)
+	if !domain.Gender(gender).IsValid() {
+		return nil, fmt.Errorf("gender must be 'female', 'male' or 'x', got: %s", gender)
 	}
 
 	birthDate, err := time.Parse("2006-01-02", birthDateStr)
@@ -168,12 +173,43 @@ func (s *Service) parseSwimmer(data *SwimmerData) (*ParsedSwimmer, error) {
 			return nil, fmt.Errorf("threshold_percent must be between 0 and 100, got: %f", *data.ThresholdPercent)
 		}
 	}
+	for field, adj := range map[string]*int{
+		"manual_adjustment_ms":         data.ManualAdjustmentMS,
+		"semi_automatic_adjustment_ms": data.SemiAutomaticAdjustmentMS,
+	} {
+		if adj != nil && (*adj < 0 || *adj > 10000) {
+			return nil, fmt.Errorf("%s must be between 0 and 10000, got: %d", field, *adj)
+		}
+	}
+
+	category := strings.TrimSpace(data.Category)
+	if category == "" {
+		category = string(domain.CategoryAgeGroup)
+	}
+	if !domain.Category(category).IsValid() {
+		return nil, fmt.Errorf("category must be 'age_group' or 'masters', got: %s", category)
+	}
+	for family, class := range map[domain.SportClassFamily]*string{
+		domain.SportClassFamilyS:  data.SportClassS,
+		domain.SportClassFamilySB: data.SportClassSB,
+		domain.SportClassFamilySM: data.SportClassSM,
+	} {
+		if class != nil && *class != "" && domain.SportClass(strings.ToUpper(*class)).Family() != family {
+			return nil, fmt.Errorf("invalid %s sport class: %s", family, *class)
+		}
+	}
 
 	return &ParsedSwimmer{
-		Name:             name,
-		BirthDate:        birthDate,
-		Gender:           gender,
-		ThresholdPercent: data.ThresholdPercent,
+		Name:                      name,
+		BirthDate:                 birthDate,
+		Gender:                    gender,
+		ThresholdPercent:          data.ThresholdPercent,
+		ManualAdjustmentMS:        data.ManualAdjustmentMS,
+		SemiAutomaticAdjustmentMS: data.SemiAutomaticAdjustmentMS,
+		Category:                  category,
+		SportClassS:               data.SportClassS,
+		SportClassSB:              data.SportClassSB,
+		SportClassSM:              data.SportClassSM,
 	}, nil
 }
 
@@ -191,8 +227,8 @@ func (s *Service) parseMeet(data *MeetData) (*ParsedMeet, error) {
 		return nil, fmt.Errorf("meet name is required")
 	}
 
-	if courseType != "25m" && courseType != "50m" {
-		return nil, fmt.Errorf("course_type must be '25m' or '50m', got: %s", courseType)
+	if !domain.CourseType(courseType).IsValid() {
+		return nil, fmt.Errorf("course_type must be '25m', '50m' or 'open_water', got: %s", courseType)
 	}
 
 	startDate, err := time.Parse("2006-01-02", startDateStr)
@@ -212,7 +248,7 @@ func (s *Service) parseMeet(data *MeetData) (*ParsedMeet, error) {
 	// Parse times
 	parsedTimes := make([]ParsedTime, 0, len(data.Times))
 	for i, timeData := range data.Times {
-		parsedTime, err := s.parseTime(&timeData, startDate, endDate)
+		parsedTime, err := s.parseTime(&timeData, courseType, startDate, endDate)
 		if err != nil {
 			return nil, fmt.Errorf("time %d validation failed: %v", i+1, err)
 		}
@@ -231,7 +267,7 @@ func (s *Service) parseMeet(data *MeetData) (*ParsedMeet, error) {
 }
 
 // parseTime validates and parses time data.
-func (s *Service) parseTime(data *TimeData, meetStart, meetEnd time.Time) (*ParsedTime, error) {
+func (s *Service) parseTime(data *TimeData, courseType string, meetStart, meetEnd time.Time) (*ParsedTime, error) {
 	// Sanitize input
 	event := strings.TrimSpace(data.Event)
 	timeStr := strings.TrimSpace(data.Time)
@@ -242,16 +278,8 @@ func (s *Service) parseTime(data *TimeData, meetStart, meetEnd time.Time) (*Pars
 		return nil, fmt.Errorf("event is required")
 	}
 
-	// Validate event code (basic validation - list of valid events)
-	validEvents := map[string]bool{
-		"50FR": true, "100FR": true, "200FR": true, "400FR": true, "800FR": true, "1500FR": true,
-		"50BK": true, "100BK": true, "200BK": true,
-		"50BR": true, "100BR": true, "200BR": true,
-		"50FL": true, "100FL": true, "200FL": true,
-		"200IM": true, "400IM": true,
-	}
-
-	if !validEvents[event] {
+	// Validate event code against the event catalogue
+	if !domain.IsValidEvent(event) {
 		return nil, fmt.Errorf("invalid event code: %s", event)
 	}
 
@@ -275,15 +303,52 @@ func (s *Service) parseTime(data *TimeData, meetStart, meetEnd time.Time) (*Pars
 			eventDateStr, meetStart.Format("2006-01-02"), meetEnd.Format("2006-01-02"))
 	}
 
+	timingMethod := strings.TrimSpace(data.TimingMethod)
+	if timingMethod == "" {
+		timingMethod = string(domain.TimingElectronic)
+	}
+	if !domain.TimingMethod(timingMethod).IsValid() {
+		return nil, fmt.Errorf("invalid timing_method: %s", timingMethod)
+	}
+
+	placement := timeservice.Placement{
+		Heat:          data.Heat,
+		Lane:          data.Lane,
+		HeatPlace:     data.HeatPlace,
+		OverallPlace:  data.OverallPlace,
+		AgeGroupPlace: data.AgeGroupPlace,
+		PointsEarned:  data.PointsEarned,
+	}
+	if err := placement.Validate(); err != nil {
+		return nil, err
+	}
+	if err := timeservice.ValidateReactionTime(data.ReactionTime); err != nil {
+		return nil, err
+	}
+
+	conditions := timeservice.OpenWaterConditions{
+		WaterTemperatureC: data.WaterTemperatureC,
+		Wetsuit:           data.Wetsuit,
+	}
+	if err := conditions.Validate(courseType); err != nil {
+		return nil, err
+	}
+
 	return &ParsedTime{
 		Event:     event,
 		TimeMS:    int32(timeMS),
 		EventDate: eventDate,
 		Notes:     notes,
+		Placement: placement,
+
+		TimingMethod:   timingMethod,
+		ReactionTimeMS: data.ReactionTime,
+
+		OpenWaterConditions: conditions,
 	}, nil
 }
 
-// parseTimeToMS converts a time string (MM:SS.HH or SS.HH) to milliseconds.
+// parseTimeToMS converts a time string (H:MM:SS.HH, MM:SS.HH or SS.HH) to milliseconds.
 func parseTimeToMS(timeStr string) (int, error) {
 	parts := strings.Split(timeStr, ":")
 
@@ -310,8 +375,26 @@ func parseTimeToMS(timeStr string) (int, error) {
 		}
 
 		totalSeconds = float64(minutes)*60 + seconds
+	case 3:
+		// Format: H:MM:SS.HH (e.g., "1:58:31.40") for open-water swims
+		hours, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return 0, fmt.Errorf("invalid hours in time: %s", timeStr)
+		}
+
+		minutes, err := strconv.Atoi(parts[1])
+		if err != nil || minutes >= 60 {
+			return 0, fmt.Errorf("invalid minutes in time: %s", timeStr)
+		}
+
+		seconds, err := strconv.ParseFloat(parts[2], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid seconds in time: %s", timeStr)
+		}
+
+		totalSeconds = float64(hours)*3600 + float64(minutes)*60 + seconds
 	default:
-		return 0, fmt.Errorf("invalid time format (expected MM:SS.HH or SS.HH): %s", timeStr)
+		return 0, fmt.Errorf("invalid time format (expected H:MM:SS.HH, MM:SS.HH or SS.HH): %s", timeStr)
 	}
 
 	// Convert to milliseconds
@@ -327,10 +410,16 @@ func parseTimeToMS(timeStr string) (int, error) {
 // createOrUpdateSwimmer creates a new swimmer or updates existing one.
 func (s *Service) createOrUpdateSwimmer(ctx context.Context, parsed *ParsedSwimmer) (string, error) {
 	input := swimmer.Input{
-		Name:             parsed.Name,
-		BirthDate:        parsed.BirthDate.Format("2006-01-02"),
-		Gender:           parsed.Gender,
-		ThresholdPercent: parsed.ThresholdPercent,
+		Name:                      parsed.Name,
+		BirthDate:                 parsed.BirthDate.Format("2006-01-02"),
+		Gender:                    parsed.Gender,
+		ThresholdPercent:          parsed.ThresholdPercent,
+		ManualAdjustmentMS:        parsed.ManualAdjustmentMS,
+		SemiAutomaticAdjustmentMS: parsed.SemiAutomaticAdjustmentMS,
+		Category:                  parsed.Category,
+		SportClassS:               parsed.SportClassS,
+		SportClassSB:              parsed.SportClassSB,
+		SportClassSM:              parsed.SportClassSM,
 	}
 
 	created, _, err := s.swimmerService.CreateOrUpdate(ctx, input)
@@ -375,9 +464,15 @@ func (s *Service) importMeet(ctx context.Context, swimmerID string, parsed *Pars
 			TimeMS:    int(timeData.TimeMS),
 			EventDate: timeData.EventDate.Format("2006-01-02"),
 			Notes:     timeData.Notes,
+			Placement: timeData.Placement,
+
+			TimingMethod:   timeData.TimingMethod,
+			ReactionTimeMS: timeData.ReactionTimeMS,
+
+			OpenWaterConditions: timeData.OpenWaterConditions,
 		}
 
-		_, err := s.timeService.Create(ctx, swimmerUUID, timeInput)
+		_, err := s.timeService.CreateImported(ctx, swimmerUUID, timeInput)
 		if err != nil {
 			// Check if it's a duplicate event error
 			if strings.Contains(err.Error(), "DUPLICATE_EVENT") {
@@ -525,12 +620,28 @@ func (s *Service) parseStandard(data *StandardData) (*ParsedStandard, error) {
 		return nil, fmt.Errorf("standard name is required")
 	}
 
-	if data.CourseType != "25m" && data.CourseType != "50m" {
-		return nil, fmt.Errorf("course_type must be '25m' or '50m', got: %s", data.CourseType)
+	if !domain.CourseType(data.CourseType).IsValid() {
+		return nil, fmt.Errorf("course_type must be '25m', '50m' or 'open_water', got: %s", data.CourseType)
+	}
+
+	if !domain.Gender(data.Gender).IsValidForStandard() {
+		return nil, fmt.Errorf("gender must be 'female', 'male', 'x' or 'mixed', got: %s", data.Gender)
+	}
+
+	policy := strings.TrimSpace(data.ManualTimePolicy)
+	if policy == "" {
+		policy = string(domain.ManualTimeAccept)
+	}
+	if !domain.ManualTimePolicy(policy).IsValid() {
+		return nil, fmt.Errorf("manual_time_policy must be 'accept', 'adjust' or 'ineligible', got: %s", policy)
 	}
 
-	if data.Gender != "female" && data.Gender != "male" {
-		return nil, fmt.Errorf("gender must be 'female' or 'male', got: %s", data.Gender)
+	category := strings.TrimSpace(data.Category)
+	if category == "" {
+		category = string(domain.CategoryAgeGroup)
+	}
+	if !domain.Category(category).IsValid() {
+		return nil, fmt.Errorf("category must be 'age_group' or 'masters', got: %s", category)
 	}
 
 	// Parse times for each event
@@ -544,15 +655,17 @@ func (s *Service) parseStandard(data *StandardData) (*ParsedStandard, error) {
 				return nil, fmt.Errorf("invalid time format for event %s: %s (expected format: ageGroup:time)", event, timeStr)
 			}
 
-			ageGroup := parts[0]
+			// Para standards qualify the age group with a sport class, e.g. "OPEN/S9"
+			ageGroup, sportClass, _ := strings.Cut(parts[0], "/")
 			timeMS, err := parseTimeToMS(parts[1])
 			if err != nil {
 				return nil, fmt.Errorf("invalid time for event %s, age group %s: %v", event, ageGroup, err)
 			}
 
 			timesForEvent = append(timesForEvent, ParsedStandardTime{
-				AgeGroup: ageGroup,
-				TimeMS:   int32(timeMS),
+				AgeGroup:   ageGroup,
+				SportClass: strings.ToUpper(sportClass),
+				TimeMS:     int32(timeMS),
 			})
 		}
 		parsedTimes[event] = timesForEvent
@@ -564,6 +677,9 @@ func (s *Service) parseStandard(data *StandardData) (*ParsedStandard, error) {
 		CourseType:  data.CourseType,
 		Gender:      data.Gender,
 		Times:       parsedTimes,
+
+		ManualTimePolicy: policy,
+		Category:         category,
 	}, nil
 }
 
@@ -575,6 +691,9 @@ func (s *Service) importStandard(ctx context.Context, parsed *ParsedStandard) er
 		Description: parsed.Description,
 		CourseType:  parsed.CourseType,
 		Gender:      parsed.Gender,
+
+		ManualTimePolicy: parsed.ManualTimePolicy,
+		Category:         parsed.Category,
 	}
 
 	createdStandard, err := s.standardService.Create(ctx, standardInput)
@@ -587,9 +706,10 @@ func (s *Service) importStandard(ctx context.Context, parsed *ParsedStandard) er
 	for event, timesForEvent := range parsed.Times {
 		for _, t := range timesForEvent {
 			times = append(times, standard.StandardTimeInput{
-				Event:    event,
-				AgeGroup: t.AgeGroup,
-				TimeMs:   int(t.TimeMS),
+				Event:      event,
+				AgeGroup:   t.AgeGroup,
+				SportClass: t.SportClass,
+				TimeMs:     int(t.TimeMS),
 			})
 		}
 	}
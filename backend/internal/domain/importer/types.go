@@ -3,6 +3,8 @@ package importer
 
 import (
 	"time"
+
+	timeservice "github.com/bpg/swimstats/backend/internal/domain/time"
 )
 
 // ImportData represents the root structure for importing swimmer data.
@@ -22,8 +24,17 @@ type SwimmerImport = ImportData
 type SwimmerData struct {
 	Name             string   `json:"name"`
 	BirthDate        string   `json:"birth_date"`                  // YYYY-MM-DD format
-	Gender           string   `json:"gender"`                      // "female" or "male"
+	Gender           string   `json:"gender"`                      // "female", "male" or "x"
 	ThresholdPercent *float64 `json:"threshold_percent,omitempty"` // "almost there" threshold percentage
+	// Adjustments added to manual/semi-automatic times when comparing against standards
+	ManualAdjustmentMS        *int `json:"manual_adjustment_ms,omitempty"`
+	SemiAutomaticAdjustmentMS *int `json:"semi_automatic_adjustment_ms,omitempty"`
+	// Category is "age_group" or "masters" (default "age_group")
+	Category string `json:"category,omitempty"`
+	// Para-swimming sport classes per stroke family, e.g. "S9", "SB8", "SM9"
+	SportClassS  *string `json:"sport_class_s,omitempty"`
+	SportClassSB *string `json:"sport_class_sb,omitempty"`
+	SportClassSM *string `json:"sport_class_sm,omitempty"`
 }
 
 // MeetData represents a meet with its associated times for import.
@@ -33,25 +44,40 @@ type MeetData struct {
 	Country    string     `json:"country"`
 	StartDate  string     `json:"start_date"`  // YYYY-MM-DD format
 	EndDate    string     `json:"end_date"`    // YYYY-MM-DD format
-	CourseType string     `json:"course_type"` // "25m" or "50m"
+	CourseType string     `json:"course_type"` // "25m", "50m" or "open_water"
 	Times      []TimeData `json:"times"`
 }
 
 // TimeData represents a swim time for import.
 type TimeData struct {
-	Event     string `json:"event"`      // Event code (e.g., "50FR", "100BK")
-	Time      string `json:"time"`       // Time in MM:SS.HH or SS.HH format
-	EventDate string `json:"event_date"` // YYYY-MM-DD format
-	Notes     string `json:"notes"`      // Optional notes
+	Event         string   `json:"event"`                      // Event code (e.g., "50FR", "100BK")
+	Time          string   `json:"time"`                       // Time in H:MM:SS.HH, MM:SS.HH or SS.HH format
+	EventDate     string   `json:"event_date"`                 // YYYY-MM-DD format
+	Notes         string   `json:"notes"`                      // Optional notes
+	Heat          *int     `json:"heat,omitempty"`             // Optional heat number
+	Lane          *int     `json:"lane,omitempty"`             // Optional lane number
+	HeatPlace     *int     `json:"heat_place,omitempty"`       // Optional place within the heat
+	OverallPlace  *int     `json:"overall_place,omitempty"`    // Optional overall place
+	AgeGroupPlace *int     `json:"age_group_place,omitempty"`  // Optional age-group place
+	PointsEarned  *float64 `json:"points_earned,omitempty"`    // Optional team/meet points
+	TimingMethod  string   `json:"timing_method,omitempty"`    // "electronic" (default), "semi_automatic" or "manual"
+	ReactionTime  *int     `json:"reaction_time_ms,omitempty"` // Optional start reaction time in ms
+
+	WaterTemperatureC *float64 `json:"water_temperature_c,omitempty"` // Optional open-water temperature in °C
+	Wetsuit           *bool    `json:"wetsuit,omitempty"`             // Optional open-water wetsuit use
 }
 
 // StandardData represents a time standard for import.
 type StandardData struct {
 	Name        string              `json:"name"`
 	Description string              `json:"description"`
-	CourseType  string              `json:"course_type"` // "25m" or "50m"
-	Gender      string              `json:"gender"`      // "female" or "male"
-	Times       map[string][]string `json:"times"`       // Event -> [age_group:time, ...]
+	CourseType  string              `json:"course_type"` // "25m", "50m" or "open_water"
+	Gender      string              `json:"gender"`      // "female", "male", "x" or "mixed"
+	Times       map[string][]string `json:"times"`       // Event -> [age_group[/sport_class]:time, ...]
+	// ManualTimePolicy is "accept", "adjust" or "ineligible" (default "accept")
+	ManualTimePolicy string `json:"manual_time_policy,omitempty"`
+	// Category is "age_group" or "masters" (default "age_group")
+	Category string `json:"category,omitempty"`
 }
 
 // ImportRequest wraps ImportData with a confirmation flag.
@@ -93,6 +119,13 @@ type ParsedSwimmer struct {
 	BirthDate        time.Time
 	Gender           string
 	ThresholdPercent *float64
+	// Timing adjustments (nil means use the default)
+	ManualAdjustmentMS        *int
+	SemiAutomaticAdjustmentMS *int
+	Category                  string
+	SportClassS               *string
+	SportClassSB              *string
+	SportClassSM              *string
 }
 
 // ParsedMeet is the validated meet data ready for database insertion.
@@ -112,6 +145,12 @@ type ParsedTime struct {
 	TimeMS    int32
 	EventDate time.Time
 	Notes     string
+	Placement timeservice.Placement
+
+	TimingMethod   string
+	ReactionTimeMS *int
+
+	OpenWaterConditions timeservice.OpenWaterConditions
 }
 
 // ParsedStandard is the validated standard data ready for database insertion.
@@ -121,10 +160,14 @@ type ParsedStandard struct {
 	CourseType  string
 	Gender      string
 	Times       map[string][]ParsedStandardTime
+
+	ManualTimePolicy string
+	Category         string
 }
 
 // ParsedStandardTime represents a single time entry in a standard.
 type ParsedStandardTime struct {
-	AgeGroup string
-	TimeMS   int32
+	AgeGroup   string
+	SportClass string
+	TimeMS     int32
 }
@@ -0,0 +1,273 @@
+// Package ladder provides standard ladder domain logic. A ladder groups
+// standards into ordered tiers, such as B, BB, A, AA, AAA and AAAA motivational times.
+package ladder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// MaxLabelLength is the maximum length of a tier label.
+const MaxLabelLength = 50
+
+// Service provides ladder business logic.
+type Service struct {
+	repo         *postgres.LadderRepository
+	standardRepo *postgres.StandardRepository
+}
+
+// NewService creates a new ladder service.
+func NewService(repo *postgres.LadderRepository, standardRepo *postgres.StandardRepository) *Service {
+	return &Service{
+		repo:         repo,
+		standardRepo: standardRepo,
+	}
+}
+
+// Tier is one standard of a ladder. Position 1 is the easiest tier.
+type Tier struct {
+	Position     int       `json:"position"`
+	StandardID   uuid.UUID `json:"standard_id"`
+	StandardName string    `json:"standard_name"`
+	// Label is the tier name (e.g. "AAA"); it defaults to the standard name.
+	Label string `json:"label"`
+}
+
+// Ladder represents a ladder with its tiers, lowest first.
+type Ladder struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	CourseType  string    `json:"course_type"`
+	Tiers       []Tier    `json:"tiers"`
+}
+
+// LadderList represents a list of ladders.
+type LadderList struct {
+	Ladders []Ladder `json:"ladders"`
+}
+
+// TierInput represents a tier of a ladder.
+type TierInput struct {
+	StandardID uuid.UUID `json:"standard_id"`
+	Label      string    `json:"label,omitempty"`
+}
+
+// Input represents input for creating or updating a ladder.
+// Tiers are listed from the easiest to the hardest standard.
+type Input struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	CourseType  string      `json:"course_type"`
+	Tiers       []TierInput `json:"tiers"`
+}
+
+// Sanitize trims whitespace from string fields.
+func (i *Input) Sanitize() {
+	i.Name = strings.TrimSpace(i.Name)
+	i.Description = strings.TrimSpace(i.Description)
+	i.CourseType = strings.TrimSpace(i.CourseType)
+	for idx := range i.Tiers {
+		i.Tiers[idx].Label = strings.TrimSpace(i.Tiers[idx].Label)
+	}
+}
+
+// Validate validates the ladder input. Call Sanitize() first.
+func (i Input) Validate() error {
+	if i.Name == "" {
+		return errors.New("name is required")
+	}
+	if len(i.Name) > 255 {
+		return errors.New("name must be at most 255 characters")
+	}
+	if !domain.CourseType(i.CourseType).IsValid() {
+		return errors.New("course_type must be '25m', '50m' or 'open_water'")
+	}
+	if len(i.Tiers) == 0 {
+		return errors.New("at least one tier is required")
+	}
+	seen := make(map[uuid.UUID]bool, len(i.Tiers))
+	for idx, tier := range i.Tiers {
+		if tier.StandardID == uuid.Nil {
+			return fmt.Errorf("tiers[%d]: standard_id is required", idx)
+		}
+		if seen[tier.StandardID] {
+			return fmt.Errorf("tiers[%d]: standard is already in the ladder", idx)
+		}
+		seen[tier.StandardID] = true
+		if len(tier.Label) > MaxLabelLength {
+			return fmt.Errorf("tiers[%d]: label must be at most %d characters", idx, MaxLabelLength)
+		}
+	}
+	return nil
+}
+
+// Get retrieves a ladder with its tiers.
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*Ladder, error) {
+	dbLadder, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.withTiers(ctx, dbLadder)
+}
+
+// List retrieves all ladders, optionally filtered by course type.
+func (s *Service) List(ctx context.Context, courseType *string) (*LadderList, error) {
+	dbLadders, err := s.repo.List(ctx, courseType)
+	if err != nil {
+		return nil, err
+	}
+
+	ladders := make([]Ladder, 0, len(dbLadders))
+	for _, dbLadder := range dbLadders {
+		ladder, err := s.withTiers(ctx, &dbLadder)
+		if err != nil {
+			return nil, err
+		}
+		ladders = append(ladders, *ladder)
+	}
+	return &LadderList{Ladders: ladders}, nil
+}
+
+// Create creates a new ladder.
+func (s *Service) Create(ctx context.Context, input Input) (*Ladder, error) {
+	if err := s.validate(ctx, &input, uuid.UUID{}); err != nil {
+		return nil, err
+	}
+
+	dbLadder, err := s.repo.Create(ctx, db.CreateLadderParams{
+		Name:        input.Name,
+		Description: postgres.TextOrNull(input.Description),
+		CourseType:  input.CourseType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.createTiers(ctx, dbLadder.ID, input.Tiers); err != nil {
+		return nil, err
+	}
+	return s.withTiers(ctx, dbLadder)
+}
+
+// Update updates a ladder, replacing its tiers.
+func (s *Service) Update(ctx context.Context, id uuid.UUID, input Input) (*Ladder, error) {
+	if _, err := s.repo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := s.validate(ctx, &input, id); err != nil {
+		return nil, err
+	}
+
+	dbLadder, err := s.repo.Update(ctx, db.UpdateLadderParams{
+		ID:          id,
+		Name:        input.Name,
+		Description: postgres.TextOrNull(input.Description),
+		CourseType:  input.CourseType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DeleteTiers(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := s.createTiers(ctx, id, input.Tiers); err != nil {
+		return nil, err
+	}
+	return s.withTiers(ctx, dbLadder)
+}
+
+// Delete deletes a ladder.
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.repo.Get(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// validate sanitizes and validates the input, checking that the name is free
+// and that every standard exists and is for the ladder's course type.
+func (s *Service) validate(ctx context.Context, input *Input, excludeID uuid.UUID) error {
+	input.Sanitize()
+	if err := input.Validate(); err != nil {
+		return fmt.Errorf("validation: %w", err)
+	}
+
+	exists, err := s.repo.NameExists(ctx, input.Name, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.New("validation: a ladder with this name already exists")
+	}
+
+	for idx, tier := range input.Tiers {
+		standard, err := s.standardRepo.Get(ctx, tier.StandardID)
+		if err != nil {
+			if errors.Is(err, postgres.ErrNotFound) {
+				return fmt.Errorf("validation: tiers[%d]: standard not found", idx)
+			}
+			return err
+		}
+		if standard.CourseType != input.CourseType {
+			return fmt.Errorf("validation: tiers[%d]: standard %s is for %s course, not %s", idx, standard.Name, standard.CourseType, input.CourseType)
+		}
+	}
+	return nil
+}
+
+func (s *Service) createTiers(ctx context.Context, ladderID uuid.UUID, tiers []TierInput) error {
+	for idx, tier := range tiers {
+		if err := s.repo.CreateTier(ctx, db.CreateLadderTierParams{
+			LadderID:   ladderID,
+			Position:   int32(idx + 1),
+			StandardID: tier.StandardID,
+			Label:      postgres.TextOrNull(tier.Label),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) withTiers(ctx context.Context, dbLadder *db.StandardLadder) (*Ladder, error) {
+	dbTiers, err := s.repo.ListTiers(ctx, dbLadder.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ladder := &Ladder{
+		ID:          dbLadder.ID,
+		Name:        dbLadder.Name,
+		Description: dbLadder.Description.String,
+		CourseType:  dbLadder.CourseType,
+		Tiers:       make([]Tier, len(dbTiers)),
+	}
+	for i, t := range dbTiers {
+		ladder.Tiers[i] = ToTier(t)
+	}
+	return ladder, nil
+}
+
+// ToTier converts a tier row, defaulting the label to the standard name.
+func ToTier(row db.ListLadderTiersRow) Tier {
+	label := row.Label.String
+	if label == "" {
+		label = row.StandardName
+	}
+	return Tier{
+		Position:     int(row.Position),
+		StandardID:   row.StandardID,
+		StandardName: row.StandardName,
+		Label:        label,
+	}
+}
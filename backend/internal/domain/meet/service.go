@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 
+	"github.com/bpg/swimstats/backend/internal/domain"
 	"github.com/bpg/swimstats/backend/internal/store/db"
 	"github.com/bpg/swimstats/backend/internal/store/postgres"
 )
@@ -35,6 +36,18 @@ type Meet struct {
 	EndDate    string    `json:"end_date"`
 	CourseType string    `json:"course_type"`
 	TimeCount  int       `json:"time_count,omitempty"`
+	Results    *Results  `json:"results,omitempty"`
+}
+
+// Results summarizes the placements achieved at a meet.
+// Medals count age-group places, falling back to overall places.
+type Results struct {
+	Gold        int     `json:"gold"`
+	Silver      int     `json:"silver"`
+	Bronze      int     `json:"bronze"`
+	TopEight    int     `json:"top_eight"`
+	PlacedCount int     `json:"placed_count"`
+	TotalPoints float64 `json:"total_points"`
 }
 
 // MeetList represents a paginated list of meets.
@@ -99,8 +112,8 @@ func (i Input) Validate() error {
 		return errors.New("end_date cannot be before start_date")
 	}
 
-	if i.CourseType != "25m" && i.CourseType != "50m" {
-		return errors.New("course_type must be '25m' or '50m'")
+	if !domain.CourseType(i.CourseType).IsValid() {
+		return errors.New("course_type must be '25m', '50m' or 'open_water'")
 	}
 	return nil
 }
@@ -118,7 +131,22 @@ func (s *Service) Get(ctx context.Context, id uuid.UUID) (*Meet, error) {
 	if err != nil {
 		return nil, err
 	}
-	return toMeetFromRow(row), nil
+
+	summary, err := s.repo.GetResultSummary(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	m := toMeetFromRow(row)
+	m.Results = &Results{
+		Gold:        int(summary.Gold),
+		Silver:      int(summary.Silver),
+		Bronze:      int(summary.Bronze),
+		TopEight:    int(summary.TopEight),
+		PlacedCount: int(summary.PlacedCount),
+		TotalPoints: summary.TotalPoints,
+	}
+	return m, nil
 }
 
 // List retrieves a paginated list of meets.
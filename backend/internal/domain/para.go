@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SportClassFamily groups para-swimming sport classes by the strokes they apply to.
+type SportClassFamily string
+
+const (
+	// SportClassFamilyS covers freestyle, backstroke and butterfly.
+	SportClassFamilyS SportClassFamily = "S"
+	// SportClassFamilySB covers breaststroke.
+	SportClassFamilySB SportClassFamily = "SB"
+	// SportClassFamilySM covers individual medley.
+	SportClassFamilySM SportClassFamily = "SM"
+)
+
+// Sport classes run from 1 (most severe impairment) to 14.
+const (
+	MinSportClass = 1
+	MaxSportClass = 14
+)
+
+// SportClassFamilyForStroke returns the sport class family used for a stroke.
+func SportClassFamilyForStroke(s Stroke) SportClassFamily {
+	switch s {
+	case StrokeBreaststroke:
+		return SportClassFamilySB
+	case StrokeMedley:
+		return SportClassFamilySM
+	default:
+		return SportClassFamilyS
+	}
+}
+
+// SportClass is a para-swimming sport class such as "S9", "SB8" or "SM9".
+type SportClass string
+
+// Family returns the family prefix of the class, or empty string if the class is invalid.
+func (c SportClass) Family() SportClassFamily {
+	family, _, ok := c.parse()
+	if !ok {
+		return ""
+	}
+	return family
+}
+
+// IsValid checks if the sport class is a known family and number.
+func (c SportClass) IsValid() bool {
+	_, _, ok := c.parse()
+	return ok
+}
+
+func (c SportClass) parse() (SportClassFamily, int, bool) {
+	s := string(c)
+	// Check the longer prefixes first so "SB8" is not read as "S" + "B8"
+	for _, family := range []SportClassFamily{SportClassFamilySB, SportClassFamilySM, SportClassFamilyS} {
+		rest, found := strings.CutPrefix(s, string(family))
+		if !found {
+			continue
+		}
+		n, err := strconv.Atoi(rest)
+		if err != nil || rest != strconv.Itoa(n) || n < MinSportClass || n > MaxSportClass {
+			return "", 0, false
+		}
+		return family, n, true
+	}
+	return "", 0, false
+}
+
+// SportClasses holds a swimmer's classification in each sport class family.
+// Empty fields mean the swimmer is not classified in that family.
+type SportClasses struct {
+	S  SportClass
+	SB SportClass
+	SM SportClass
+}
+
+// ForEvent returns the swimmer's sport class for the stroke of a catalogued event.
+func (c SportClasses) ForEvent(code EventCode) SportClass {
+	event, ok := LookupEvent(code)
+	if !ok {
+		return ""
+	}
+	switch SportClassFamilyForStroke(event.Stroke) {
+	case SportClassFamilySB:
+		return c.SB
+	case SportClassFamilySM:
+		return c.SM
+	default:
+		return c.S
+	}
+}
+
+// IsEmpty reports whether the swimmer has no classification at all.
+func (c SportClasses) IsEmpty() bool {
+	return c.S == "" && c.SB == "" && c.SM == ""
+}
+
+// ParaPoints scores a swim against a World Para Swimming base time.
+// World Para Swimming uses the same cubic formula as World Aquatics.
+func ParaPoints(baseTimeMS, timeMS int) int {
+	return WorldAquaticsPoints(baseTimeMS, timeMS)
+}
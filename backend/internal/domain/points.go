@@ -0,0 +1,13 @@
+package domain
+
+import "math"
+
+// WorldAquaticsPoints scores a swim against a World Aquatics base time:
+// points = 1000 × (base time / swim time)³, rounded down.
+func WorldAquaticsPoints(baseTimeMS, timeMS int) int {
+	if baseTimeMS <= 0 || timeMS <= 0 {
+		return 0
+	}
+	ratio := float64(baseTimeMS) / float64(timeMS)
+	return int(math.Floor(1000 * ratio * ratio * ratio))
+}
@@ -0,0 +1,162 @@
+package pointstable
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+)
+
+// FormatRudolph is the file format of Rudolph tables.
+const FormatRudolph = "rudolph"
+
+func init() {
+	RegisterFormat(FormatRudolph, ParseRudolph)
+}
+
+// rudolphFile is the on-disk layout of a Rudolph table for one course and gender:
+// event -> age -> qualifying times, fastest (most points) first. With the usual
+// 20 rows the first time is worth 20 points and the last 1 point.
+type rudolphFile struct {
+	Format      string                         `json:"format"`
+	Name        string                         `json:"name"`
+	Description string                         `json:"description"`
+	CourseType  string                         `json:"course_type"`
+	Gender      string                         `json:"gender"`
+	Times       map[string]map[string][]string `json:"times"`
+}
+
+type rudolphKey struct {
+	event      domain.EventCode
+	courseType domain.CourseType
+	gender     domain.Gender
+}
+
+// rudolphRows holds the qualifying times of one event by age.
+type rudolphRows struct {
+	ages  []int // ascending
+	times map[int][]int
+}
+
+// RudolphTable scores swims by the row of the swimmer's age they reach.
+// The row for the oldest age applies to all older swimmers; swimmers younger
+// than the youngest age are not scored.
+type RudolphTable struct {
+	name        string
+	description string
+	rows        map[rudolphKey]*rudolphRows
+}
+
+// ParseRudolph parses a Rudolph table file.
+func ParseRudolph(data []byte) (Table, error) {
+	var file rudolphFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	file.Name = strings.TrimSpace(file.Name)
+	if file.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	courseType := domain.CourseType(file.CourseType)
+	if !courseType.IsValid() {
+		return nil, fmt.Errorf("course_type must be '25m', '50m' or 'open_water'")
+	}
+	gender := domain.Gender(file.Gender)
+	if !gender.IsValid() {
+		return nil, fmt.Errorf("gender must be 'female', 'male' or 'x'")
+	}
+	if len(file.Times) == 0 {
+		return nil, fmt.Errorf("no times defined")
+	}
+
+	table := &RudolphTable{
+		name:        file.Name,
+		description: file.Description,
+		rows:        make(map[rudolphKey]*rudolphRows, len(file.Times)),
+	}
+	for event, byAge := range file.Times {
+		rows := &rudolphRows{times: make(map[int][]int, len(byAge))}
+		for ageStr, timeStrs := range byAge {
+			age, err := strconv.Atoi(ageStr)
+			if err != nil || age <= 0 {
+				return nil, fmt.Errorf("%s: invalid age '%s'", event, ageStr)
+			}
+			if len(timeStrs) == 0 {
+				return nil, fmt.Errorf("%s age %d: no times defined", event, age)
+			}
+			times := make([]int, len(timeStrs))
+			for i, timeStr := range timeStrs {
+				timeMS, err := domain.ParseTime(timeStr)
+				if err != nil {
+					return nil, fmt.Errorf("%s age %d: invalid time '%s'", event, age, timeStr)
+				}
+				if i > 0 && timeMS <= times[i-1] {
+					return nil, fmt.Errorf("%s age %d: times must be listed fastest first", event, age)
+				}
+				times[i] = timeMS
+			}
+			rows.ages = append(rows.ages, age)
+			rows.times[age] = times
+		}
+		sort.Ints(rows.ages)
+		table.rows[rudolphKey{domain.EventCode(event), courseType, gender}] = rows
+	}
+	return table, nil
+}
+
+// Name returns the table name.
+func (t *RudolphTable) Name() string {
+	return t.name
+}
+
+// Description returns the table description.
+func (t *RudolphTable) Description() string {
+	return t.description
+}
+
+// Format returns FormatRudolph.
+func (t *RudolphTable) Format() string {
+	return FormatRudolph
+}
+
+// Score returns the points of the fastest row the swim reaches.
+// A swim slower than every row is worth 0 points.
+func (t *RudolphTable) Score(swim Swim) (int, bool) {
+	rows, ok := t.rows[rudolphKey{swim.Event, swim.CourseType, swim.Gender}]
+	if !ok || len(rows.ages) == 0 || swim.Age < rows.ages[0] || swim.TimeMS <= 0 {
+		return 0, false
+	}
+
+	// Oldest age row not above the swimmer's age
+	i := sort.SearchInts(rows.ages, swim.Age+1) - 1
+	times := rows.times[rows.ages[i]]
+	for j, limit := range times {
+		if swim.TimeMS <= limit {
+			return len(times) - j, true
+		}
+	}
+	return 0, true
+}
+
+// Merge adds the rows of another Rudolph table with the same name,
+// typically the file for another course or gender.
+func (t *RudolphTable) Merge(other Table) error {
+	o, ok := other.(*RudolphTable)
+	if !ok {
+		return fmt.Errorf("cannot merge %s table into Rudolph table %s", other.Format(), t.name)
+	}
+	for key, rows := range o.rows {
+		if _, exists := t.rows[key]; exists {
+			return fmt.Errorf("table %s defines %s %s %s twice", t.name, key.event, key.courseType, key.gender)
+		}
+		t.rows[key] = rows
+	}
+	if t.description == "" {
+		t.description = o.description
+	}
+	return nil
+}
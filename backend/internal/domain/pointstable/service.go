@@ -0,0 +1,223 @@
+package pointstable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// ErrTableNotFound is returned when no table with the requested name is loaded.
+var ErrTableNotFound = errors.New("points table not found")
+
+// Service scores a swimmer's times against the loaded points tables.
+type Service struct {
+	timeRepo    *postgres.TimeRepository
+	swimmerRepo *postgres.SwimmerRepository
+}
+
+// NewService creates a new points table service.
+func NewService(timeRepo *postgres.TimeRepository, swimmerRepo *postgres.SwimmerRepository) *Service {
+	return &Service{
+		timeRepo:    timeRepo,
+		swimmerRepo: swimmerRepo,
+	}
+}
+
+// TableInfo describes a loaded points table.
+type TableInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Format      string `json:"format"`
+}
+
+// TableList represents the loaded points tables.
+type TableList struct {
+	Tables []TableInfo `json:"tables"`
+}
+
+// SwimPoints is a single swim scored against a points table.
+// Points is omitted when the table does not cover the swim.
+type SwimPoints struct {
+	TimeID        string `json:"time_id"`
+	Event         string `json:"event"`
+	CourseType    string `json:"course_type"`
+	TimeMS        int    `json:"time_ms"`
+	TimeFormatted string `json:"time_formatted"`
+	MeetName      string `json:"meet"`
+	Date          string `json:"date"`
+	Season        string `json:"season"`
+	Age           int    `json:"age"`
+	Points        *int   `json:"points,omitempty"`
+}
+
+// SwimPointsList lists a swimmer's swims with their points, in chronological order.
+type SwimPointsList struct {
+	Table      string       `json:"table"`
+	SwimmerID  string       `json:"swimmer_id"`
+	CourseType *string      `json:"course_type,omitempty"`
+	Times      []SwimPoints `json:"times"`
+}
+
+// SeasonProfile lists the best-scoring swim of each event in a season,
+// from most to fewest points.
+type SeasonProfile struct {
+	Season      string       `json:"season"`
+	TotalPoints int          `json:"total_points"`
+	Events      []SwimPoints `json:"events"`
+}
+
+// PointsProfile is a swimmer's best-points event profile for each season.
+type PointsProfile struct {
+	Table      string          `json:"table"`
+	SwimmerID  string          `json:"swimmer_id"`
+	CourseType *string         `json:"course_type,omitempty"`
+	Seasons    []SeasonProfile `json:"seasons"`
+}
+
+// ListTables lists the loaded points tables.
+func (s *Service) ListTables() *TableList {
+	tables := Tables()
+	list := &TableList{Tables: make([]TableInfo, len(tables))}
+	for i, table := range tables {
+		list.Tables[i] = TableInfo{
+			Name:        table.Name(),
+			Description: table.Description(),
+			Format:      table.Format(),
+		}
+	}
+	return list
+}
+
+// ScoreTimes scores every swim of the swimmer against the named table, using
+// the swimmer's age at competition on the date of each swim.
+func (s *Service) ScoreTimes(ctx context.Context, tableName string, swimmerID uuid.UUID, courseType *string) (*SwimPointsList, error) {
+	table, ok := Lookup(tableName)
+	if !ok {
+		return nil, ErrTableNotFound
+	}
+	swims, err := s.scoreSwims(ctx, table, swimmerID, courseType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SwimPointsList{
+		Table:      table.Name(),
+		SwimmerID:  swimmerID.String(),
+		CourseType: courseType,
+		Times:      swims,
+	}, nil
+}
+
+// GetProfile builds the swimmer's best-points event profile for each season,
+// optionally limited to one season (e.g. "2025-26"). Seasons are in chronological
+// order and only include events with at least one scored swim.
+func (s *Service) GetProfile(ctx context.Context, tableName string, swimmerID uuid.UUID, courseType, season *string) (*PointsProfile, error) {
+	table, ok := Lookup(tableName)
+	if !ok {
+		return nil, ErrTableNotFound
+	}
+	swims, err := s.scoreSwims(ctx, table, swimmerID, courseType)
+	if err != nil {
+		return nil, err
+	}
+
+	type eventKey struct{ season, event, courseType string }
+	best := make(map[eventKey]SwimPoints)
+	var seasons []string
+	for _, swim := range swims {
+		if swim.Points == nil || (season != nil && swim.Season != *season) {
+			continue
+		}
+		key := eventKey{swim.Season, swim.Event, swim.CourseType}
+		current, ok := best[key]
+		// Swims are chronological, so ties keep the first swim to reach the points
+		if !ok || *swim.Points > *current.Points || (*swim.Points == *current.Points && swim.TimeMS < current.TimeMS) {
+			best[key] = swim
+		}
+		if len(seasons) == 0 || seasons[len(seasons)-1] != swim.Season {
+			seasons = append(seasons, swim.Season)
+		}
+	}
+
+	profile := &PointsProfile{
+		Table:      table.Name(),
+		SwimmerID:  swimmerID.String(),
+		CourseType: courseType,
+		Seasons:    make([]SeasonProfile, 0, len(seasons)),
+	}
+	for _, name := range seasons {
+		sp := SeasonProfile{Season: name, Events: []SwimPoints{}}
+		for key, swim := range best {
+			if key.season == name {
+				sp.Events = append(sp.Events, swim)
+				sp.TotalPoints += *swim.Points
+			}
+		}
+		sort.Slice(sp.Events, func(i, j int) bool {
+			a, b := sp.Events[i], sp.Events[j]
+			if *a.Points != *b.Points {
+				return *a.Points > *b.Points
+			}
+			if oa, ob := domain.EventSortOrder(a.Event), domain.EventSortOrder(b.Event); oa != ob {
+				return oa < ob
+			}
+			return a.CourseType < b.CourseType
+		})
+		profile.Seasons = append(profile.Seasons, sp)
+	}
+	return profile, nil
+}
+
+// scoreSwims scores the swimmer's times in chronological order.
+func (s *Service) scoreSwims(ctx context.Context, table Table, swimmerID uuid.UUID, courseType *string) ([]SwimPoints, error) {
+	if courseType != nil && !domain.CourseType(*courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", *courseType)
+	}
+
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+
+	rows, err := s.timeRepo.ListTimeHistory(ctx, swimmerID, courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+
+	swims := make([]SwimPoints, 0, len(rows))
+	for _, row := range rows {
+		if !row.Date.Valid {
+			continue
+		}
+		age := domain.AgeAtCompetition(swimmer.BirthDate.Time, row.Date.Time)
+		swim := SwimPoints{
+			TimeID:        row.ID.String(),
+			Event:         row.Event,
+			CourseType:    row.CourseType,
+			TimeMS:        int(row.TimeMs),
+			TimeFormatted: domain.FormatTime(int(row.TimeMs)),
+			MeetName:      row.MeetName,
+			Date:          row.Date.Time.Format("2006-01-02"),
+			Season:        domain.SeasonForDate(row.Date.Time),
+			Age:           age,
+		}
+		points, ok := table.Score(Swim{
+			Event:      domain.EventCode(row.Event),
+			CourseType: domain.CourseType(row.CourseType),
+			Gender:     domain.Gender(swimmer.Gender),
+			Age:        age,
+			TimeMS:     int(row.TimeMs),
+		})
+		if ok {
+			swim.Points = &points
+		}
+		swims = append(swims, swim)
+	}
+	return swims, nil
+}
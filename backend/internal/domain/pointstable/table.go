@@ -0,0 +1,161 @@
+// Package pointstable provides age-group points tables such as Rudolph tables.
+// Tables are loaded from files at startup; each file names the format it is
+// written in, so new table formats can be added by registering a parser.
+package pointstable
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+)
+
+// Swim is a single swim to be scored by a points table.
+type Swim struct {
+	Event      domain.EventCode
+	CourseType domain.CourseType
+	Gender     domain.Gender
+	Age        int
+	TimeMS     int
+}
+
+// Table scores swims.
+type Table interface {
+	// Name returns the unique name of the table, used in API paths.
+	Name() string
+	// Description returns a human readable description of the table.
+	Description() string
+	// Format returns the name of the file format the table was loaded from.
+	Format() string
+	// Score returns the points for a swim. It returns false when the table
+	// does not cover the event, course, gender or age of the swim.
+	Score(swim Swim) (int, bool)
+}
+
+// Merger is implemented by tables that can absorb another table of the same
+// name, e.g. when a table is split across one file per course and gender.
+type Merger interface {
+	Merge(other Table) error
+}
+
+// ParseFunc parses the contents of a table file.
+type ParseFunc func(data []byte) (Table, error)
+
+var formats = struct {
+	mu      sync.RWMutex
+	parsers map[string]ParseFunc
+}{parsers: make(map[string]ParseFunc)}
+
+// RegisterFormat registers a parser for a table file format.
+func RegisterFormat(name string, parse ParseFunc) {
+	formats.mu.Lock()
+	defer formats.mu.Unlock()
+	formats.parsers[name] = parse
+}
+
+// Parse parses a table file, dispatching on its "format" key.
+func Parse(data []byte) (Table, error) {
+	var header struct {
+		Format string `json:"format"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if header.Format == "" {
+		return nil, fmt.Errorf("format is required")
+	}
+
+	formats.mu.RLock()
+	parse, ok := formats.parsers[header.Format]
+	formats.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown table format: %s", header.Format)
+	}
+	return parse(data)
+}
+
+// LoadDir parses every *.json file in dir. Files that share a table name are
+// merged into a single table.
+func LoadDir(dir string) ([]Table, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("list table files: %w", err)
+	}
+	sort.Strings(paths)
+
+	tables := make([]Table, 0, len(paths))
+	byName := make(map[string]Table, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", path, err)
+		}
+		table, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+
+		existing, ok := byName[table.Name()]
+		if !ok {
+			byName[table.Name()] = table
+			tables = append(tables, table)
+			continue
+		}
+		merger, ok := existing.(Merger)
+		if !ok || existing.Format() != table.Format() {
+			return nil, fmt.Errorf("%s: duplicate table name: %s", filepath.Base(path), table.Name())
+		}
+		if err := merger.Merge(table); err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+	}
+	return tables, nil
+}
+
+// registry holds the tables available to the API.
+var registry struct {
+	mu     sync.RWMutex
+	tables []Table
+	byName map[string]Table
+}
+
+// SetTables replaces the available tables.
+func SetTables(tables []Table) {
+	sorted := make([]Table, len(tables))
+	copy(sorted, tables)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name() < sorted[j].Name()
+	})
+
+	byName := make(map[string]Table, len(sorted))
+	for _, table := range sorted {
+		byName[strings.ToLower(table.Name())] = table
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	registry.tables = sorted
+	registry.byName = byName
+}
+
+// Tables returns the available tables ordered by name.
+func Tables() []Table {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	tables := make([]Table, len(registry.tables))
+	copy(tables, registry.tables)
+	return tables
+}
+
+// Lookup returns the table with the given name, ignoring case.
+func Lookup(name string) (Table, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	table, ok := registry.byName[strings.ToLower(name)]
+	return table, ok
+}
@@ -0,0 +1,435 @@
+// Package ranking provides ranking snapshot domain logic. A ranking snapshot
+// is an imported ranking list, such as a provincial top 50, for one season,
+// event, course, gender and age group.
+package ranking
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// Service provides ranking snapshot business logic.
+type Service struct {
+	repo *postgres.RankingRepository
+}
+
+// NewService creates a new ranking service.
+func NewService(repo *postgres.RankingRepository) *Service {
+	return &Service{repo: repo}
+}
+
+// Snapshot represents a ranking snapshot.
+type Snapshot struct {
+	ID         uuid.UUID `json:"id"`
+	Source     string    `json:"source"`
+	Season     string    `json:"season"`
+	Event      string    `json:"event"`
+	CourseType string    `json:"course_type"`
+	Gender     string    `json:"gender"`
+	AgeGroup   string    `json:"age_group"`
+	CapturedOn string    `json:"captured_on,omitempty"`
+	EntryCount int       `json:"entry_count"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// SnapshotList represents a list of ranking snapshots.
+type SnapshotList struct {
+	Snapshots []Snapshot `json:"snapshots"`
+}
+
+// Entry represents a ranked time in a snapshot.
+type Entry struct {
+	Rank          int    `json:"rank"`
+	SwimmerName   string `json:"swimmer_name,omitempty"`
+	Club          string `json:"club,omitempty"`
+	TimeMS        int    `json:"time_ms"`
+	TimeFormatted string `json:"time_formatted"`
+}
+
+// SnapshotWithEntries represents a ranking snapshot with its entries,
+// fastest first.
+type SnapshotWithEntries struct {
+	Snapshot
+	Entries []Entry `json:"entries"`
+}
+
+// Filter restricts the snapshots listed. Empty fields are not filtered on.
+type Filter struct {
+	Season     string
+	CourseType string
+	Event      string
+	Gender     string
+	AgeGroup   string
+}
+
+// EntryInput represents one ranked time to import. The time is given either
+// as TimeMS or as a formatted Time such as "1:05.32". When Rank is not given
+// it is derived from the time, with equal times sharing a rank.
+type EntryInput struct {
+	Rank        int    `json:"rank,omitempty"`
+	SwimmerName string `json:"swimmer_name,omitempty"`
+	Club        string `json:"club,omitempty"`
+	Time        string `json:"time,omitempty"`
+	TimeMS      int    `json:"time_ms,omitempty"`
+}
+
+// Sanitize trims whitespace from string fields.
+func (i *EntryInput) Sanitize() {
+	i.SwimmerName = domain.SanitizeString(i.SwimmerName)
+	i.Club = domain.SanitizeString(i.Club)
+	i.Time = strings.TrimSpace(i.Time)
+}
+
+// Validate validates the entry and resolves Time into TimeMS. Call
+// Sanitize() first.
+func (i *EntryInput) Validate() error {
+	if i.Rank < 0 {
+		return errors.New("rank must be positive")
+	}
+	if i.Time != "" {
+		ms, err := domain.ParseTime(i.Time)
+		if err != nil {
+			return fmt.Errorf("invalid time '%s': %w", i.Time, err)
+		}
+		if i.TimeMS != 0 && i.TimeMS != ms {
+			return errors.New("time and time_ms disagree")
+		}
+		i.TimeMS = ms
+	}
+	if i.TimeMS <= 0 {
+		return errors.New("time is required")
+	}
+	return nil
+}
+
+// ImportInput represents a ranking snapshot to import. Importing a snapshot
+// with the source, season, event, course, gender and age group of an existing
+// one replaces its entries.
+type ImportInput struct {
+	Source     string       `json:"source"`
+	Season     string       `json:"season"`
+	Event      string       `json:"event"`
+	CourseType string       `json:"course_type"`
+	Gender     string       `json:"gender"`
+	AgeGroup   string       `json:"age_group"`
+	CapturedOn string       `json:"captured_on,omitempty"`
+	Entries    []EntryInput `json:"entries"`
+}
+
+// Sanitize trims whitespace from string fields.
+func (i *ImportInput) Sanitize() {
+	i.Source = domain.SanitizeString(i.Source)
+	i.Season = strings.TrimSpace(i.Season)
+	i.Event = strings.ToUpper(strings.TrimSpace(i.Event))
+	i.CourseType = strings.TrimSpace(i.CourseType)
+	i.Gender = strings.ToLower(strings.TrimSpace(i.Gender))
+	i.AgeGroup = strings.ToUpper(strings.TrimSpace(i.AgeGroup))
+	i.CapturedOn = strings.TrimSpace(i.CapturedOn)
+	for idx := range i.Entries {
+		i.Entries[idx].Sanitize()
+	}
+}
+
+// Validate validates the snapshot fields of the import. Entries are validated
+// one by one during the import. Call Sanitize() first.
+func (i ImportInput) Validate() error {
+	if i.Source == "" {
+		return errors.New("source is required")
+	}
+	if len(i.Source) > 255 {
+		return errors.New("source must be 255 characters or less")
+	}
+	if _, _, err := domain.SeasonDates(i.Season); err != nil {
+		return err
+	}
+	if !domain.EventCode(i.Event).IsValid() {
+		return fmt.Errorf("invalid event: %s", i.Event)
+	}
+	if !domain.CourseType(i.CourseType).IsValid() {
+		return fmt.Errorf("invalid course type: %s", i.CourseType)
+	}
+	if !domain.Gender(i.Gender).IsValid() {
+		return errors.New("gender must be 'female', 'male' or 'x'")
+	}
+	if ag := domain.AgeGroup(i.AgeGroup); !(domain.YouthScheme{}).IsValid(ag) && !(domain.MastersScheme{}).IsValid(ag) {
+		return fmt.Errorf("invalid age group: %s", i.AgeGroup)
+	}
+	if i.CapturedOn != "" {
+		if _, err := time.Parse("2006-01-02", i.CapturedOn); err != nil {
+			return errors.New("captured_on must be a valid date in YYYY-MM-DD format")
+		}
+	}
+	if len(i.Entries) == 0 {
+		return errors.New("no entries defined")
+	}
+	return nil
+}
+
+// ImportResult contains the results of a ranking snapshot import. Entries
+// that fail validation are skipped and reported in Errors.
+type ImportResult struct {
+	Snapshot SnapshotWithEntries `json:"snapshot"`
+	Replaced bool                `json:"replaced"`
+	Imported int                 `json:"imported"`
+	Skipped  int                 `json:"skipped"`
+	Errors   []string            `json:"errors,omitempty"`
+}
+
+// ParseCSV reads ranking entries from a CSV file with a header row naming
+// the time column and optionally rank, swimmer_name (or name) and club, in
+// any order.
+func ParseCSV(r io.Reader) ([]EntryInput, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("validation: empty CSV file")
+		}
+		return nil, fmt.Errorf("validation: invalid CSV: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := index["time"]; !ok {
+		return nil, errors.New("validation: CSV is missing the time column")
+	}
+	if _, ok := index["swimmer_name"]; !ok {
+		if i, ok := index["name"]; ok {
+			index["swimmer_name"] = i
+		}
+	}
+
+	var inputs []EntryInput
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("validation: invalid CSV: %w", err)
+		}
+		field := func(name string) string {
+			i, ok := index[name]
+			if !ok || i >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[i])
+		}
+		var rank int
+		if s := strings.TrimSuffix(field("rank"), "."); s != "" {
+			rank, err = strconv.Atoi(s)
+			if err != nil {
+				return nil, fmt.Errorf("validation: invalid rank '%s' on line %d", s, line)
+			}
+		}
+		inputs = append(inputs, EntryInput{
+			Rank:        rank,
+			SwimmerName: field("swimmer_name"),
+			Club:        field("club"),
+			Time:        field("time"),
+		})
+	}
+	return inputs, nil
+}
+
+// List retrieves the ranking snapshots matching the filter.
+func (s *Service) List(ctx context.Context, filter Filter) (*SnapshotList, error) {
+	rows, err := s.repo.ListSnapshots(ctx, postgres.ListSnapshotsParams{
+		Season:     filter.Season,
+		CourseType: filter.CourseType,
+		Event:      filter.Event,
+		Gender:     filter.Gender,
+		AgeGroup:   filter.AgeGroup,
+	})
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]Snapshot, len(rows))
+	for i, row := range rows {
+		snapshots[i] = toSnapshot(db.RankingSnapshot{
+			ID:         row.ID,
+			Source:     row.Source,
+			Season:     row.Season,
+			Event:      row.Event,
+			CourseType: row.CourseType,
+			Gender:     row.Gender,
+			AgeGroup:   row.AgeGroup,
+			CapturedOn: row.CapturedOn,
+			CreatedAt:  row.CreatedAt,
+			UpdatedAt:  row.UpdatedAt,
+		})
+		snapshots[i].EntryCount = int(row.EntryCount)
+	}
+	return &SnapshotList{Snapshots: snapshots}, nil
+}
+
+// Get retrieves a ranking snapshot with its entries.
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*SnapshotWithEntries, error) {
+	snapshot, err := s.repo.GetSnapshot(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.withEntries(ctx, snapshot)
+}
+
+// Delete deletes a ranking snapshot and its entries.
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.repo.GetSnapshot(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.DeleteSnapshot(ctx, id)
+}
+
+// Import creates a ranking snapshot with its entries, or replaces the
+// entries of the snapshot with the same source, season, event, course,
+// gender and age group.
+func (s *Service) Import(ctx context.Context, input ImportInput) (*ImportResult, error) {
+	input.Sanitize()
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("validation: %w", err)
+	}
+
+	result := &ImportResult{}
+	var valid []EntryInput
+	for idx := range input.Entries {
+		in := input.Entries[idx]
+		if err := in.Validate(); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("entries[%d]: %v", idx, err))
+			result.Skipped++
+			continue
+		}
+		valid = append(valid, in)
+	}
+	if len(valid) == 0 {
+		return nil, errors.New("validation: no valid entries")
+	}
+
+	// Derive missing ranks from the time order, equal times sharing a rank
+	sort.SliceStable(valid, func(i, j int) bool { return valid[i].TimeMS < valid[j].TimeMS })
+	for i := range valid {
+		if valid[i].Rank != 0 {
+			continue
+		}
+		valid[i].Rank = i + 1
+		if i > 0 && valid[i].TimeMS == valid[i-1].TimeMS {
+			valid[i].Rank = valid[i-1].Rank
+		}
+	}
+
+	snapshot, err := s.repo.GetSnapshotByKey(ctx, db.GetRankingSnapshotByKeyParams{
+		Source:     input.Source,
+		Season:     input.Season,
+		Event:      input.Event,
+		CourseType: input.CourseType,
+		Gender:     input.Gender,
+		AgeGroup:   input.AgeGroup,
+	})
+	switch {
+	case err == nil:
+		snapshot, err = s.repo.UpdateSnapshot(ctx, db.UpdateRankingSnapshotParams{
+			ID:         snapshot.ID,
+			CapturedOn: postgres.DateOrNull(input.CapturedOn),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := s.repo.DeleteEntries(ctx, snapshot.ID); err != nil {
+			return nil, err
+		}
+		result.Replaced = true
+	case errors.Is(err, postgres.ErrNotFound):
+		snapshot, err = s.repo.CreateSnapshot(ctx, db.CreateRankingSnapshotParams{
+			Source:     input.Source,
+			Season:     input.Season,
+			Event:      input.Event,
+			CourseType: input.CourseType,
+			Gender:     input.Gender,
+			AgeGroup:   input.AgeGroup,
+			CapturedOn: postgres.DateOrNull(input.CapturedOn),
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	for _, in := range valid {
+		if _, err := s.repo.CreateEntry(ctx, db.CreateRankingEntryParams{
+			SnapshotID:  snapshot.ID,
+			Rank:        int32(in.Rank),
+			SwimmerName: postgres.TextOrNull(in.SwimmerName),
+			Club:        postgres.TextOrNull(in.Club),
+			TimeMs:      int32(in.TimeMS),
+		}); err != nil {
+			return nil, err
+		}
+		result.Imported++
+	}
+
+	withEntries, err := s.withEntries(ctx, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	result.Snapshot = *withEntries
+	return result, nil
+}
+
+func (s *Service) withEntries(ctx context.Context, snapshot *db.RankingSnapshot) (*SnapshotWithEntries, error) {
+	rows, err := s.repo.ListEntries(ctx, snapshot.ID)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]Entry, len(rows))
+	for i, row := range rows {
+		entries[i] = Entry{
+			Rank:          int(row.Rank),
+			SwimmerName:   row.SwimmerName.String,
+			Club:          row.Club.String,
+			TimeMS:        int(row.TimeMs),
+			TimeFormatted: domain.FormatTime(int(row.TimeMs)),
+		}
+	}
+
+	sn := toSnapshot(*snapshot)
+	sn.EntryCount = len(entries)
+	return &SnapshotWithEntries{Snapshot: sn, Entries: entries}, nil
+}
+
+// Conversion helpers
+
+func toSnapshot(row db.RankingSnapshot) Snapshot {
+	s := Snapshot{
+		ID:         row.ID,
+		Source:     row.Source,
+		Season:     row.Season,
+		Event:      row.Event,
+		CourseType: row.CourseType,
+		Gender:     row.Gender,
+		AgeGroup:   row.AgeGroup,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+	if row.CapturedOn.Valid {
+		s.CapturedOn = row.CapturedOn.Time.Format("2006-01-02")
+	}
+	return s
+}
@@ -0,0 +1,14 @@
+package domain
+
+const (
+	// MaxReactionTimeMS is the slowest reaction time accepted on a time record.
+	MaxReactionTimeMS = 3000
+	// FalseStartThresholdMS is the fastest plausible reaction to the start signal.
+	// Faster readings usually mean the swimmer anticipated the start.
+	FalseStartThresholdMS = 100
+)
+
+// IsFalseStartRange reports whether a reaction time is faster than a plausible start.
+func IsFalseStartRange(reactionMS int) bool {
+	return reactionMS < FalseStartThresholdMS
+}
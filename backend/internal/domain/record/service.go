@@ -0,0 +1,619 @@
+// Package record provides record table domain logic. A record table holds
+// club, provincial or national records by event, course, gender and age group.
+package record
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	timeservice "github.com/bpg/swimstats/backend/internal/domain/time"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// Scope is the level a record table applies to.
+type Scope string
+
+const (
+	ScopeClub       Scope = "club"
+	ScopeProvincial Scope = "provincial"
+	ScopeNational   Scope = "national"
+)
+
+// IsValid checks if the scope is valid.
+func (s Scope) IsValid() bool {
+	switch s {
+	case ScopeClub, ScopeProvincial, ScopeNational:
+		return true
+	default:
+		return false
+	}
+}
+
+// ApproachPercent is how close to a record, in percent of the record time, a
+// swim has to be to count as approaching it.
+const ApproachPercent = 2.0
+
+// Status is how the swimmer's best time compares with a record.
+type Status string
+
+const (
+	StatusBroken      Status = "broken"
+	StatusEqualled    Status = "equalled"
+	StatusApproaching Status = "approaching"
+	StatusBehind      Status = "behind"
+	StatusNoTime      Status = "no_time"
+)
+
+// Service provides record business logic.
+type Service struct {
+	repo        *postgres.RecordRepository
+	swimmerRepo *postgres.SwimmerRepository
+	timeRepo    *postgres.TimeRepository
+}
+
+// NewService creates a new record service.
+func NewService(repo *postgres.RecordRepository, swimmerRepo *postgres.SwimmerRepository, timeRepo *postgres.TimeRepository) *Service {
+	return &Service{
+		repo:        repo,
+		swimmerRepo: swimmerRepo,
+		timeRepo:    timeRepo,
+	}
+}
+
+// Table represents a record table.
+type Table struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Scope       string    `json:"scope"`
+	Description string    `json:"description,omitempty"`
+	RecordCount int       `json:"record_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableList represents a list of record tables.
+type TableList struct {
+	Tables []Table `json:"tables"`
+}
+
+// Record represents a record in a table.
+type Record struct {
+	ID            uuid.UUID `json:"id"`
+	Event         string    `json:"event"`
+	CourseType    string    `json:"course_type"`
+	Gender        string    `json:"gender"`
+	AgeGroup      string    `json:"age_group"`
+	TimeMS        int       `json:"time_ms"`
+	TimeFormatted string    `json:"time_formatted"`
+	Holder        string    `json:"holder,omitempty"`
+	Date          string    `json:"date,omitempty"`
+	Location      string    `json:"location,omitempty"`
+}
+
+// TableWithRecords represents a record table with its records.
+type TableWithRecords struct {
+	Table
+	Records []Record `json:"records"`
+}
+
+// Input represents one record to import. The time is given either as
+// TimeMS or as a formatted Time such as "1:05.32".
+type Input struct {
+	Event      string `json:"event"`
+	CourseType string `json:"course_type"`
+	Gender     string `json:"gender"`
+	AgeGroup   string `json:"age_group"`
+	Time       string `json:"time,omitempty"`
+	TimeMS     int    `json:"time_ms,omitempty"`
+	Holder     string `json:"holder,omitempty"`
+	Date       string `json:"date,omitempty"`
+	Location   string `json:"location,omitempty"`
+}
+
+// Sanitize trims whitespace from string fields.
+func (i *Input) Sanitize() {
+	i.Event = strings.ToUpper(strings.TrimSpace(i.Event))
+	i.CourseType = strings.TrimSpace(i.CourseType)
+	i.Gender = strings.ToLower(strings.TrimSpace(i.Gender))
+	i.AgeGroup = strings.ToUpper(strings.TrimSpace(i.AgeGroup))
+	i.Time = strings.TrimSpace(i.Time)
+	i.Holder = domain.SanitizeString(i.Holder)
+	i.Date = strings.TrimSpace(i.Date)
+	i.Location = domain.SanitizeString(i.Location)
+}
+
+// Validate validates the record and resolves Time into TimeMS. Call
+// Sanitize() first.
+func (i *Input) Validate() error {
+	if !domain.EventCode(i.Event).IsValid() {
+		return fmt.Errorf("invalid event: %s", i.Event)
+	}
+	if !domain.CourseType(i.CourseType).IsValid() {
+		return fmt.Errorf("invalid course type: %s", i.CourseType)
+	}
+	if !domain.Gender(i.Gender).IsValidForStandard() {
+		return errors.New("gender must be 'female', 'male', 'x' or 'mixed'")
+	}
+	if ag := domain.AgeGroup(i.AgeGroup); !(domain.YouthScheme{}).IsValid(ag) && !(domain.MastersScheme{}).IsValid(ag) {
+		return fmt.Errorf("invalid age group: %s", i.AgeGroup)
+	}
+	if i.Time != "" {
+		ms, err := domain.ParseTime(i.Time)
+		if err != nil {
+			return fmt.Errorf("invalid time '%s': %w", i.Time, err)
+		}
+		if i.TimeMS != 0 && i.TimeMS != ms {
+			return errors.New("time and time_ms disagree")
+		}
+		i.TimeMS = ms
+	}
+	if i.TimeMS <= 0 {
+		return errors.New("time is required")
+	}
+	if i.Date != "" {
+		if _, err := time.Parse("2006-01-02", i.Date); err != nil {
+			return errors.New("date must be a valid date in YYYY-MM-DD format")
+		}
+	}
+	return nil
+}
+
+// ImportInput represents a record table to import. Importing a table with the
+// name of an existing one replaces its records.
+type ImportInput struct {
+	Name        string  `json:"name"`
+	Scope       string  `json:"scope"`
+	Description string  `json:"description,omitempty"`
+	Records     []Input `json:"records"`
+}
+
+// Sanitize trims whitespace from string fields.
+func (i *ImportInput) Sanitize() {
+	i.Name = domain.SanitizeString(i.Name)
+	i.Scope = strings.ToLower(strings.TrimSpace(i.Scope))
+	i.Description = domain.SanitizeString(i.Description)
+	for idx := range i.Records {
+		i.Records[idx].Sanitize()
+	}
+}
+
+// Validate validates the table fields of the import. Records are validated
+// one by one during the import. Call Sanitize() first.
+func (i ImportInput) Validate() error {
+	if i.Name == "" {
+		return errors.New("name is required")
+	}
+	if len(i.Name) > 255 {
+		return errors.New("name must be 255 characters or less")
+	}
+	if !Scope(i.Scope).IsValid() {
+		return errors.New("scope must be 'club', 'provincial' or 'national'")
+	}
+	if len(i.Records) == 0 {
+		return errors.New("no records defined")
+	}
+	return nil
+}
+
+// ImportResult contains the results of a record table import. Rows that fail
+// validation are skipped and reported in Errors.
+type ImportResult struct {
+	Table    TableWithRecords `json:"table"`
+	Replaced bool             `json:"replaced"`
+	Imported int              `json:"imported"`
+	Skipped  int              `json:"skipped"`
+	Errors   []string         `json:"errors,omitempty"`
+}
+
+// csvColumns are the columns of a record CSV file. The first five are required.
+var csvColumns = []string{"event", "course_type", "gender", "age_group", "time", "holder", "date", "location"}
+
+// ParseCSV reads records from a CSV file with a header row naming the
+// columns event, course_type, gender, age_group and time, and optionally
+// holder, date and location, in any order.
+func ParseCSV(r io.Reader) ([]Input, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("validation: empty CSV file")
+		}
+		return nil, fmt.Errorf("validation: invalid CSV: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range csvColumns[:5] {
+		if _, ok := index[name]; !ok {
+			return nil, fmt.Errorf("validation: CSV is missing the %s column", name)
+		}
+	}
+
+	var inputs []Input
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("validation: invalid CSV: %w", err)
+		}
+		field := func(name string) string {
+			i, ok := index[name]
+			if !ok || i >= len(row) {
+				return ""
+			}
+			return row[i]
+		}
+		inputs = append(inputs, Input{
+			Event:      field("event"),
+			CourseType: field("course_type"),
+			Gender:     field("gender"),
+			AgeGroup:   field("age_group"),
+			Time:       field("time"),
+			Holder:     field("holder"),
+			Date:       field("date"),
+			Location:   field("location"),
+		})
+	}
+	return inputs, nil
+}
+
+// List retrieves all record tables.
+func (s *Service) List(ctx context.Context) (*TableList, error) {
+	rows, err := s.repo.ListTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]Table, len(rows))
+	for i, row := range rows {
+		tables[i] = toTable(db.RecordTable{
+			ID:          row.ID,
+			Name:        row.Name,
+			Scope:       row.Scope,
+			Description: row.Description,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+		})
+		tables[i].RecordCount = int(row.RecordCount)
+	}
+	return &TableList{Tables: tables}, nil
+}
+
+// Get retrieves a record table with its records.
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*TableWithRecords, error) {
+	table, err := s.repo.GetTable(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.withRecords(ctx, table)
+}
+
+// Delete deletes a record table and its records.
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.repo.GetTable(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.DeleteTable(ctx, id)
+}
+
+// Import creates a record table with its records, or replaces the records of
+// the table with the same name.
+func (s *Service) Import(ctx context.Context, input ImportInput) (*ImportResult, error) {
+	input.Sanitize()
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("validation: %w", err)
+	}
+
+	result := &ImportResult{}
+	type recordKey struct{ event, courseType, gender, ageGroup string }
+	seen := make(map[recordKey]bool, len(input.Records))
+	var records []db.CreateRecordParams
+	for idx := range input.Records {
+		in := &input.Records[idx]
+		if err := in.Validate(); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("records[%d]: %v", idx, err))
+			result.Skipped++
+			continue
+		}
+		key := recordKey{in.Event, in.CourseType, in.Gender, in.AgeGroup}
+		if seen[key] {
+			result.Errors = append(result.Errors, fmt.Sprintf("records[%d]: duplicate record for %s %s %s %s", idx, in.Event, in.CourseType, in.Gender, in.AgeGroup))
+			result.Skipped++
+			continue
+		}
+		seen[key] = true
+
+		records = append(records, db.CreateRecordParams{
+			Event:      in.Event,
+			CourseType: in.CourseType,
+			Gender:     in.Gender,
+			AgeGroup:   in.AgeGroup,
+			TimeMs:     int32(in.TimeMS),
+			Holder:     postgres.TextOrNull(in.Holder),
+			RecordDate: postgres.DateOrNull(in.Date),
+			Location:   postgres.TextOrNull(in.Location),
+		})
+	}
+	if len(records) == 0 {
+		return nil, errors.New("validation: no valid records")
+	}
+
+	table, replaced, err := s.repo.ReplaceTable(ctx, db.CreateRecordTableParams{
+		Name:        input.Name,
+		Scope:       input.Scope,
+		Description: postgres.TextOrNull(input.Description),
+	}, records)
+	if err != nil {
+		return nil, err
+	}
+	result.Replaced = replaced
+	result.Imported = len(records)
+
+	withRecords, err := s.withRecords(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	result.Table = *withRecords
+	return result, nil
+}
+
+// RecordComparison compares a record with the swimmer's best time in the
+// record's age group, or overall for open records.
+type RecordComparison struct {
+	Record
+	TableID           uuid.UUID `json:"table_id"`
+	TableName         string    `json:"table_name"`
+	Scope             string    `json:"scope"`
+	BestTimeMS        *int      `json:"best_time_ms"`
+	BestTimeFormatted *string   `json:"best_time_formatted"`
+	BestDate          *string   `json:"best_date"`
+	DifferenceMS      *int      `json:"difference_ms"`
+	Status            Status    `json:"status"`
+}
+
+// ComparisonSummary counts the compared records by status.
+type ComparisonSummary struct {
+	Broken      int `json:"broken"`
+	Equalled    int `json:"equalled"`
+	Approaching int `json:"approaching"`
+}
+
+// Comparison is the swimmer's times compared with the records that apply.
+type Comparison struct {
+	SwimmerID       string             `json:"swimmer_id"`
+	CurrentAgeGroup string             `json:"current_age_group"`
+	Records         []RecordComparison `json:"records"`
+	Summary         ComparisonSummary  `json:"summary"`
+}
+
+// Compare compares the swimmer's best times with the records of the
+// swimmer's gender and mixed records, optionally for a single course type or
+// table. A swim counts toward the age group that applied on the event date.
+func (s *Service) Compare(ctx context.Context, swimmerID uuid.UUID, courseType *string, tableID *uuid.UUID) (*Comparison, error) {
+	if courseType != nil && !domain.CourseType(*courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", *courseType)
+	}
+	if tableID != nil {
+		if _, err := s.repo.GetTable(ctx, *tableID); err != nil {
+			return nil, err
+		}
+	}
+
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+	records, err := s.repo.ListApplicable(ctx, postgres.ApplicableRecordsParams{
+		Gender:     swimmer.Gender,
+		CourseType: courseType,
+		TableID:    tableID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.timeRepo.ListTimeHistory(ctx, swimmerID, courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+
+	// Open records are compared with the best time at any age
+	scheme := domain.Category(swimmer.Category).AgeScheme()
+	bests := timeservice.AgeGroupBests(history, swimmer.BirthDate.Time, scheme)
+	openBests := make(map[timeservice.AgeGroupBestKey]db.ListTimeHistoryRow)
+	for key, row := range bests {
+		open := timeservice.AgeGroupBestKey{CourseType: key.CourseType, Event: key.Event, AgeGroup: domain.AgeGroupOpen}
+		if best, ok := openBests[open]; !ok || row.TimeMs < best.TimeMs {
+			openBests[open] = row
+		}
+	}
+	for key, row := range openBests {
+		bests[key] = row
+	}
+
+	comparison := &Comparison{
+		SwimmerID:       swimmerID.String(),
+		CurrentAgeGroup: string(scheme.AgeGroupAt(swimmer.BirthDate.Time, time.Now())),
+		Records:         make([]RecordComparison, 0, len(records)),
+	}
+	for _, row := range records {
+		rc := RecordComparison{
+			Record: toRecord(db.Record{
+				ID:         row.ID,
+				Event:      row.Event,
+				CourseType: row.CourseType,
+				Gender:     row.Gender,
+				AgeGroup:   row.AgeGroup,
+				TimeMs:     row.TimeMs,
+				Holder:     row.Holder,
+				RecordDate: row.RecordDate,
+				Location:   row.Location,
+			}),
+			TableID:   row.RecordTableID,
+			TableName: row.TableName,
+			Scope:     row.Scope,
+			Status:    StatusNoTime,
+		}
+		key := timeservice.AgeGroupBestKey{CourseType: row.CourseType, Event: row.Event, AgeGroup: domain.AgeGroup(row.AgeGroup)}
+		if best, ok := bests[key]; ok {
+			bestMS := int(best.TimeMs)
+			formatted := domain.FormatTime(bestMS)
+			date := best.Date.Time.Format("2006-01-02")
+			difference := bestMS - int(row.TimeMs)
+			rc.BestTimeMS = &bestMS
+			rc.BestTimeFormatted = &formatted
+			rc.BestDate = &date
+			rc.DifferenceMS = &difference
+			rc.Status = status(difference, int(row.TimeMs))
+		}
+		switch rc.Status {
+		case StatusBroken:
+			comparison.Summary.Broken++
+		case StatusEqualled:
+			comparison.Summary.Equalled++
+		case StatusApproaching:
+			comparison.Summary.Approaching++
+		}
+		comparison.Records = append(comparison.Records, rc)
+	}
+	return comparison, nil
+}
+
+// CheckRecords returns the records a swim broke, equalled or came within
+// ApproachPercent of. Records of the age group of the swimmer's category on
+// the day of the swim and open records are checked. It implements timeservice.RecordChecker.
+func (s *Service) CheckRecords(ctx context.Context, swimmerID uuid.UUID, courseType, event string, timeMS int, date time.Time) ([]timeservice.RecordMark, error) {
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+	records, err := s.repo.ListApplicable(ctx, postgres.ApplicableRecordsParams{
+		Gender:     swimmer.Gender,
+		CourseType: &courseType,
+		Event:      &event,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ageGroup := domain.Category(swimmer.Category).AgeScheme().AgeGroupAt(swimmer.BirthDate.Time, date)
+	var marks []timeservice.RecordMark
+	for _, row := range records {
+		if domain.AgeGroup(row.AgeGroup) != ageGroup && domain.AgeGroup(row.AgeGroup) != domain.AgeGroupOpen {
+			continue
+		}
+		difference := timeMS - int(row.TimeMs)
+		if status(difference, int(row.TimeMs)) == StatusBehind {
+			continue
+		}
+		marks = append(marks, timeservice.RecordMark{
+			RecordID:     row.ID,
+			TableName:    row.TableName,
+			Scope:        row.Scope,
+			AgeGroup:     row.AgeGroup,
+			RecordTimeMS: int(row.TimeMs),
+			DifferenceMS: difference,
+			Broken:       difference < 0,
+		})
+	}
+	return marks, nil
+}
+
+// status classifies a time by its difference from a record time.
+func status(differenceMS, recordMS int) Status {
+	switch {
+	case differenceMS < 0:
+		return StatusBroken
+	case differenceMS == 0:
+		return StatusEqualled
+	case float64(differenceMS) <= float64(recordMS)*ApproachPercent/100:
+		return StatusApproaching
+	default:
+		return StatusBehind
+	}
+}
+
+func (s *Service) withRecords(ctx context.Context, table *db.RecordTable) (*TableWithRecords, error) {
+	rows, err := s.repo.ListRecords(ctx, table.ID)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]Record, len(rows))
+	for i, row := range rows {
+		records[i] = toRecord(row)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].CourseType != records[j].CourseType {
+			return records[i].CourseType < records[j].CourseType
+		}
+		if records[i].Gender != records[j].Gender {
+			return records[i].Gender < records[j].Gender
+		}
+		if records[i].Event != records[j].Event {
+			return domain.EventSortOrder(records[i].Event) < domain.EventSortOrder(records[j].Event)
+		}
+		return ageGroupOrder(records[i].AgeGroup) < ageGroupOrder(records[j].AgeGroup)
+	})
+
+	t := toTable(*table)
+	t.RecordCount = len(records)
+	return &TableWithRecords{Table: t, Records: records}, nil
+}
+
+// ageGroupOrder orders youth age groups before masters bands, each from the
+// youngest.
+func ageGroupOrder(ageGroup string) int {
+	ag := domain.AgeGroup(ageGroup)
+	if (domain.YouthScheme{}).IsValid(ag) {
+		minAge, _ := domain.AgeGroupBounds(ag)
+		return minAge
+	}
+	var from int
+	_, _ = fmt.Sscanf(ageGroup, "%d-", &from)
+	return 100 + from
+}
+
+// Conversion helpers
+
+func toTable(row db.RecordTable) Table {
+	return Table{
+		ID:          row.ID,
+		Name:        row.Name,
+		Scope:       row.Scope,
+		Description: row.Description.String,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+func toRecord(row db.Record) Record {
+	r := Record{
+		ID:            row.ID,
+		Event:         row.Event,
+		CourseType:    row.CourseType,
+		Gender:        row.Gender,
+		AgeGroup:      row.AgeGroup,
+		TimeMS:        int(row.TimeMs),
+		TimeFormatted: domain.FormatTime(int(row.TimeMs)),
+		Holder:        row.Holder.String,
+		Location:      row.Location.String,
+	}
+	if row.RecordDate.Valid {
+		r.Date = row.RecordDate.Time.Format("2006-01-02")
+	}
+	return r
+}
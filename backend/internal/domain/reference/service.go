@@ -0,0 +1,460 @@
+// Package reference provides reference swimmer domain logic. A reference
+// swimmer is another swimmer's history, such as an older sibling's archive or
+// a role model's published results, used as a benchmark.
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/domain/importer"
+	timeservice "github.com/bpg/swimstats/backend/internal/domain/time"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// Leader is which swimmer is ahead in an event.
+type Leader string
+
+const (
+	LeaderSwimmer   Leader = "swimmer"
+	LeaderReference Leader = "reference"
+	LeaderTied      Leader = "tied"
+)
+
+// Service provides reference swimmer business logic.
+type Service struct {
+	repo        *postgres.ReferenceRepository
+	swimmerRepo *postgres.SwimmerRepository
+	timeRepo    *postgres.TimeRepository
+}
+
+// NewService creates a new reference swimmer service.
+func NewService(repo *postgres.ReferenceRepository, swimmerRepo *postgres.SwimmerRepository, timeRepo *postgres.TimeRepository) *Service {
+	return &Service{
+		repo:        repo,
+		swimmerRepo: swimmerRepo,
+		timeRepo:    timeRepo,
+	}
+}
+
+// Swimmer represents a reference swimmer.
+type Swimmer struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	BirthDate   string    `json:"birth_date"`
+	Gender      string    `json:"gender"`
+	Description string    `json:"description,omitempty"`
+	TimeCount   int       `json:"time_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// SwimmerList represents a list of reference swimmers.
+type SwimmerList struct {
+	Swimmers []Swimmer `json:"swimmers"`
+}
+
+// Time represents a reference swimmer's time with their age at competition.
+type Time struct {
+	ID            uuid.UUID `json:"id"`
+	Event         string    `json:"event"`
+	CourseType    string    `json:"course_type"`
+	TimeMS        int       `json:"time_ms"`
+	TimeFormatted string    `json:"time_formatted"`
+	Date          string    `json:"date"`
+	MeetName      string    `json:"meet_name,omitempty"`
+	Age           int       `json:"age"`
+}
+
+// SwimmerWithTimes represents a reference swimmer with their times in
+// chronological order.
+type SwimmerWithTimes struct {
+	Swimmer
+	Times []Time `json:"times"`
+}
+
+// ImportResult contains the results of a reference swimmer import. Times that
+// fail validation are skipped and reported in Errors.
+type ImportResult struct {
+	Swimmer  Swimmer  `json:"swimmer"`
+	Replaced bool     `json:"replaced"`
+	Imported int      `json:"imported"`
+	Skipped  int      `json:"skipped"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// List retrieves all reference swimmers.
+func (s *Service) List(ctx context.Context) (*SwimmerList, error) {
+	rows, err := s.repo.ListSwimmers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	swimmers := make([]Swimmer, len(rows))
+	for i, row := range rows {
+		swimmers[i] = toSwimmer(db.ReferenceSwimmer{
+			ID:          row.ID,
+			Name:        row.Name,
+			BirthDate:   row.BirthDate,
+			Gender:      row.Gender,
+			Description: row.Description,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+		})
+		swimmers[i].TimeCount = int(row.TimeCount)
+	}
+	return &SwimmerList{Swimmers: swimmers}, nil
+}
+
+// Get retrieves a reference swimmer with their times.
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*SwimmerWithTimes, error) {
+	ref, err := s.repo.GetSwimmer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.repo.ListTimes(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	times := make([]Time, len(rows))
+	for i, row := range rows {
+		times[i] = Time{
+			ID:            row.ID,
+			Event:         row.Event,
+			CourseType:    row.CourseType,
+			TimeMS:        int(row.TimeMs),
+			TimeFormatted: domain.FormatTime(int(row.TimeMs)),
+			Date:          row.EventDate.Time.Format("2006-01-02"),
+			MeetName:      row.MeetName.String,
+			Age:           domain.AgeAtCompetition(ref.BirthDate.Time, row.EventDate.Time),
+		}
+	}
+
+	sw := toSwimmer(*ref)
+	sw.TimeCount = len(times)
+	return &SwimmerWithTimes{Swimmer: sw, Times: times}, nil
+}
+
+// Delete deletes a reference swimmer and their times.
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.repo.GetSwimmer(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.DeleteSwimmer(ctx, id)
+}
+
+// Import loads a reference swimmer from a JSON export file: the swimmer
+// profile and the times of every meet. Other sections of the file are
+// ignored. The name defaults to the swimmer's name in the file; importing a
+// reference swimmer with an existing name replaces their times. Files
+// without a valid time are rejected.
+func (s *Service) Import(ctx context.Context, data *importer.ImportData, name, description string) (*ImportResult, error) {
+	if data.Swimmer == nil {
+		return nil, errors.New("validation: swimmer is required")
+	}
+	if name = domain.SanitizeString(name); name == "" {
+		name = domain.SanitizeString(data.Swimmer.Name)
+	}
+	description = domain.SanitizeString(description)
+	if name == "" {
+		return nil, errors.New("validation: name is required")
+	}
+	if len(name) > 255 {
+		return nil, errors.New("validation: name must be 255 characters or less")
+	}
+	birthDate, err := time.Parse("2006-01-02", strings.TrimSpace(data.Swimmer.BirthDate))
+	if err != nil {
+		return nil, errors.New("validation: birth_date must be a valid date in YYYY-MM-DD format")
+	}
+	gender := strings.ToLower(strings.TrimSpace(data.Swimmer.Gender))
+	if !domain.Gender(gender).IsValid() {
+		return nil, errors.New("validation: gender must be 'female', 'male' or 'x'")
+	}
+
+	result := &ImportResult{}
+	var times []db.CreateReferenceTimeParams
+	for mi, m := range data.Meets {
+		courseType := strings.TrimSpace(m.CourseType)
+		if !domain.CourseType(courseType).IsValid() {
+			result.Errors = append(result.Errors, fmt.Sprintf("meets[%d]: invalid course type: %s", mi, m.CourseType))
+			result.Skipped += len(m.Times)
+			continue
+		}
+		for ti, t := range m.Times {
+			params, err := parseTime(t, strings.TrimSpace(m.StartDate))
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("meets[%d].times[%d]: %v", mi, ti, err))
+				result.Skipped++
+				continue
+			}
+			params.CourseType = courseType
+			params.MeetName = postgres.TextOrNull(domain.SanitizeString(m.Name))
+			times = append(times, params)
+		}
+	}
+	if len(times) == 0 {
+		return nil, errors.New("validation: no valid times")
+	}
+
+	ref, replaced, err := s.repo.ReplaceSwimmer(ctx, db.CreateReferenceSwimmerParams{
+		Name:        name,
+		BirthDate:   pgtype.Date{Time: birthDate, Valid: true},
+		Gender:      gender,
+		Description: postgres.TextOrNull(description),
+	}, times)
+	if err != nil {
+		return nil, err
+	}
+	result.Replaced = replaced
+	result.Imported = len(times)
+
+	result.Swimmer = toSwimmer(*ref)
+	result.Swimmer.TimeCount = result.Imported
+	return result, nil
+}
+
+// parseTime validates a time from an export file. The event date defaults to
+// the meet's start date.
+func parseTime(t importer.TimeData, meetStart string) (db.CreateReferenceTimeParams, error) {
+	event := strings.ToUpper(strings.TrimSpace(t.Event))
+	if !domain.EventCode(event).IsValid() {
+		return db.CreateReferenceTimeParams{}, fmt.Errorf("invalid event: %s", t.Event)
+	}
+	ms, err := domain.ParseTime(strings.TrimSpace(t.Time))
+	if err != nil {
+		return db.CreateReferenceTimeParams{}, fmt.Errorf("invalid time '%s': %w", t.Time, err)
+	}
+	dateStr := strings.TrimSpace(t.EventDate)
+	if dateStr == "" {
+		dateStr = meetStart
+	}
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return db.CreateReferenceTimeParams{}, errors.New("event_date must be a valid date in YYYY-MM-DD format")
+	}
+	return db.CreateReferenceTimeParams{
+		Event:     event,
+		TimeMs:    int32(ms),
+		EventDate: pgtype.Date{Time: date, Valid: true},
+	}, nil
+}
+
+// Swim is one swimmer's time in a head-to-head comparison.
+type Swim struct {
+	TimeMS        int    `json:"time_ms"`
+	TimeFormatted string `json:"time_formatted"`
+	Date          string `json:"date"`
+	MeetName      string `json:"meet_name,omitempty"`
+	Age           int    `json:"age"`
+}
+
+// EventHeadToHead compares the two swimmers in one event. DifferenceMS is
+// the swimmer's time minus the reference's, negative when the swimmer is
+// faster; it and Leader are only set when both have a time.
+type EventHeadToHead struct {
+	Event               string  `json:"event"`
+	Swimmer             *Swim   `json:"swimmer"`
+	Reference           *Swim   `json:"reference"`
+	DifferenceMS        *int    `json:"difference_ms"`
+	DifferenceFormatted *string `json:"difference_formatted"`
+	Leader              Leader  `json:"leader,omitempty"`
+}
+
+// Summary counts the events each swimmer leads.
+type Summary struct {
+	SwimmerAhead   int `json:"swimmer_ahead"`
+	ReferenceAhead int `json:"reference_ahead"`
+	Tied           int `json:"tied"`
+}
+
+// Section is a set of event comparisons. Age is set when both swimmers'
+// times are their bests at that age at competition.
+type Section struct {
+	Age     *int              `json:"age,omitempty"`
+	Events  []EventHeadToHead `json:"events"`
+	Summary Summary           `json:"summary"`
+}
+
+// HeadToHead compares the swimmer with a reference swimmer in one course:
+// personal best against personal best, and best against best at equal age.
+type HeadToHead struct {
+	ReferenceID   uuid.UUID `json:"reference_id"`
+	ReferenceName string    `json:"reference_name"`
+	CourseType    string    `json:"course_type"`
+	SwimmerAge    int       `json:"swimmer_age"`
+	ReferenceAge  int       `json:"reference_age"`
+	PersonalBests Section   `json:"personal_bests"`
+	EqualAge      Section   `json:"equal_age"`
+}
+
+// Compare compares the swimmer with a reference swimmer event by event in a
+// course type. Ages are ages at competition (as of December 31). The equal
+// age comparison defaults to the swimmer's current age, e.g. what the
+// reference swam at 12 against the swimmer's times at 12 now.
+func (s *Service) Compare(ctx context.Context, swimmerID, referenceID uuid.UUID, courseType string, age *int) (*HeadToHead, error) {
+	if !domain.CourseType(courseType).IsValid() {
+		return nil, fmt.Errorf("validation: invalid course type: %s", courseType)
+	}
+	if age != nil && (*age < 1 || *age > 120) {
+		return nil, errors.New("validation: age must be between 1 and 120")
+	}
+
+	ref, err := s.repo.GetSwimmer(ctx, referenceID)
+	if err != nil {
+		return nil, err
+	}
+	swimmer, err := s.swimmerRepo.Get(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+	birthDate := swimmer.BirthDate.Time
+	refBirthDate := ref.BirthDate.Time
+
+	now := time.Now()
+	result := &HeadToHead{
+		ReferenceID:   ref.ID,
+		ReferenceName: ref.Name,
+		CourseType:    courseType,
+		SwimmerAge:    domain.AgeAtCompetition(birthDate, now),
+		ReferenceAge:  domain.AgeAtCompetition(refBirthDate, now),
+	}
+
+	// Personal best against personal best
+	pbs, err := s.timeRepo.GetPersonalBests(ctx, swimmerID, courseType)
+	if err != nil {
+		return nil, fmt.Errorf("get personal bests: %w", err)
+	}
+	refPBs, err := s.repo.GetPersonalBests(ctx, ref.ID, courseType)
+	if err != nil {
+		return nil, err
+	}
+	swims := make(map[string]*Swim, len(pbs))
+	for _, pb := range pbs {
+		date := pb.MeetDate.Time
+		if pb.EventDate.Valid {
+			date = pb.EventDate.Time
+		}
+		swims[pb.Event] = newSwim(int(pb.TimeMs), date, pb.MeetName, birthDate)
+	}
+	refSwims := make(map[string]*Swim, len(refPBs))
+	for _, pb := range refPBs {
+		refSwims[pb.Event] = newSwim(int(pb.TimeMs), pb.EventDate.Time, pb.MeetName.String, refBirthDate)
+	}
+	result.PersonalBests = compareSwims(swims, refSwims)
+
+	// Best against best at the same age
+	equalAge := result.SwimmerAge
+	if age != nil {
+		equalAge = *age
+	}
+	history, err := s.timeRepo.ListTimeHistory(ctx, swimmerID, &courseType)
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+	refTimes, err := s.repo.ListTimes(ctx, ref.ID, &courseType)
+	if err != nil {
+		return nil, err
+	}
+	refHistory := make([]db.ListTimeHistoryRow, len(refTimes))
+	for i, t := range refTimes {
+		refHistory[i] = db.ListTimeHistoryRow{
+			ID:         t.ID,
+			Event:      t.Event,
+			TimeMs:     t.TimeMs,
+			Date:       t.EventDate,
+			MeetName:   t.MeetName.String,
+			CourseType: t.CourseType,
+		}
+	}
+	result.EqualAge = compareSwims(
+		swimsAtAge(history, birthDate, equalAge),
+		swimsAtAge(refHistory, refBirthDate, equalAge),
+	)
+	result.EqualAge.Age = &equalAge
+	return result, nil
+}
+
+// swimsAtAge returns the fastest swim per event at an age at competition.
+func swimsAtAge(history []db.ListTimeHistoryRow, birthDate time.Time, age int) map[string]*Swim {
+	swims := make(map[string]*Swim)
+	for key, row := range timeservice.AgeBests(history, birthDate) {
+		if key.Age == age {
+			swims[key.Event] = newSwim(int(row.TimeMs), row.Date.Time, row.MeetName, birthDate)
+		}
+	}
+	return swims
+}
+
+// compareSwims compares the swims of both swimmers in every event either has swum.
+func compareSwims(swims, refSwims map[string]*Swim) Section {
+	events := make(map[string]bool, len(swims)+len(refSwims))
+	for event := range swims {
+		events[event] = true
+	}
+	for event := range refSwims {
+		events[event] = true
+	}
+
+	section := Section{Events: make([]EventHeadToHead, 0, len(events))}
+	for event := range events {
+		h := EventHeadToHead{
+			Event:     event,
+			Swimmer:   swims[event],
+			Reference: refSwims[event],
+		}
+		if h.Swimmer != nil && h.Reference != nil {
+			difference := h.Swimmer.TimeMS - h.Reference.TimeMS
+			formatted := domain.FormatDifference(difference)
+			h.DifferenceMS = &difference
+			h.DifferenceFormatted = &formatted
+			switch {
+			case difference < 0:
+				h.Leader = LeaderSwimmer
+				section.Summary.SwimmerAhead++
+			case difference > 0:
+				h.Leader = LeaderReference
+				section.Summary.ReferenceAhead++
+			default:
+				h.Leader = LeaderTied
+				section.Summary.Tied++
+			}
+		}
+		section.Events = append(section.Events, h)
+	}
+	sort.Slice(section.Events, func(i, j int) bool {
+		return domain.EventSortOrder(section.Events[i].Event) < domain.EventSortOrder(section.Events[j].Event)
+	})
+	return section
+}
+
+func newSwim(timeMS int, date time.Time, meetName string, birthDate time.Time) *Swim {
+	return &Swim{
+		TimeMS:        timeMS,
+		TimeFormatted: domain.FormatTime(timeMS),
+		Date:          date.Format("2006-01-02"),
+		MeetName:      meetName,
+		Age:           domain.AgeAtCompetition(birthDate, date),
+	}
+}
+
+// Conversion helpers
+
+func toSwimmer(row db.ReferenceSwimmer) Swimmer {
+	return Swimmer{
+		ID:          row.ID,
+		Name:        row.Name,
+		BirthDate:   row.BirthDate.Time.Format("2006-01-02"),
+		Gender:      row.Gender,
+		Description: row.Description.String,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// SeasonForDate returns the swim season containing the date, e.g. "2025-26".
+// Seasons run from September 1 through August 31.
+func SeasonForDate(date time.Time) string {
+	start := date.Year()
+	if date.Month() < time.September {
+		start--
+	}
+	return fmt.Sprintf("%d-%02d", start, (start+1)%100)
+}
+
+// SeasonDates returns the first and last day of a season written as in
+// SeasonForDate, e.g. "2025-26".
+func SeasonDates(season string) (start, end time.Time, err error) {
+	var first, second int
+	if _, err := fmt.Sscanf(season, "%4d-%2d", &first, &second); err != nil || len(season) != 7 || (first+1)%100 != second {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid season %q, expected e.g. \"2025-26\"", season)
+	}
+	start = time.Date(first, time.September, 1, 0, 0, 0, 0, time.UTC)
+	end = time.Date(first+1, time.August, 31, 0, 0, 0, 0, time.UTC)
+	return start, end, nil
+}
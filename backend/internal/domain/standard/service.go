@@ -33,12 +33,18 @@ type Standard struct {
 	CourseType  string    `json:"course_type"`
 	Gender      string    `json:"gender"`
 	IsPreloaded bool      `json:"is_preloaded"`
+	// ManualTimePolicy is how manual and semi-automatic times are treated: accept, adjust or ineligible.
+	ManualTimePolicy string `json:"manual_time_policy"`
+	// Category is "age_group" or "masters" and selects the age groups used by the times.
+	Category string `json:"category"`
 }
 
 // StandardTime represents a qualifying time within a standard.
 type StandardTime struct {
-	Event         string `json:"event"`
-	AgeGroup      string `json:"age_group"`
+	Event    string `json:"event"`
+	AgeGroup string `json:"age_group"`
+	// SportClass limits the time to para-swimmers of that class; empty applies to everyone.
+	SportClass    string `json:"sport_class,omitempty"`
 	TimeMs        int    `json:"time_ms"`
 	TimeFormatted string `json:"time_formatted"`
 }
@@ -56,10 +62,12 @@ type StandardList struct {
 
 // Input represents input for creating/updating a standard.
 type Input struct {
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	CourseType  string `json:"course_type"`
-	Gender      string `json:"gender"`
+	Name             string `json:"name"`
+	Description      string `json:"description,omitempty"`
+	CourseType       string `json:"course_type"`
+	Gender           string `json:"gender"`
+	ManualTimePolicy string `json:"manual_time_policy,omitempty"` // Defaults to "accept"
+	Category         string `json:"category,omitempty"`           // Defaults to "age_group"
 }
 
 // Sanitize trims whitespace from string fields.
@@ -68,6 +76,14 @@ func (i *Input) Sanitize() {
 	i.Description = strings.TrimSpace(i.Description)
 	i.CourseType = strings.TrimSpace(i.CourseType)
 	i.Gender = strings.TrimSpace(i.Gender)
+	i.ManualTimePolicy = strings.TrimSpace(i.ManualTimePolicy)
+	if i.ManualTimePolicy == "" {
+		i.ManualTimePolicy = string(domain.ManualTimeAccept)
+	}
+	i.Category = strings.TrimSpace(i.Category)
+	if i.Category == "" {
+		i.Category = string(domain.CategoryAgeGroup)
+	}
 }
 
 // Validate validates the standard input. Call Sanitize() first.
@@ -78,20 +94,27 @@ func (i Input) Validate() error {
 	if len(i.Name) > 255 {
 		return errors.New("name must be at most 255 characters")
 	}
-	if i.CourseType != "25m" && i.CourseType != "50m" {
-		return errors.New("course_type must be '25m' or '50m'")
+	if !domain.CourseType(i.CourseType).IsValid() {
+		return errors.New("course_type must be '25m', '50m' or 'open_water'")
+	}
+	if !domain.Gender(i.Gender).IsValidForStandard() {
+		return errors.New("gender must be 'female', 'male', 'x' or 'mixed'")
+	}
+	if !domain.ManualTimePolicy(i.ManualTimePolicy).IsValid() {
+		return errors.New("manual_time_policy must be 'accept', 'adjust' or 'ineligible'")
 	}
-	if i.Gender != "female" && i.Gender != "male" {
-		return errors.New("gender must be 'female' or 'male'")
+	if !domain.Category(i.Category).IsValid() {
+		return errors.New("category must be 'age_group' or 'masters'")
 	}
 	return nil
 }
 
 // StandardTimeInput represents input for a qualifying time.
 type StandardTimeInput struct {
-	Event    string `json:"event"`
-	AgeGroup string `json:"age_group"`
-	TimeMs   int    `json:"time_ms"`
+	Event      string `json:"event"`
+	AgeGroup   string `json:"age_group"`
+	SportClass string `json:"sport_class,omitempty"` // e.g. "S9"; empty applies to every swimmer
+	TimeMs     int    `json:"time_ms"`
 }
 
 // Validate validates the standard time input.
@@ -102,19 +125,42 @@ func (i StandardTimeInput) Validate() error {
 	if !domain.AgeGroup(i.AgeGroup).IsValid() {
 		return fmt.Errorf("invalid age group: %s", i.AgeGroup)
 	}
+	if i.SportClass != "" {
+		class := domain.SportClass(i.SportClass)
+		if !class.IsValid() {
+			return fmt.Errorf("invalid sport class: %s", i.SportClass)
+		}
+		event, _ := domain.LookupEvent(domain.EventCode(i.Event))
+		if class.Family() != domain.SportClassFamilyForStroke(event.Stroke) {
+			return fmt.Errorf("sport class %s does not apply to %s", i.SportClass, i.Event)
+		}
+	}
 	if i.TimeMs <= 0 {
 		return errors.New("time_ms must be greater than 0")
 	}
 	return nil
 }
 
+// validateAgeGroups checks that every time uses an age group of the category's scheme.
+func validateAgeGroups(category string, times []StandardTimeInput) error {
+	scheme := domain.Category(category).AgeScheme()
+	for idx, t := range times {
+		if !scheme.IsValid(domain.AgeGroup(t.AgeGroup)) {
+			return fmt.Errorf("times[%d]: age group %s is not used by %s standards", idx, t.AgeGroup, category)
+		}
+	}
+	return nil
+}
+
 // ImportInput represents input for importing a complete standard with times.
 type ImportInput struct {
-	Name        string              `json:"name"`
-	Description string              `json:"description,omitempty"`
-	CourseType  string              `json:"course_type"`
-	Gender      string              `json:"gender"`
-	Times       []StandardTimeInput `json:"times"`
+	Name             string              `json:"name"`
+	Description      string              `json:"description,omitempty"`
+	CourseType       string              `json:"course_type"`
+	Gender           string              `json:"gender"`
+	ManualTimePolicy string              `json:"manual_time_policy,omitempty"`
+	Category         string              `json:"category,omitempty"`
+	Times            []StandardTimeInput `json:"times"`
 }
 
 // Sanitize trims whitespace from string fields.
@@ -123,19 +169,30 @@ func (i *ImportInput) Sanitize() {
 	i.Description = strings.TrimSpace(i.Description)
 	i.CourseType = strings.TrimSpace(i.CourseType)
 	i.Gender = strings.TrimSpace(i.Gender)
+	i.ManualTimePolicy = strings.TrimSpace(i.ManualTimePolicy)
+	if i.ManualTimePolicy == "" {
+		i.ManualTimePolicy = string(domain.ManualTimeAccept)
+	}
+	i.Category = strings.TrimSpace(i.Category)
+	if i.Category == "" {
+		i.Category = string(domain.CategoryAgeGroup)
+	}
 	for idx := range i.Times {
 		i.Times[idx].Event = strings.TrimSpace(i.Times[idx].Event)
 		i.Times[idx].AgeGroup = strings.TrimSpace(i.Times[idx].AgeGroup)
+		i.Times[idx].SportClass = strings.ToUpper(strings.TrimSpace(i.Times[idx].SportClass))
 	}
 }
 
 // Validate validates the import input. Call Sanitize() first.
 func (i ImportInput) Validate() error {
 	input := Input{
-		Name:        i.Name,
-		Description: i.Description,
-		CourseType:  i.CourseType,
-		Gender:      i.Gender,
+		Name:             i.Name,
+		Description:      i.Description,
+		CourseType:       i.CourseType,
+		Gender:           i.Gender,
+		ManualTimePolicy: i.ManualTimePolicy,
+		Category:         i.Category,
 	}
 	if err := input.Validate(); err != nil {
 		return err
@@ -145,24 +202,32 @@ func (i ImportInput) Validate() error {
 			return fmt.Errorf("times[%d]: %w", idx, err)
 		}
 	}
-	return nil
+	return validateAgeGroups(i.Category, i.Times)
 }
 
 // ListParams contains parameters for listing standards.
 type ListParams struct {
 	CourseType *string
 	Gender     *string
+	Category   *string
+	// SwimmerGender limits the list to standards that apply to a swimmer of that gender:
+	// the same gender plus mixed standards.
+	SwimmerGender *string
 }
 
 // JSONFileInput represents the JSON file format for bulk importing standards.
 type JSONFileInput struct {
-	Season     string                         `json:"season"`
-	Source     string                         `json:"source"`
-	CourseType string                         `json:"course_type"`
-	Gender     string                         `json:"gender"`
-	Standards  map[string]JSONStandardMeta    `json:"standards"`
-	AgeGroups  []string                       `json:"age_groups"`
-	Times      map[string]map[string]JSONTime `json:"times"` // event -> age_group -> times
+	Season     string `json:"season"`
+	Source     string `json:"source"`
+	CourseType string `json:"course_type"`
+	Gender     string `json:"gender"`
+	// ManualTimePolicy applies to every standard in the file (default "accept").
+	ManualTimePolicy string `json:"manual_time_policy,omitempty"`
+	// Category applies to every standard in the file (default "age_group").
+	Category  string                         `json:"category,omitempty"`
+	Standards map[string]JSONStandardMeta    `json:"standards"`
+	AgeGroups []string                       `json:"age_groups"`
+	Times     map[string]map[string]JSONTime `json:"times"` // event -> age_group -> times
 }
 
 // JSONStandardMeta contains metadata for a standard in the JSON file.
@@ -209,8 +274,10 @@ func (s *Service) GetWithTimes(ctx context.Context, id uuid.UUID) (*StandardWith
 // List retrieves all standards matching the filter.
 func (s *Service) List(ctx context.Context, params ListParams) (*StandardList, error) {
 	dbStandards, err := s.repo.List(ctx, postgres.ListStandardsParams{
-		CourseType: params.CourseType,
-		Gender:     params.Gender,
+		CourseType:    params.CourseType,
+		Gender:        params.Gender,
+		Category:      params.Category,
+		SwimmerGender: params.SwimmerGender,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("list standards: %w", err)
@@ -247,11 +314,13 @@ func (s *Service) Create(ctx context.Context, input Input) (*Standard, error) {
 	}
 
 	dbStandard, err := s.repo.Create(ctx, db.CreateStandardParams{
-		Name:        input.Name,
-		Description: description,
-		CourseType:  input.CourseType,
-		Gender:      input.Gender,
-		IsPreloaded: false,
+		Name:             input.Name,
+		Description:      description,
+		CourseType:       input.CourseType,
+		Gender:           input.Gender,
+		IsPreloaded:      false,
+		ManualTimePolicy: input.ManualTimePolicy,
+		Category:         input.Category,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create standard: %w", err)
@@ -278,6 +347,17 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, input Input) (*Stand
 		return nil, errors.New("preloaded standards cannot be modified")
 	}
 
+	// Existing times are keyed by the current category's age groups
+	if input.Category != existing.Category {
+		times, err := s.repo.ListTimes(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("get standard times: %w", err)
+		}
+		if len(times) > 0 {
+			return nil, errors.New("validation: category cannot be changed while the standard has times")
+		}
+	}
+
 	// Check for duplicate name (excluding current standard)
 	exists, err := s.repo.NameExists(ctx, input.Name, id)
 	if err != nil {
@@ -293,11 +373,13 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, input Input) (*Stand
 	}
 
 	dbStandard, err := s.repo.Update(ctx, db.UpdateStandardParams{
-		ID:          id,
-		Name:        input.Name,
-		Description: description,
-		CourseType:  input.CourseType,
-		Gender:      input.Gender,
+		ID:               id,
+		Name:             input.Name,
+		Description:      description,
+		CourseType:       input.CourseType,
+		Gender:           input.Gender,
+		ManualTimePolicy: input.ManualTimePolicy,
+		Category:         input.Category,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("update standard: %w", err)
@@ -339,6 +421,9 @@ func (s *Service) SetTimes(ctx context.Context, standardID uuid.UUID, times []St
 			return nil, fmt.Errorf("times[%d]: %w", idx, err)
 		}
 	}
+	if err := validateAgeGroups(dbStandard.Category, times); err != nil {
+		return nil, fmt.Errorf("validation: %w", err)
+	}
 
 	// Delete existing times
 	if err := s.repo.DeleteTimes(ctx, standardID); err != nil {
@@ -353,6 +438,7 @@ func (s *Service) SetTimes(ctx context.Context, standardID uuid.UUID, times []St
 			Event:      t.Event,
 			AgeGroup:   t.AgeGroup,
 			TimeMs:     int32(t.TimeMs),
+			SportClass: t.SportClass,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("insert time: %w", err)
@@ -387,11 +473,13 @@ func (s *Service) Import(ctx context.Context, input ImportInput) (*StandardWithT
 
 	// Create the standard
 	dbStandard, err := s.repo.Create(ctx, db.CreateStandardParams{
-		Name:        input.Name,
-		Description: description,
-		CourseType:  input.CourseType,
-		Gender:      input.Gender,
-		IsPreloaded: false,
+		Name:             input.Name,
+		Description:      description,
+		CourseType:       input.CourseType,
+		Gender:           input.Gender,
+		IsPreloaded:      false,
+		ManualTimePolicy: input.ManualTimePolicy,
+		Category:         input.Category,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create standard: %w", err)
@@ -405,6 +493,7 @@ func (s *Service) Import(ctx context.Context, input ImportInput) (*StandardWithT
 			Event:      t.Event,
 			AgeGroup:   t.AgeGroup,
 			TimeMs:     int32(t.TimeMs),
+			SportClass: t.SportClass,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("insert time: %w", err)
@@ -419,12 +508,19 @@ func (s *Service) Import(ctx context.Context, input ImportInput) (*StandardWithT
 // Each standard code (e.g., "OSC", "OAG") in the file creates a separate standard.
 func (s *Service) ImportFromJSON(ctx context.Context, input JSONFileInput) (*JSONImportResult, error) {
 	// Validate basic fields
-	if input.CourseType != "25m" && input.CourseType != "50m" {
-		return nil, errors.New("validation: course_type must be '25m' or '50m'")
+	if !domain.CourseType(input.CourseType).IsValid() {
+		return nil, errors.New("validation: course_type must be '25m', '50m' or 'open_water'")
+	}
+	if !domain.Gender(input.Gender).IsValidForStandard() {
+		return nil, errors.New("validation: gender must be 'female', 'male', 'x' or 'mixed'")
+	}
+	if input.Category == "" {
+		input.Category = string(domain.CategoryAgeGroup)
 	}
-	if input.Gender != "female" && input.Gender != "male" {
-		return nil, errors.New("validation: gender must be 'female' or 'male'")
+	if !domain.Category(input.Category).IsValid() {
+		return nil, errors.New("validation: category must be 'age_group' or 'masters'")
 	}
+	scheme := domain.Category(input.Category).AgeScheme()
 	if len(input.Standards) == 0 {
 		return nil, errors.New("validation: no standards defined in file")
 	}
@@ -474,9 +570,12 @@ func (s *Service) ImportFromJSON(ctx context.Context, input JSONFileInput) (*JSO
 					continue
 				}
 
+				// Para standards key times as "age_group/sport_class", e.g. "OPEN/S9"
+				ageGroupPart, sportClass, _ := strings.Cut(ageGroupRaw, "/")
+
 				// Map age group to our format
-				ageGroup := mapAgeGroup(ageGroupRaw)
-				if !domain.AgeGroup(ageGroup).IsValid() {
+				ageGroup := mapAgeGroup(ageGroupPart)
+				if !scheme.IsValid(domain.AgeGroup(ageGroup)) {
 					result.Errors = append(result.Errors, fmt.Sprintf("%s/%s: unknown age group '%s'", code, event, ageGroupRaw))
 					continue
 				}
@@ -488,9 +587,10 @@ func (s *Service) ImportFromJSON(ctx context.Context, input JSONFileInput) (*JSO
 				}
 
 				times = append(times, StandardTimeInput{
-					Event:    event,
-					AgeGroup: ageGroup,
-					TimeMs:   timeMs,
+					Event:      event,
+					AgeGroup:   ageGroup,
+					SportClass: strings.ToUpper(sportClass),
+					TimeMs:     timeMs,
 				})
 			}
 		}
@@ -503,11 +603,13 @@ func (s *Service) ImportFromJSON(ctx context.Context, input JSONFileInput) (*JSO
 
 		// Create the standard with times
 		importInput := ImportInput{
-			Name:        name,
-			Description: meta.Description,
-			CourseType:  input.CourseType,
-			Gender:      input.Gender,
-			Times:       times,
+			Name:             name,
+			Description:      meta.Description,
+			CourseType:       input.CourseType,
+			Gender:           input.Gender,
+			ManualTimePolicy: input.ManualTimePolicy,
+			Category:         input.Category,
+			Times:            times,
 		}
 
 		std, err := s.Import(ctx, importInput)
@@ -524,17 +626,27 @@ func (s *Service) ImportFromJSON(ctx context.Context, input JSONFileInput) (*JSO
 	return result, nil
 }
 
-// parseTimeString parses a time string like "1:05.32" or "0:28.50" to milliseconds.
+// parseTimeString parses a time string like "1:05.32", "0:28.50" or "1:58:31.40" to milliseconds.
 func parseTimeString(s string) (int, error) {
 	if s == "" {
 		return 0, errors.New("empty time string")
 	}
 
-	// Handle formats: "M:SS.ss", "MM:SS.ss", "S.ss", "SS.ss"
-	var minutes, seconds, hundredths int
+	// Handle formats: "H:MM:SS.ss", "M:SS.ss", "MM:SS.ss", "S.ss", "SS.ss"
+	var hours, minutes, seconds, hundredths int
+
+	// Try "H:MM:SS.ss" format used for open-water events first
+	n, err := fmt.Sscanf(s, "%d:%d:%d.%d", &hours, &minutes, &seconds, &hundredths)
+	if err == nil && n == 4 {
+		if hundredths < 10 && s[len(s)-2] == '.' {
+			hundredths *= 10
+		}
+		totalMs := (hours*3600+minutes*60+seconds)*1000 + hundredths*10
+		return totalMs, nil
+	}
 
-	// Try "M:SS.ss" or "MM:SS.ss" format first
-	n, err := fmt.Sscanf(s, "%d:%d.%d", &minutes, &seconds, &hundredths)
+	// Try "M:SS.ss" or "MM:SS.ss" format
+	n, err = fmt.Sscanf(s, "%d:%d.%d", &minutes, &seconds, &hundredths)
 	if err == nil && n == 3 {
 		// Adjust hundredths if only one digit was provided
 		if hundredths < 10 && len(s) > 0 && s[len(s)-2] == '.' {
@@ -596,12 +708,14 @@ func toStandard(dbStd *db.TimeStandard) *Standard {
 		description = dbStd.Description.String
 	}
 	return &Standard{
-		ID:          dbStd.ID,
-		Name:        dbStd.Name,
-		Description: description,
-		CourseType:  dbStd.CourseType,
-		Gender:      dbStd.Gender,
-		IsPreloaded: dbStd.IsPreloaded,
+		ID:               dbStd.ID,
+		Name:             dbStd.Name,
+		Description:      description,
+		CourseType:       dbStd.CourseType,
+		Gender:           dbStd.Gender,
+		IsPreloaded:      dbStd.IsPreloaded,
+		ManualTimePolicy: dbStd.ManualTimePolicy,
+		Category:         dbStd.Category,
 	}
 }
 
@@ -611,6 +725,7 @@ func toStandardWithTimes(dbStd *db.TimeStandard, dbTimes []db.StandardTime) *Sta
 		times[i] = StandardTime{
 			Event:         t.Event,
 			AgeGroup:      t.AgeGroup,
+			SportClass:    t.SportClass,
 			TimeMs:        int(t.TimeMs),
 			TimeFormatted: domain.FormatTime(int(t.TimeMs)),
 		}
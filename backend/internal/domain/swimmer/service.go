@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -27,24 +28,44 @@ func NewService(repo *postgres.SwimmerRepository) *Service {
 
 // Swimmer represents a swimmer with computed fields.
 type Swimmer struct {
-	ID               uuid.UUID `json:"id"`
-	Name             string    `json:"name"`
-	BirthDate        string    `json:"birth_date"`
-	Gender           string    `json:"gender"`
-	ThresholdPercent float64   `json:"threshold_percent"`
-	CurrentAge       int       `json:"current_age"`
-	CurrentAgeGroup  string    `json:"current_age_group"`
+	ID                        uuid.UUID `json:"id"`
+	Name                      string    `json:"name"`
+	BirthDate                 string    `json:"birth_date"`
+	Gender                    string    `json:"gender"`
+	ThresholdPercent          float64   `json:"threshold_percent"`
+	ManualAdjustmentMS        int       `json:"manual_adjustment_ms"`
+	SemiAutomaticAdjustmentMS int       `json:"semi_automatic_adjustment_ms"`
+	// Category is "age_group" or "masters"; it selects the age grouping used.
+	Category string `json:"category"`
+	// Para-swimming sport classes per stroke family; omitted when unclassified.
+	SportClassS     *string `json:"sport_class_s,omitempty"`
+	SportClassSB    *string `json:"sport_class_sb,omitempty"`
+	SportClassSM    *string `json:"sport_class_sm,omitempty"`
+	CurrentAge      int     `json:"current_age"`
+	CurrentAgeGroup string  `json:"current_age_group"`
 }
 
 // DefaultThresholdPercent is the default "almost there" threshold.
 const DefaultThresholdPercent = 3.0
 
+// Default adjustments added to hand-recorded times when comparing against standards.
+const (
+	DefaultManualAdjustmentMS        = 240
+	DefaultSemiAutomaticAdjustmentMS = 140
+)
+
 // Input represents input for creating/updating a swimmer.
 type Input struct {
-	Name             string   `json:"name"`
-	BirthDate        string   `json:"birth_date"`
-	Gender           string   `json:"gender"`
-	ThresholdPercent *float64 `json:"threshold_percent,omitempty"`
+	Name                      string   `json:"name"`
+	BirthDate                 string   `json:"birth_date"`
+	Gender                    string   `json:"gender"`
+	ThresholdPercent          *float64 `json:"threshold_percent,omitempty"`
+	ManualAdjustmentMS        *int     `json:"manual_adjustment_ms,omitempty"`
+	SemiAutomaticAdjustmentMS *int     `json:"semi_automatic_adjustment_ms,omitempty"`
+	Category                  string   `json:"category,omitempty"`       // Defaults to "age_group"
+	SportClassS               *string  `json:"sport_class_s,omitempty"`  // e.g. "S9"
+	SportClassSB              *string  `json:"sport_class_sb,omitempty"` // e.g. "SB8"
+	SportClassSM              *string  `json:"sport_class_sm,omitempty"` // e.g. "SM9"
 }
 
 // Sanitize trims whitespace from string fields.
@@ -52,6 +73,25 @@ func (i *Input) Sanitize() {
 	i.Name = domain.SanitizeString(i.Name)
 	i.BirthDate = domain.SanitizeString(i.BirthDate)
 	i.Gender = domain.SanitizeString(i.Gender)
+	i.Category = domain.SanitizeString(i.Category)
+	if i.Category == "" {
+		i.Category = string(domain.CategoryAgeGroup)
+	}
+	i.SportClassS = sanitizeSportClass(i.SportClassS)
+	i.SportClassSB = sanitizeSportClass(i.SportClassSB)
+	i.SportClassSM = sanitizeSportClass(i.SportClassSM)
+}
+
+// sanitizeSportClass upper-cases a sport class and drops it when blank.
+func sanitizeSportClass(class *string) *string {
+	if class == nil {
+		return nil
+	}
+	c := strings.ToUpper(domain.SanitizeString(*class))
+	if c == "" {
+		return nil
+	}
+	return &c
 }
 
 // Validate validates the swimmer input. Call Sanitize() first.
@@ -68,17 +108,55 @@ func (i Input) Validate() error {
 	if _, err := time.Parse("2006-01-02", i.BirthDate); err != nil {
 		return errors.New("birth_date must be a valid date in YYYY-MM-DD format")
 	}
-	if i.Gender != "male" && i.Gender != "female" {
-		return errors.New("gender must be 'male' or 'female'")
+	if !domain.Gender(i.Gender).IsValid() {
+		return errors.New("gender must be 'male', 'female' or 'x'")
 	}
 	if i.ThresholdPercent != nil {
 		if *i.ThresholdPercent < 0 || *i.ThresholdPercent > 100 {
 			return errors.New("threshold_percent must be between 0 and 100")
 		}
 	}
+	if i.ManualAdjustmentMS != nil {
+		if *i.ManualAdjustmentMS < 0 || *i.ManualAdjustmentMS > 10000 {
+			return errors.New("manual_adjustment_ms must be between 0 and 10000")
+		}
+	}
+	if i.SemiAutomaticAdjustmentMS != nil {
+		if *i.SemiAutomaticAdjustmentMS < 0 || *i.SemiAutomaticAdjustmentMS > 10000 {
+			return errors.New("semi_automatic_adjustment_ms must be between 0 and 10000")
+		}
+	}
+	if !domain.Category(i.Category).IsValid() {
+		return errors.New("category must be 'age_group' or 'masters'")
+	}
+	for _, sc := range []struct {
+		field  string
+		class  *string
+		family domain.SportClassFamily
+	}{
+		{"sport_class_s", i.SportClassS, domain.SportClassFamilyS},
+		{"sport_class_sb", i.SportClassSB, domain.SportClassFamilySB},
+		{"sport_class_sm", i.SportClassSM, domain.SportClassFamilySM},
+	} {
+		if sc.class != nil && domain.SportClass(*sc.class).Family() != sc.family {
+			return fmt.Errorf("%s must be %s1 to %s14", sc.field, sc.family, sc.family)
+		}
+	}
 	return nil
 }
 
+// adjustments returns the timing adjustments, falling back to the defaults.
+func (i Input) adjustments() (manual, semiAutomatic int) {
+	manual, semiAutomatic = DefaultManualAdjustmentMS, DefaultSemiAutomaticAdjustmentMS
+	if i.ManualAdjustmentMS != nil {
+		manual = *i.ManualAdjustmentMS
+	}
+	if i.SemiAutomaticAdjustmentMS != nil {
+		semiAutomatic = *i.SemiAutomaticAdjustmentMS
+	}
+	return manual, semiAutomatic
+}
+
 // Get retrieves the swimmer profile.
 // In single-user mode, returns the first swimmer.
 func (s *Service) Get(ctx context.Context) (*Swimmer, error) {
@@ -115,12 +193,19 @@ func (s *Service) Create(ctx context.Context, input Input) (*Swimmer, error) {
 	if input.ThresholdPercent != nil {
 		threshold = *input.ThresholdPercent
 	}
+	manualAdj, semiAdj := input.adjustments()
 
 	params := db.CreateSwimmerParams{
-		Name:             input.Name,
-		BirthDate:        pgtype.Date{Time: birthDate, Valid: true},
-		Gender:           input.Gender,
-		ThresholdPercent: floatToNumeric(threshold),
+		Name:                      input.Name,
+		BirthDate:                 pgtype.Date{Time: birthDate, Valid: true},
+		Gender:                    input.Gender,
+		ThresholdPercent:          floatToNumeric(threshold),
+		ManualAdjustmentMs:        int32(manualAdj),
+		SemiAutomaticAdjustmentMs: int32(semiAdj),
+		Category:                  input.Category,
+		SportClassS:               stringToText(input.SportClassS),
+		SportClassSb:              stringToText(input.SportClassSB),
+		SportClassSm:              stringToText(input.SportClassSM),
 	}
 
 	dbSwimmer, err := s.repo.Create(ctx, params)
@@ -144,13 +229,20 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, input Input) (*Swimm
 	if input.ThresholdPercent != nil {
 		threshold = *input.ThresholdPercent
 	}
+	manualAdj, semiAdj := input.adjustments()
 
 	params := db.UpdateSwimmerParams{
-		ID:               id,
-		Name:             input.Name,
-		BirthDate:        pgtype.Date{Time: birthDate, Valid: true},
-		Gender:           input.Gender,
-		ThresholdPercent: floatToNumeric(threshold),
+		ID:                        id,
+		Name:                      input.Name,
+		BirthDate:                 pgtype.Date{Time: birthDate, Valid: true},
+		Gender:                    input.Gender,
+		ThresholdPercent:          floatToNumeric(threshold),
+		ManualAdjustmentMs:        int32(manualAdj),
+		SemiAutomaticAdjustmentMs: int32(semiAdj),
+		Category:                  input.Category,
+		SportClassS:               stringToText(input.SportClassS),
+		SportClassSb:              stringToText(input.SportClassSB),
+		SportClassSm:              stringToText(input.SportClassSM),
 	}
 
 	dbSwimmer, err := s.repo.Update(ctx, params)
@@ -191,17 +283,24 @@ func (s *Service) Exists(ctx context.Context) (bool, error) {
 // toSwimmer converts a database swimmer to a domain swimmer with computed fields.
 func toSwimmer(dbSwimmer *db.Swimmer) *Swimmer {
 	birthDate := dbSwimmer.BirthDate.Time.Format("2006-01-02")
-	currentAge := domain.AgeAtDate(dbSwimmer.BirthDate.Time, time.Now())
-	ageGroup := domain.AgeGroupFromAge(currentAge)
+	now := time.Now()
+	currentAge := domain.AgeAtDate(dbSwimmer.BirthDate.Time, now)
+	ageGroup := domain.Category(dbSwimmer.Category).AgeScheme().AgeGroupAt(dbSwimmer.BirthDate.Time, now)
 
 	return &Swimmer{
-		ID:               dbSwimmer.ID,
-		Name:             dbSwimmer.Name,
-		BirthDate:        birthDate,
-		Gender:           dbSwimmer.Gender,
-		ThresholdPercent: numericToFloat(dbSwimmer.ThresholdPercent),
-		CurrentAge:       currentAge,
-		CurrentAgeGroup:  string(ageGroup),
+		ID:                        dbSwimmer.ID,
+		Name:                      dbSwimmer.Name,
+		BirthDate:                 birthDate,
+		Gender:                    dbSwimmer.Gender,
+		ThresholdPercent:          numericToFloat(dbSwimmer.ThresholdPercent),
+		ManualAdjustmentMS:        int(dbSwimmer.ManualAdjustmentMs),
+		SemiAutomaticAdjustmentMS: int(dbSwimmer.SemiAutomaticAdjustmentMs),
+		Category:                  dbSwimmer.Category,
+		SportClassS:               textToString(dbSwimmer.SportClassS),
+		SportClassSB:              textToString(dbSwimmer.SportClassSb),
+		SportClassSM:              textToString(dbSwimmer.SportClassSm),
+		CurrentAge:                currentAge,
+		CurrentAgeGroup:           string(ageGroup),
 	}
 }
 
@@ -223,3 +322,19 @@ func numericToFloat(n pgtype.Numeric) float64 {
 	}
 	return f.Float64
 }
+
+// stringToText converts an optional string to pgtype.Text.
+func stringToText(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *s, Valid: true}
+}
+
+// textToString converts pgtype.Text to an optional string.
+func textToString(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
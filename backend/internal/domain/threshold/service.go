@@ -0,0 +1,220 @@
+package threshold
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+	"github.com/bpg/swimstats/backend/internal/store/postgres"
+)
+
+// Service provides threshold business logic.
+type Service struct {
+	repo         *postgres.ThresholdRepository
+	standardRepo *postgres.StandardRepository
+}
+
+// NewService creates a new threshold service.
+func NewService(repo *postgres.ThresholdRepository, standardRepo *postgres.StandardRepository) *Service {
+	return &Service{
+		repo:         repo,
+		standardRepo: standardRepo,
+	}
+}
+
+// ThresholdList represents a list of thresholds.
+type ThresholdList struct {
+	Thresholds []Threshold `json:"thresholds"`
+}
+
+// Input represents input for creating or updating a threshold. Set Event for
+// a single event, or MinDistance and/or MaxDistance for a distance bucket.
+type Input struct {
+	StandardID  *uuid.UUID `json:"standard_id,omitempty"`
+	Event       string     `json:"event,omitempty"`
+	MinDistance *int       `json:"min_distance,omitempty"`
+	MaxDistance *int       `json:"max_distance,omitempty"`
+	Percent     *float64   `json:"percent,omitempty"`
+	TimeMS      *int       `json:"time_ms,omitempty"`
+}
+
+// Sanitize trims whitespace from string fields.
+func (i *Input) Sanitize() {
+	i.Event = strings.ToUpper(strings.TrimSpace(i.Event))
+}
+
+// Validate validates the threshold input. Call Sanitize() first.
+func (i Input) Validate() error {
+	if (i.Percent == nil) == (i.TimeMS == nil) {
+		return errors.New("exactly one of percent and time_ms is required")
+	}
+	if i.Percent != nil && (*i.Percent < 0 || *i.Percent > 100) {
+		return errors.New("percent must be between 0 and 100")
+	}
+	if i.TimeMS != nil && *i.TimeMS < 0 {
+		return errors.New("time_ms must not be negative")
+	}
+	if i.Event != "" {
+		if !domain.EventCode(i.Event).IsValid() {
+			return fmt.Errorf("invalid event: %s", i.Event)
+		}
+		if i.MinDistance != nil || i.MaxDistance != nil {
+			return errors.New("set either event or a distance bucket, not both")
+		}
+	}
+	if i.MinDistance != nil && *i.MinDistance <= 0 {
+		return errors.New("min_distance must be positive")
+	}
+	if i.MaxDistance != nil && *i.MaxDistance <= 0 {
+		return errors.New("max_distance must be positive")
+	}
+	if i.MinDistance != nil && i.MaxDistance != nil && *i.MinDistance > *i.MaxDistance {
+		return errors.New("min_distance must not exceed max_distance")
+	}
+	if i.StandardID == nil && i.Event == "" && i.MinDistance == nil && i.MaxDistance == nil {
+		return errors.New("a standard, an event or a distance bucket is required")
+	}
+	return nil
+}
+
+// Get retrieves a threshold.
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*Threshold, error) {
+	row, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	t := ToThreshold(*row)
+	return &t, nil
+}
+
+// List retrieves all thresholds.
+func (s *Service) List(ctx context.Context) (*ThresholdList, error) {
+	rows, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ThresholdList{Thresholds: toThresholds(rows)}, nil
+}
+
+// Create creates a new threshold.
+func (s *Service) Create(ctx context.Context, input Input) (*Threshold, error) {
+	if err := s.validate(ctx, &input, uuid.UUID{}); err != nil {
+		return nil, err
+	}
+
+	row, err := s.repo.Create(ctx, db.CreateThresholdParams{
+		StandardID:  postgres.UUIDOrNull(input.StandardID),
+		Event:       postgres.TextOrNull(input.Event),
+		MinDistance: postgres.IntOrNull(input.MinDistance),
+		MaxDistance: postgres.IntOrNull(input.MaxDistance),
+		Percent:     floatToNumeric(input.Percent),
+		TimeMs:      postgres.IntOrNull(input.TimeMS),
+	})
+	if err != nil {
+		return nil, err
+	}
+	t := ToThreshold(*row)
+	return &t, nil
+}
+
+// Update updates a threshold.
+func (s *Service) Update(ctx context.Context, id uuid.UUID, input Input) (*Threshold, error) {
+	if _, err := s.repo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := s.validate(ctx, &input, id); err != nil {
+		return nil, err
+	}
+
+	row, err := s.repo.Update(ctx, db.UpdateThresholdParams{
+		ID:          id,
+		StandardID:  postgres.UUIDOrNull(input.StandardID),
+		Event:       postgres.TextOrNull(input.Event),
+		MinDistance: postgres.IntOrNull(input.MinDistance),
+		MaxDistance: postgres.IntOrNull(input.MaxDistance),
+		Percent:     floatToNumeric(input.Percent),
+		TimeMs:      postgres.IntOrNull(input.TimeMS),
+	})
+	if err != nil {
+		return nil, err
+	}
+	t := ToThreshold(*row)
+	return &t, nil
+}
+
+// Delete deletes a threshold.
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.repo.Get(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// validate sanitizes and validates the input, checking that the standard
+// exists and that no other threshold covers the same events at the same level.
+func (s *Service) validate(ctx context.Context, input *Input, excludeID uuid.UUID) error {
+	input.Sanitize()
+	if err := input.Validate(); err != nil {
+		return fmt.Errorf("validation: %w", err)
+	}
+
+	if input.StandardID != nil {
+		if _, err := s.standardRepo.Get(ctx, *input.StandardID); err != nil {
+			if errors.Is(err, postgres.ErrNotFound) {
+				return errors.New("validation: standard not found")
+			}
+			return err
+		}
+	}
+
+	candidate := Threshold{
+		StandardID:  input.StandardID,
+		MinDistance: input.MinDistance,
+		MaxDistance: input.MaxDistance,
+	}
+	if input.Event != "" {
+		candidate.Event = &input.Event
+	}
+
+	rows, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, existing := range toThresholds(rows) {
+		if existing.ID != excludeID && existing.overlaps(candidate) {
+			return errors.New("validation: another threshold already covers these events")
+		}
+	}
+	return nil
+}
+
+func toThresholds(rows []db.ComparisonThreshold) []Threshold {
+	thresholds := make([]Threshold, len(rows))
+	for i, row := range rows {
+		thresholds[i] = ToThreshold(row)
+	}
+	return thresholds
+}
+
+func intOrNil(v pgtype.Int4) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int32)
+	return &i
+}
+
+func floatToNumeric(f *float64) pgtype.Numeric {
+	var n pgtype.Numeric
+	if f == nil {
+		return n
+	}
+	_ = n.Scan(fmt.Sprintf("%.2f", *f))
+	return n
+}
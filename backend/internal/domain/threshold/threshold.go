@@ -0,0 +1,198 @@
+// Package threshold provides "almost there" threshold domain logic.
+// A threshold can be set for a standard, an event or a distance bucket, as a
+// percentage of the standard time or as an absolute time, and overrides the
+// swimmer's default percentage.
+package threshold
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// Source identifies where the threshold applied to a comparison came from.
+type Source string
+
+const (
+	SourceEvent    Source = "event"
+	SourceDistance Source = "distance"
+	SourceStandard Source = "standard"
+	SourceSwimmer  Source = "swimmer"
+)
+
+// ResolutionOrder lists the threshold sources from the most to the least
+// specific. Within the event and distance levels, a threshold set for the
+// standard wins over one set for every standard.
+var ResolutionOrder = []Source{SourceEvent, SourceDistance, SourceStandard, SourceSwimmer}
+
+// Threshold represents a configured threshold. Exactly one of Percent and
+// TimeMS is set. Without a StandardID it applies to every standard.
+type Threshold struct {
+	ID          uuid.UUID  `json:"id"`
+	StandardID  *uuid.UUID `json:"standard_id,omitempty"`
+	Event       *string    `json:"event,omitempty"`
+	MinDistance *int       `json:"min_distance,omitempty"`
+	MaxDistance *int       `json:"max_distance,omitempty"`
+	Percent     *float64   `json:"percent,omitempty"`
+	TimeMS      *int       `json:"time_ms,omitempty"`
+}
+
+// Scope returns the resolution level of the threshold.
+func (t Threshold) Scope() Source {
+	switch {
+	case t.Event != nil:
+		return SourceEvent
+	case t.MinDistance != nil || t.MaxDistance != nil:
+		return SourceDistance
+	default:
+		return SourceStandard
+	}
+}
+
+// appliesTo reports whether the threshold covers the event.
+func (t Threshold) appliesTo(code domain.EventCode) bool {
+	switch t.Scope() {
+	case SourceEvent:
+		return domain.EventCode(*t.Event) == code
+	case SourceDistance:
+		event, ok := domain.LookupEvent(code)
+		if !ok {
+			return false
+		}
+		low, high := t.distanceRange()
+		return event.Distance >= low && event.Distance <= high
+	default:
+		return true
+	}
+}
+
+// distanceRange returns the inclusive distance bucket, open ends included.
+func (t Threshold) distanceRange() (int, int) {
+	low, high := 0, math.MaxInt
+	if t.MinDistance != nil {
+		low = *t.MinDistance
+	}
+	if t.MaxDistance != nil {
+		high = *t.MaxDistance
+	}
+	return low, high
+}
+
+// overlaps reports whether two thresholds cover the same events for the same
+// standards at the same level, which would make the resolution ambiguous.
+func (t Threshold) overlaps(other Threshold) bool {
+	if (t.StandardID == nil) != (other.StandardID == nil) {
+		return false
+	}
+	if t.StandardID != nil && *t.StandardID != *other.StandardID {
+		return false
+	}
+	if t.Scope() != other.Scope() {
+		return false
+	}
+	switch t.Scope() {
+	case SourceEvent:
+		return *t.Event == *other.Event
+	case SourceDistance:
+		low, high := t.distanceRange()
+		otherLow, otherHigh := other.distanceRange()
+		return low <= otherHigh && otherLow <= high
+	default:
+		return true
+	}
+}
+
+// rank orders matching thresholds; lower ranks are more specific.
+func (t Threshold) rank() int {
+	rank := 0
+	for i, source := range ResolutionOrder {
+		if source == t.Scope() {
+			rank = i * 2
+		}
+	}
+	if t.StandardID == nil {
+		rank++
+	}
+	return rank
+}
+
+// Applied is the threshold used for one event comparison.
+type Applied struct {
+	Source      Source     `json:"source"`
+	ThresholdID *uuid.UUID `json:"threshold_id,omitempty"`
+	Percent     *float64   `json:"percent,omitempty"`
+	TimeMS      *int       `json:"time_ms,omitempty"`
+}
+
+// IsAlmost reports whether a swimmer who missed the standard time by diffMS
+// is within the threshold.
+func (a Applied) IsAlmost(diffMS, standardTimeMS int) bool {
+	if a.TimeMS != nil {
+		return diffMS <= *a.TimeMS
+	}
+	if a.Percent == nil || standardTimeMS <= 0 {
+		return false
+	}
+	return float64(diffMS)/float64(standardTimeMS)*100 <= *a.Percent
+}
+
+// Resolver picks the threshold for each event of a comparison against one standard.
+type Resolver struct {
+	thresholds     []Threshold
+	defaultPercent float64
+}
+
+// NewResolver creates a resolver from the thresholds that apply to a standard,
+// falling back to the swimmer's default percentage.
+func NewResolver(thresholds []Threshold, defaultPercent float64) *Resolver {
+	return &Resolver{thresholds: thresholds, defaultPercent: defaultPercent}
+}
+
+// Resolve returns the most specific threshold for an event.
+func (r *Resolver) Resolve(code domain.EventCode) Applied {
+	var best *Threshold
+	for i := range r.thresholds {
+		t := &r.thresholds[i]
+		if !t.appliesTo(code) {
+			continue
+		}
+		if best == nil || t.rank() < best.rank() {
+			best = t
+		}
+	}
+	if best == nil {
+		percent := r.defaultPercent
+		return Applied{Source: SourceSwimmer, Percent: &percent}
+	}
+	id := best.ID
+	return Applied{
+		Source:      best.Scope(),
+		ThresholdID: &id,
+		Percent:     best.Percent,
+		TimeMS:      best.TimeMS,
+	}
+}
+
+// ToThreshold converts a database row to a threshold.
+func ToThreshold(row db.ComparisonThreshold) Threshold {
+	t := Threshold{ID: row.ID}
+	if row.StandardID.Valid {
+		id := uuid.UUID(row.StandardID.Bytes)
+		t.StandardID = &id
+	}
+	if row.Event.Valid {
+		t.Event = &row.Event.String
+	}
+	t.MinDistance = intOrNil(row.MinDistance)
+	t.MaxDistance = intOrNil(row.MaxDistance)
+	t.TimeMS = intOrNil(row.TimeMs)
+	if row.Percent.Valid {
+		if f, err := row.Percent.Float64Value(); err == nil && f.Valid {
+			t.Percent = &f.Float64
+		}
+	}
+	return t
+}
@@ -0,0 +1,64 @@
+package time
+
+import (
+	gotime "time"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// AgeGroupBestKey identifies an event in a course within one age group.
+type AgeGroupBestKey struct {
+	CourseType string
+	Event      string
+	AgeGroup   domain.AgeGroup
+}
+
+// AgeGroupBests returns the fastest swim per course, event and age group from
+// a chronological history. The age group is the one of the scheme that
+// applied on the day of the swim. On a tie the earlier swim is kept.
+func AgeGroupBests(history []db.ListTimeHistoryRow, birthDate gotime.Time, scheme domain.AgeScheme) map[AgeGroupBestKey]db.ListTimeHistoryRow {
+	bests := make(map[AgeGroupBestKey]db.ListTimeHistoryRow)
+	for _, row := range history {
+		if !row.Date.Valid {
+			continue
+		}
+		key := AgeGroupBestKey{
+			CourseType: row.CourseType,
+			Event:      row.Event,
+			AgeGroup:   scheme.AgeGroupAt(birthDate, row.Date.Time),
+		}
+		if best, ok := bests[key]; !ok || row.TimeMs < best.TimeMs {
+			bests[key] = row
+		}
+	}
+	return bests
+}
+
+// AgeBestKey identifies an event in a course at one age at competition.
+type AgeBestKey struct {
+	CourseType string
+	Event      string
+	Age        int
+}
+
+// AgeBests returns the fastest swim per course, event and age from a
+// chronological history. The age is the age at competition on the day of the
+// swim. On a tie the earlier swim is kept.
+func AgeBests(history []db.ListTimeHistoryRow, birthDate gotime.Time) map[AgeBestKey]db.ListTimeHistoryRow {
+	bests := make(map[AgeBestKey]db.ListTimeHistoryRow)
+	for _, row := range history {
+		if !row.Date.Valid {
+			continue
+		}
+		key := AgeBestKey{
+			CourseType: row.CourseType,
+			Event:      row.Event,
+			Age:        domain.AgeAtCompetition(birthDate, row.Date.Time),
+		}
+		if best, ok := bests[key]; !ok || row.TimeMs < best.TimeMs {
+			bests[key] = row
+		}
+	}
+	return bests
+}
@@ -0,0 +1,59 @@
+package time
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+)
+
+// Water temperatures outside this range (in °C) are rejected as typos.
+const (
+	MinWaterTemperatureC = 0
+	MaxWaterTemperatureC = 40
+)
+
+// OpenWaterConditions holds the optional race conditions of an open-water swim.
+type OpenWaterConditions struct {
+	WaterTemperatureC *float64 `json:"water_temperature_c,omitempty"`
+	Wetsuit           *bool    `json:"wetsuit,omitempty"`
+}
+
+// IsEmpty reports whether no conditions were recorded.
+func (c OpenWaterConditions) IsEmpty() bool {
+	return c.WaterTemperatureC == nil && c.Wetsuit == nil
+}
+
+// Validate validates the conditions against the course type of the meet.
+// Conditions are only recorded for open-water swims.
+func (c OpenWaterConditions) Validate(courseType string) error {
+	if c.IsEmpty() {
+		return nil
+	}
+	if domain.CourseType(courseType) != domain.CourseOpenWater {
+		return errors.New("water_temperature_c and wetsuit are only recorded for open-water swims")
+	}
+	if c.WaterTemperatureC != nil && (*c.WaterTemperatureC < MinWaterTemperatureC || *c.WaterTemperatureC > MaxWaterTemperatureC) {
+		return errors.New("water_temperature_c must be between 0 and 40")
+	}
+	return nil
+}
+
+// NewOpenWaterConditions builds OpenWaterConditions from nullable database columns.
+func NewOpenWaterConditions(temperature pgtype.Numeric, wetsuit pgtype.Bool) OpenWaterConditions {
+	var c OpenWaterConditions
+	c.WaterTemperatureC = numericToFloat(temperature)
+	if wetsuit.Valid {
+		c.Wetsuit = &wetsuit.Bool
+	}
+	return c
+}
+
+// boolToPgBool converts an optional bool to pgtype.Bool.
+func boolToPgBool(v *bool) pgtype.Bool {
+	if v == nil {
+		return pgtype.Bool{}
+	}
+	return pgtype.Bool{Bool: *v, Valid: true}
+}
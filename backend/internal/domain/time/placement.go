@@ -0,0 +1,93 @@
+package time
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Placement holds the optional heat, lane and result details of a swim.
+type Placement struct {
+	Heat          *int     `json:"heat,omitempty"`
+	Lane          *int     `json:"lane,omitempty"`
+	HeatPlace     *int     `json:"heat_place,omitempty"`
+	OverallPlace  *int     `json:"overall_place,omitempty"`
+	AgeGroupPlace *int     `json:"age_group_place,omitempty"`
+	PointsEarned  *float64 `json:"points_earned,omitempty"`
+}
+
+// Validate validates the placement details. All fields are optional.
+func (p Placement) Validate() error {
+	if p.Heat != nil && *p.Heat <= 0 {
+		return errors.New("heat must be positive")
+	}
+	if p.Lane != nil && *p.Lane < 0 {
+		return errors.New("lane must not be negative")
+	}
+	if p.HeatPlace != nil && *p.HeatPlace <= 0 {
+		return errors.New("heat_place must be positive")
+	}
+	if p.OverallPlace != nil && *p.OverallPlace <= 0 {
+		return errors.New("overall_place must be positive")
+	}
+	if p.AgeGroupPlace != nil && *p.AgeGroupPlace <= 0 {
+		return errors.New("age_group_place must be positive")
+	}
+	// Points may be fractional (e.g. split on ties), stored with two decimals
+	if p.PointsEarned != nil && (*p.PointsEarned < 0 || *p.PointsEarned >= 10000) {
+		return errors.New("points_earned must be between 0 and 9999.99")
+	}
+	return nil
+}
+
+// newPlacement builds a Placement from nullable database columns.
+func newPlacement(heat, lane, heatPlace, overallPlace, ageGroupPlace pgtype.Int4, points pgtype.Numeric) Placement {
+	return Placement{
+		Heat:          int4ToInt(heat),
+		Lane:          int4ToInt(lane),
+		HeatPlace:     int4ToInt(heatPlace),
+		OverallPlace:  int4ToInt(overallPlace),
+		AgeGroupPlace: int4ToInt(ageGroupPlace),
+		PointsEarned:  numericToFloat(points),
+	}
+}
+
+// intToInt4 converts an optional int to pgtype.Int4.
+func intToInt4(v *int) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*v), Valid: true}
+}
+
+// int4ToInt converts a pgtype.Int4 to an optional int.
+func int4ToInt(v pgtype.Int4) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int32)
+	return &i
+}
+
+// floatToNumeric converts an optional float64 to pgtype.Numeric.
+func floatToNumeric(f *float64) pgtype.Numeric {
+	var n pgtype.Numeric
+	if f == nil {
+		return n
+	}
+	_ = n.Scan(fmt.Sprintf("%.2f", *f))
+	return n
+}
+
+// numericToFloat converts a pgtype.Numeric to an optional float64.
+func numericToFloat(n pgtype.Numeric) *float64 {
+	if !n.Valid {
+		return nil
+	}
+	f, err := n.Float64Value()
+	if err != nil || !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
@@ -0,0 +1,17 @@
+package time
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/bpg/swimstats/backend/internal/domain"
+)
+
+// PointsFromBaseTime scores a swim against its World Aquatics base time.
+// It returns nil when no base time is loaded for the event, course and gender.
+func PointsFromBaseTime(baseTimeMS pgtype.Int4, timeMS int32) *int {
+	if !baseTimeMS.Valid {
+		return nil
+	}
+	points := domain.WorldAquaticsPoints(int(baseTimeMS.Int32), int(timeMS))
+	return &points
+}
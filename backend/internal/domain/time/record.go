@@ -0,0 +1,45 @@
+package time
+
+import (
+	"context"
+	gotime "time"
+
+	"github.com/google/uuid"
+)
+
+// RecordMark is a record that a swim broke or came close to.
+// DifferenceMS is the swim time minus the record time, negative when broken.
+type RecordMark struct {
+	RecordID     uuid.UUID `json:"record_id"`
+	TableName    string    `json:"table_name"`
+	Scope        string    `json:"scope"`
+	AgeGroup     string    `json:"age_group"`
+	RecordTimeMS int       `json:"record_time_ms"`
+	DifferenceMS int       `json:"difference_ms"`
+	Broken       bool      `json:"broken"`
+}
+
+// RecordChecker compares a new swim with the loaded record tables.
+type RecordChecker interface {
+	CheckRecords(ctx context.Context, swimmerID uuid.UUID, courseType, event string, timeMS int, date gotime.Time) ([]RecordMark, error)
+}
+
+// SetRecordChecker sets the checker that marks new swims breaking or
+// approaching records.
+func (s *Service) SetRecordChecker(checker RecordChecker) {
+	s.recordChecker = checker
+}
+
+// checkRecords returns the records a new swim broke or approached. Checker
+// errors are logged and don't fail the swim that triggered them.
+func (s *Service) checkRecords(ctx context.Context, swimmerID uuid.UUID, courseType, event string, timeMS int, date gotime.Time) []RecordMark {
+	if s.recordChecker == nil {
+		return nil
+	}
+	marks, err := s.recordChecker.CheckRecords(ctx, swimmerID, courseType, event, timeMS, date)
+	if err != nil {
+		s.logger.Error("record check failed", "swimmer_id", swimmerID, "event", event, "error", err)
+		return nil
+	}
+	return marks
+}
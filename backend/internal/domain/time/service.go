@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	gotime "time"
 
 	"github.com/google/uuid"
@@ -17,15 +18,42 @@ import (
 
 // Service provides time business logic.
 type Service struct {
-	timeRepo *postgres.TimeRepository
-	meetRepo *postgres.MeetRepository
+	timeRepo      *postgres.TimeRepository
+	meetRepo      *postgres.MeetRepository
+	swimmerRepo   *postgres.SwimmerRepository
+	observers     []TimeObserver
+	recordChecker RecordChecker
+	logger        *slog.Logger
+}
+
+// TimeObserver is notified after a swimmer's times are created, updated or
+// deleted, so that derived state such as goal status can be refreshed.
+type TimeObserver interface {
+	TimesChanged(ctx context.Context, swimmerID uuid.UUID) error
 }
 
 // NewService creates a new time service.
-func NewService(timeRepo *postgres.TimeRepository, meetRepo *postgres.MeetRepository) *Service {
+func NewService(timeRepo *postgres.TimeRepository, meetRepo *postgres.MeetRepository, swimmerRepo *postgres.SwimmerRepository, logger *slog.Logger) *Service {
 	return &Service{
-		timeRepo: timeRepo,
-		meetRepo: meetRepo,
+		timeRepo:    timeRepo,
+		meetRepo:    meetRepo,
+		swimmerRepo: swimmerRepo,
+		logger:      logger,
+	}
+}
+
+// AddObserver registers an observer of time changes.
+func (s *Service) AddObserver(observer TimeObserver) {
+	s.observers = append(s.observers, observer)
+}
+
+// notify tells the observers that a swimmer's times changed. Observer errors
+// are logged and don't fail the change that triggered them.
+func (s *Service) notify(ctx context.Context, swimmerID uuid.UUID) {
+	for _, observer := range s.observers {
+		if err := observer.TimesChanged(ctx, swimmerID); err != nil {
+			s.logger.Error("time observer failed", "swimmer_id", swimmerID, "error", err)
+		}
 	}
 }
 
@@ -39,7 +67,21 @@ type TimeRecord struct {
 	EventDate     string    `json:"event_date,omitempty"`
 	Notes         string    `json:"notes,omitempty"`
 	IsPB          bool      `json:"is_pb,omitempty"`
-	Meet          *Meet     `json:"meet,omitempty"`
+	// AgeGroup is the age group on the day of the swim, and IsAgeGroupBest
+	// marks the fastest swim in the event and course within it. Both are set
+	// in time lists.
+	AgeGroup       string `json:"age_group,omitempty"`
+	IsAgeGroupBest bool   `json:"is_age_group_best,omitempty"`
+	TimingMethod   string `json:"timing_method"`
+	// ReactionTimeMS is the start reaction time reported by the touchpads.
+	ReactionTimeMS *int `json:"reaction_time_ms,omitempty"`
+	// Points are World Aquatics points, set when a base time is loaded.
+	Points *int `json:"points,omitempty"`
+	Placement
+	OpenWaterConditions
+	// Records lists the records a newly created swim broke or approached.
+	Records []RecordMark `json:"records,omitempty"`
+	Meet    *Meet        `json:"meet,omitempty"`
 }
 
 // Meet represents basic meet info embedded in a time record.
@@ -71,6 +113,11 @@ type Input struct {
 	TimeMS    int       `json:"time_ms"`
 	EventDate string    `json:"event_date"`
 	Notes     string    `json:"notes,omitempty"`
+	// TimingMethod defaults to "electronic" when omitted.
+	TimingMethod   string `json:"timing_method,omitempty"`
+	ReactionTimeMS *int   `json:"reaction_time_ms,omitempty"`
+	Placement
+	OpenWaterConditions
 }
 
 // Sanitize trims whitespace from string fields.
@@ -78,6 +125,10 @@ func (i *Input) Sanitize() {
 	i.Event = domain.SanitizeString(i.Event)
 	i.EventDate = domain.SanitizeString(i.EventDate)
 	i.Notes = domain.SanitizeString(i.Notes)
+	i.TimingMethod = domain.SanitizeString(i.TimingMethod)
+	if i.TimingMethod == "" {
+		i.TimingMethod = string(domain.TimingElectronic)
+	}
 }
 
 // BatchTimeInput represents a single time in a batch.
@@ -86,6 +137,11 @@ type BatchTimeInput struct {
 	TimeMS    int    `json:"time_ms"`
 	EventDate string `json:"event_date"`
 	Notes     string `json:"notes,omitempty"`
+	// TimingMethod defaults to "electronic" when omitted.
+	TimingMethod   string `json:"timing_method,omitempty"`
+	ReactionTimeMS *int   `json:"reaction_time_ms,omitempty"`
+	Placement
+	OpenWaterConditions
 }
 
 // Sanitize trims whitespace from string fields.
@@ -93,6 +149,10 @@ func (i *BatchTimeInput) Sanitize() {
 	i.Event = domain.SanitizeString(i.Event)
 	i.EventDate = domain.SanitizeString(i.EventDate)
 	i.Notes = domain.SanitizeString(i.Notes)
+	i.TimingMethod = domain.SanitizeString(i.TimingMethod)
+	if i.TimingMethod == "" {
+		i.TimingMethod = string(domain.TimingElectronic)
+	}
 }
 
 // BatchInput represents input for batch time creation.
@@ -121,6 +181,21 @@ func (i Input) Validate() error {
 	if _, err := gotime.Parse("2006-01-02", i.EventDate); err != nil {
 		return errors.New("event_date must be a valid date in YYYY-MM-DD format")
 	}
+	if !domain.TimingMethod(i.TimingMethod).IsValid() {
+		return errors.New("timing_method must be 'electronic', 'semi_automatic' or 'manual'")
+	}
+	if err := ValidateReactionTime(i.ReactionTimeMS); err != nil {
+		return err
+	}
+	return i.Placement.Validate()
+}
+
+// ValidateReactionTime validates an optional reaction time.
+// Values in the false-start range are accepted and flagged by the analytics.
+func ValidateReactionTime(reactionMS *int) error {
+	if reactionMS != nil && (*reactionMS < 0 || *reactionMS > domain.MaxReactionTimeMS) {
+		return fmt.Errorf("reaction_time_ms must be between 0 and %d", domain.MaxReactionTimeMS)
+	}
 	return nil
 }
 
@@ -148,6 +223,18 @@ func ValidateEventDate(eventDate string, meetStartDate, meetEndDate gotime.Time)
 	return nil
 }
 
+// ValidateEventCourse validates that a catalogued event is swum in the course type.
+func ValidateEventCourse(event, courseType string) error {
+	catalogued, ok := domain.LookupEvent(domain.EventCode(event))
+	if !ok {
+		return errors.New("invalid event code")
+	}
+	if !catalogued.AllowsCourse(domain.CourseType(courseType)) {
+		return fmt.Errorf("event %s is not swum in %s course", event, courseType)
+	}
+	return nil
+}
+
 // ListParams contains parameters for listing times.
 type ListParams struct {
 	SwimmerID  uuid.UUID
@@ -196,21 +283,61 @@ func (s *Service) List(ctx context.Context, params ListParams) (*TimeList, error
 		return nil, fmt.Errorf("count times: %w", err)
 	}
 
+	sw, err := s.swimmerRepo.Get(ctx, params.SwimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("get swimmer: %w", err)
+	}
+	// Age group bests only need the history of the events on the page
+	scheme := domain.Category(sw.Category).AgeScheme()
+	var events, courseTypes []string
+	seenEvents, seenCourses := make(map[string]bool), make(map[string]bool)
+	for _, row := range rows {
+		if !seenEvents[row.Event] {
+			seenEvents[row.Event] = true
+			events = append(events, row.Event)
+		}
+		if !seenCourses[row.MeetCourseType] {
+			seenCourses[row.MeetCourseType] = true
+			courseTypes = append(courseTypes, row.MeetCourseType)
+		}
+	}
+	var ageGroupBests map[AgeGroupBestKey]db.ListTimeHistoryRow
+	if len(rows) > 0 {
+		history, err := s.timeRepo.ListTimeHistoryForEvents(ctx, params.SwimmerID, events, courseTypes)
+		if err != nil {
+			return nil, err
+		}
+		ageGroupBests = AgeGroupBests(history, sw.BirthDate.Time, scheme)
+	}
+
 	times := make([]TimeRecord, len(rows))
 	for i, row := range rows {
 		var eventDate string
 		if row.EventDate.Valid {
 			eventDate = row.EventDate.Time.Format("2006-01-02")
 		}
+		swumOn := row.MeetStartDate.Time
+		if row.EventDate.Valid {
+			swumOn = row.EventDate.Time
+		}
+		ageGroup := scheme.AgeGroupAt(sw.BirthDate.Time, swumOn)
+		best := ageGroupBests[AgeGroupBestKey{CourseType: row.MeetCourseType, Event: row.Event, AgeGroup: ageGroup}]
 
 		times[i] = TimeRecord{
-			ID:            row.ID,
-			MeetID:        row.MeetID,
-			Event:         row.Event,
-			TimeMS:        int(row.TimeMs),
-			TimeFormatted: domain.FormatTime(int(row.TimeMs)),
-			EventDate:     eventDate,
-			Notes:         row.Notes.String,
+			ID:                  row.ID,
+			MeetID:              row.MeetID,
+			Event:               row.Event,
+			TimeMS:              int(row.TimeMs),
+			TimeFormatted:       domain.FormatTime(int(row.TimeMs)),
+			EventDate:           eventDate,
+			Notes:               row.Notes.String,
+			AgeGroup:            string(ageGroup),
+			IsAgeGroupBest:      best.ID == row.ID,
+			TimingMethod:        row.TimingMethod,
+			ReactionTimeMS:      int4ToInt(row.ReactionTimeMs),
+			Points:              PointsFromBaseTime(row.BaseTimeMs, row.TimeMs),
+			Placement:           newPlacement(row.Heat, row.Lane, row.HeatPlace, row.OverallPlace, row.AgeGroupPlace, row.PointsEarned),
+			OpenWaterConditions: NewOpenWaterConditions(row.WaterTemperatureC, row.Wetsuit),
 			Meet: &Meet{
 				ID:         row.MeetID,
 				Name:       row.MeetName,
@@ -230,6 +357,29 @@ func (s *Service) List(ctx context.Context, params ListParams) (*TimeList, error
 
 // Create creates a new time.
 func (s *Service) Create(ctx context.Context, swimmerID uuid.UUID, input Input) (*TimeRecord, error) {
+	record, err := s.create(ctx, swimmerID, input)
+	if err != nil {
+		return nil, err
+	}
+	s.notify(ctx, swimmerID)
+	return record, nil
+}
+
+// CreateImported creates a time like Create without notifying the observers.
+// An import creates many times one by one and calls TimesImported once when
+// they are all in.
+func (s *Service) CreateImported(ctx context.Context, swimmerID uuid.UUID, input Input) (*TimeRecord, error) {
+	return s.create(ctx, swimmerID, input)
+}
+
+// TimesImported notifies the observers once after an import created a
+// swimmer's times with CreateImported.
+func (s *Service) TimesImported(ctx context.Context, swimmerID uuid.UUID) {
+	s.notify(ctx, swimmerID)
+}
+
+// create creates a new time without notifying the observers.
+func (s *Service) create(ctx context.Context, swimmerID uuid.UUID, input Input) (*TimeRecord, error) {
 	input.Sanitize()
 	if err := input.Validate(); err != nil {
 		return nil, fmt.Errorf("validation: %w", err)
@@ -249,6 +399,14 @@ func (s *Service) Create(ctx context.Context, swimmerID uuid.UUID, input Input)
 		return nil, fmt.Errorf("validation: %w", err)
 	}
 
+	// Validate the event is swum in the meet's course
+	if err := ValidateEventCourse(input.Event, meet.CourseType); err != nil {
+		return nil, fmt.Errorf("validation: %w", err)
+	}
+	if err := input.OpenWaterConditions.Validate(meet.CourseType); err != nil {
+		return nil, fmt.Errorf("validation: %w", err)
+	}
+
 	// Check for duplicate event in the same meet
 	exists, err := s.timeRepo.EventExistsForMeet(ctx, swimmerID, input.MeetID, input.Event)
 	if err != nil {
@@ -268,12 +426,22 @@ func (s *Service) Create(ctx context.Context, swimmerID uuid.UUID, input Input)
 	eventDate := pgtype.Date{Time: ed, Valid: true}
 
 	params := db.CreateTimeParams{
-		SwimmerID: swimmerID,
-		MeetID:    input.MeetID,
-		Event:     input.Event,
-		TimeMs:    int32(input.TimeMS),
-		EventDate: eventDate,
-		Notes:     notes,
+		SwimmerID:         swimmerID,
+		MeetID:            input.MeetID,
+		Event:             input.Event,
+		TimeMs:            int32(input.TimeMS),
+		EventDate:         eventDate,
+		Notes:             notes,
+		Heat:              intToInt4(input.Heat),
+		Lane:              intToInt4(input.Lane),
+		HeatPlace:         intToInt4(input.HeatPlace),
+		OverallPlace:      intToInt4(input.OverallPlace),
+		AgeGroupPlace:     intToInt4(input.AgeGroupPlace),
+		PointsEarned:      floatToNumeric(input.PointsEarned),
+		TimingMethod:      input.TimingMethod,
+		ReactionTimeMs:    intToInt4(input.ReactionTimeMS),
+		WaterTemperatureC: floatToNumeric(input.WaterTemperatureC),
+		Wetsuit:           boolToPgBool(input.Wetsuit),
 	}
 
 	dbTime, err := s.timeRepo.Create(ctx, params)
@@ -284,27 +452,15 @@ func (s *Service) Create(ctx context.Context, swimmerID uuid.UUID, input Input)
 	// Check if this is a PB
 	isPB, _ := s.timeRepo.IsPersonalBest(ctx, swimmerID, meet.CourseType, input.Event, int32(input.TimeMS), &dbTime.ID)
 
-	// EventDate is always valid since it's required
-	eventDateStr := dbTime.EventDate.Time.Format("2006-01-02")
-
-	return &TimeRecord{
-		ID:            dbTime.ID,
-		MeetID:        dbTime.MeetID,
-		Event:         dbTime.Event,
-		TimeMS:        int(dbTime.TimeMs),
-		TimeFormatted: domain.FormatTime(int(dbTime.TimeMs)),
-		EventDate:     eventDateStr,
-		Notes:         dbTime.Notes.String,
-		IsPB:          isPB,
-		Meet: &Meet{
-			ID:         meet.ID,
-			Name:       meet.Name,
-			City:       meet.City,
-			StartDate:  meet.StartDate.Time.Format("2006-01-02"),
-			EndDate:    meet.EndDate.Time.Format("2006-01-02"),
-			CourseType: meet.CourseType,
-		},
-	}, nil
+	// Read the time back with its meet and points
+	row, err := s.timeRepo.GetWithMeet(ctx, dbTime.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get time: %w", err)
+	}
+	record := toTimeRecordFromRow(row)
+	record.IsPB = isPB
+	record.Records = s.checkRecords(ctx, swimmerID, meet.CourseType, dbTime.Event, int(dbTime.TimeMs), dbTime.EventDate.Time)
+	return record, nil
 }
 
 // CreateBatch creates multiple times in a batch.
@@ -342,11 +498,20 @@ func (s *Service) CreateBatch(ctx context.Context, swimmerID uuid.UUID, input Ba
 		return nil, fmt.Errorf("get meet: %w", err)
 	}
 
-	// Validate event dates are within meet range
+	// Validate event dates are within meet range and events are swum in the meet's course
 	for _, t := range input.Times {
 		if err := ValidateEventDate(t.EventDate, meet.StartDate.Time, meet.EndDate.Time); err != nil {
 			return nil, fmt.Errorf("validation for %s: %w", t.Event, err)
 		}
+		if !domain.IsValidEvent(t.Event) {
+			return nil, fmt.Errorf("invalid event code: %s", t.Event)
+		}
+		if err := ValidateEventCourse(t.Event, meet.CourseType); err != nil {
+			return nil, fmt.Errorf("validation for %s: %w", t.Event, err)
+		}
+		if err := t.OpenWaterConditions.Validate(meet.CourseType); err != nil {
+			return nil, fmt.Errorf("validation for %s: %w", t.Event, err)
+		}
 	}
 
 	// Check for duplicate events already in the meet
@@ -377,6 +542,15 @@ func (s *Service) CreateBatch(ctx context.Context, swimmerID uuid.UUID, input Ba
 		if t.TimeMS <= 0 {
 			return nil, fmt.Errorf("time_ms must be positive for event %s", t.Event)
 		}
+		if !domain.TimingMethod(t.TimingMethod).IsValid() {
+			return nil, fmt.Errorf("invalid timing_method for event %s: %s", t.Event, t.TimingMethod)
+		}
+		if err := ValidateReactionTime(t.ReactionTimeMS); err != nil {
+			return nil, fmt.Errorf("validation for %s: %w", t.Event, err)
+		}
+		if err := t.Placement.Validate(); err != nil {
+			return nil, fmt.Errorf("validation for %s: %w", t.Event, err)
+		}
 
 		var notes pgtype.Text
 		if t.Notes != "" {
@@ -388,12 +562,22 @@ func (s *Service) CreateBatch(ctx context.Context, swimmerID uuid.UUID, input Ba
 		eventDate := pgtype.Date{Time: ed, Valid: true}
 
 		params := db.CreateTimeParams{
-			SwimmerID: swimmerID,
-			MeetID:    input.MeetID,
-			Event:     t.Event,
-			TimeMs:    int32(t.TimeMS),
-			EventDate: eventDate,
-			Notes:     notes,
+			SwimmerID:         swimmerID,
+			MeetID:            input.MeetID,
+			Event:             t.Event,
+			TimeMs:            int32(t.TimeMS),
+			EventDate:         eventDate,
+			Notes:             notes,
+			Heat:              intToInt4(t.Heat),
+			Lane:              intToInt4(t.Lane),
+			HeatPlace:         intToInt4(t.HeatPlace),
+			OverallPlace:      intToInt4(t.OverallPlace),
+			AgeGroupPlace:     intToInt4(t.AgeGroupPlace),
+			PointsEarned:      floatToNumeric(t.PointsEarned),
+			TimingMethod:      t.TimingMethod,
+			ReactionTimeMs:    intToInt4(t.ReactionTimeMS),
+			WaterTemperatureC: floatToNumeric(t.WaterTemperatureC),
+			Wetsuit:           boolToPgBool(t.Wetsuit),
 		}
 
 		dbTime, err := s.timeRepo.Create(ctx, params)
@@ -412,23 +596,19 @@ func (s *Service) CreateBatch(ctx context.Context, swimmerID uuid.UUID, input Ba
 			}
 		}
 
-		var eventDateStr string
-		if dbTime.EventDate.Valid {
-			eventDateStr = dbTime.EventDate.Time.Format("2006-01-02")
+		row, err := s.timeRepo.GetWithMeet(ctx, dbTime.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get time for %s: %w", t.Event, err)
 		}
-
-		times = append(times, TimeRecord{
-			ID:            dbTime.ID,
-			MeetID:        dbTime.MeetID,
-			Event:         dbTime.Event,
-			TimeMS:        int(dbTime.TimeMs),
-			TimeFormatted: domain.FormatTime(int(dbTime.TimeMs)),
-			EventDate:     eventDateStr,
-			Notes:         dbTime.Notes.String,
-			IsPB:          isPB,
-		})
+		record := toTimeRecordFromRow(row)
+		record.IsPB = isPB
+		record.Records = s.checkRecords(ctx, swimmerID, meet.CourseType, dbTime.Event, int(dbTime.TimeMs), ed)
+		record.Meet = nil
+		times = append(times, *record)
 	}
 
+	s.notify(ctx, swimmerID)
+
 	// Convert newPBs map to slice
 	pbSlice := make([]string, 0, len(newPBs))
 	for event := range newPBs {
@@ -462,6 +642,14 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, input Input) (*TimeR
 		return nil, fmt.Errorf("validation: %w", err)
 	}
 
+	// Validate the event is swum in the meet's course
+	if err := ValidateEventCourse(input.Event, meet.CourseType); err != nil {
+		return nil, fmt.Errorf("validation: %w", err)
+	}
+	if err := input.OpenWaterConditions.Validate(meet.CourseType); err != nil {
+		return nil, fmt.Errorf("validation: %w", err)
+	}
+
 	var notes pgtype.Text
 	if input.Notes != "" {
 		notes = pgtype.Text{String: input.Notes, Valid: true}
@@ -472,45 +660,41 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, input Input) (*TimeR
 	eventDate := pgtype.Date{Time: ed, Valid: true}
 
 	params := db.UpdateTimeParams{
-		ID:        id,
-		MeetID:    input.MeetID,
-		Event:     input.Event,
-		TimeMs:    int32(input.TimeMS),
-		EventDate: eventDate,
-		Notes:     notes,
+		ID:                id,
+		MeetID:            input.MeetID,
+		Event:             input.Event,
+		TimeMs:            int32(input.TimeMS),
+		EventDate:         eventDate,
+		Notes:             notes,
+		Heat:              intToInt4(input.Heat),
+		Lane:              intToInt4(input.Lane),
+		HeatPlace:         intToInt4(input.HeatPlace),
+		OverallPlace:      intToInt4(input.OverallPlace),
+		AgeGroupPlace:     intToInt4(input.AgeGroupPlace),
+		PointsEarned:      floatToNumeric(input.PointsEarned),
+		TimingMethod:      input.TimingMethod,
+		ReactionTimeMs:    intToInt4(input.ReactionTimeMS),
+		WaterTemperatureC: floatToNumeric(input.WaterTemperatureC),
+		Wetsuit:           boolToPgBool(input.Wetsuit),
 	}
 
 	dbTime, err := s.timeRepo.Update(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("update time: %w", err)
 	}
+	s.notify(ctx, dbTime.SwimmerID)
 
-	// EventDate is always valid since it's required
-	eventDateStr := dbTime.EventDate.Time.Format("2006-01-02")
-
-	return &TimeRecord{
-		ID:            dbTime.ID,
-		MeetID:        dbTime.MeetID,
-		Event:         dbTime.Event,
-		TimeMS:        int(dbTime.TimeMs),
-		TimeFormatted: domain.FormatTime(int(dbTime.TimeMs)),
-		EventDate:     eventDateStr,
-		Notes:         dbTime.Notes.String,
-		Meet: &Meet{
-			ID:         meet.ID,
-			Name:       meet.Name,
-			City:       meet.City,
-			StartDate:  meet.StartDate.Time.Format("2006-01-02"),
-			EndDate:    meet.EndDate.Time.Format("2006-01-02"),
-			CourseType: meet.CourseType,
-		},
-	}, nil
+	row, err := s.timeRepo.GetWithMeet(ctx, dbTime.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get time: %w", err)
+	}
+	return toTimeRecordFromRow(row), nil
 }
 
 // Delete deletes a time.
 func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
 	// First check if time exists
-	_, err := s.timeRepo.Get(ctx, id)
+	existing, err := s.timeRepo.Get(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -518,6 +702,7 @@ func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
 	if err := s.timeRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("delete time: %w", err)
 	}
+	s.notify(ctx, existing.SwimmerID)
 	return nil
 }
 
@@ -528,13 +713,18 @@ func toTimeRecordFromRow(row *db.GetTimeWithMeetRow) *TimeRecord {
 	}
 
 	return &TimeRecord{
-		ID:            row.ID,
-		MeetID:        row.MeetID,
-		Event:         row.Event,
-		TimeMS:        int(row.TimeMs),
-		TimeFormatted: domain.FormatTime(int(row.TimeMs)),
-		EventDate:     eventDate,
-		Notes:         row.Notes.String,
+		ID:                  row.ID,
+		MeetID:              row.MeetID,
+		Event:               row.Event,
+		TimeMS:              int(row.TimeMs),
+		TimeFormatted:       domain.FormatTime(int(row.TimeMs)),
+		EventDate:           eventDate,
+		Notes:               row.Notes.String,
+		TimingMethod:        row.TimingMethod,
+		ReactionTimeMS:      int4ToInt(row.ReactionTimeMs),
+		Points:              PointsFromBaseTime(row.BaseTimeMs, row.TimeMs),
+		Placement:           newPlacement(row.Heat, row.Lane, row.HeatPlace, row.OverallPlace, row.AgeGroupPlace, row.PointsEarned),
+		OpenWaterConditions: NewOpenWaterConditions(row.WaterTemperatureC, row.Wetsuit),
 		Meet: &Meet{
 			ID:         row.MeetID,
 			Name:       row.MeetName,
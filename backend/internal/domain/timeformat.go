@@ -10,7 +10,7 @@ import (
 // TimeMS represents a swim time in milliseconds.
 type TimeMS int
 
-// FormatTime converts milliseconds to display format (MM:SS.ss or SS.ss).
+// FormatTime converts milliseconds to display format (H:MM:SS.ss, MM:SS.ss or SS.ss).
 func FormatTime(ms int) string {
 	if ms <= 0 {
 		return "0.00"
@@ -18,31 +18,69 @@ func FormatTime(ms int) string {
 
 	totalSeconds := ms / 1000
 	hundredths := (ms % 1000) / 10
-	minutes := totalSeconds / 60
+	hours := totalSeconds / 3600
+	minutes := totalSeconds % 3600 / 60
 	seconds := totalSeconds % 60
 
+	if hours > 0 {
+		return fmt.Sprintf("%d:%02d:%02d.%02d", hours, minutes, seconds, hundredths)
+	}
 	if minutes == 0 {
 		return fmt.Sprintf("%d.%02d", seconds, hundredths)
 	}
 	return fmt.Sprintf("%d:%02d.%02d", minutes, seconds, hundredths)
 }
 
+// FormatDifference formats a time difference in milliseconds with a leading
+// sign, e.g. "+1.25" or "-0.40".
+func FormatDifference(diffMS int) string {
+	switch {
+	case diffMS == 0:
+		return "0.00"
+	case diffMS < 0:
+		return "-" + FormatTime(-diffMS)
+	default:
+		return "+" + FormatTime(diffMS)
+	}
+}
+
 // ParseTime converts display format to milliseconds.
-// Supported formats: "28.45", "1:05.32", "16:42.18"
+// Supported formats: "28.45", "1:05.32", "16:42.18", "1:58:31.40"
 func ParseTime(s string) (int, error) {
 	s = strings.TrimSpace(s)
 	if s == "" {
 		return 0, NewValidationError("time", "time cannot be empty")
 	}
 
-	// Pattern for MM:SS.ss or SS.ss
+	// Pattern for H:MM:SS.ss, MM:SS.ss or SS.ss
+	withHours := regexp.MustCompile(`^(\d+):(\d{2}):(\d{2})\.(\d{1,2})$`)
 	withMinutes := regexp.MustCompile(`^(\d+):(\d{1,2})\.(\d{1,2})$`)
 	withoutMinutes := regexp.MustCompile(`^(\d+)\.(\d{1,2})$`)
 
-	var minutes, seconds, hundredths int
+	var hours, minutes, seconds, hundredths int
 	var err error
 
-	if matches := withMinutes.FindStringSubmatch(s); matches != nil {
+	if matches := withHours.FindStringSubmatch(s); matches != nil {
+		hours, err = strconv.Atoi(matches[1])
+		if err != nil {
+			return 0, NewValidationError("time", "invalid hours value")
+		}
+		minutes, err = strconv.Atoi(matches[2])
+		if err != nil {
+			return 0, NewValidationError("time", "invalid minutes value")
+		}
+		seconds, err = strconv.Atoi(matches[3])
+		if err != nil {
+			return 0, NewValidationError("time", "invalid seconds value")
+		}
+		hundredths, err = parseHundredths(matches[4])
+		if err != nil {
+			return 0, err
+		}
+		if minutes >= 60 {
+			return 0, NewValidationError("time", "minutes must be less than 60 when hours are present")
+		}
+	} else if matches := withMinutes.FindStringSubmatch(s); matches != nil {
 		minutes, err = strconv.Atoi(matches[1])
 		if err != nil {
 			return 0, NewValidationError("time", "invalid minutes value")
@@ -65,18 +103,18 @@ func ParseTime(s string) (int, error) {
 			return 0, err
 		}
 	} else {
-		return 0, NewValidationError("time", "invalid time format, expected SS.ss or MM:SS.ss")
+		return 0, NewValidationError("time", "invalid time format, expected SS.ss, MM:SS.ss or H:MM:SS.ss")
 	}
 
 	// Validate ranges
-	if seconds >= 60 && minutes > 0 {
+	if seconds >= 60 && (minutes > 0 || hours > 0) {
 		return 0, NewValidationError("time", "seconds must be less than 60 when minutes are present")
 	}
 	if hundredths > 99 {
 		return 0, NewValidationError("time", "hundredths must be less than 100")
 	}
 
-	totalMs := (minutes*60+seconds)*1000 + hundredths*10
+	totalMs := (hours*3600+minutes*60+seconds)*1000 + hundredths*10
 	if totalMs <= 0 {
 		return 0, NewValidationError("time", "time must be greater than zero")
 	}
@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// TrendModel identifies the curve fitted to a swimmer's times against age.
+type TrendModel string
+
+const (
+	// TrendAuto picks whichever model fits the swims best.
+	TrendAuto TrendModel = "auto"
+	// TrendLog fits time = a + b·ln(age).
+	TrendLog TrendModel = "log"
+	// TrendExponential fits time = a·e^(b·age), an exponential decay when b < 0.
+	TrendExponential TrendModel = "exponential"
+)
+
+// IsValid checks if the trend model is valid.
+func (m TrendModel) IsValid() bool {
+	switch m {
+	case TrendAuto, TrendLog, TrendExponential:
+		return true
+	default:
+		return false
+	}
+}
+
+// TrendConfidence is the coverage of the prediction bands.
+const TrendConfidence = 0.95
+
+// MinTrendSwims is the number of swims needed to fit a trend.
+const MinTrendSwims = 3
+
+// ErrNotEnoughSwims is returned when there are too few swims, or too little
+// spread in age between them, to fit a trend.
+var ErrNotEnoughSwims = errors.New("at least 3 swims on different dates are needed to fit a trend")
+
+// TrendSample is one swim used to fit a trend.
+type TrendSample struct {
+	Date   time.Time
+	TimeMS int
+}
+
+// Trend is a curve fitted to swim times against age in years. Both models are
+// linear regressions after a log transform, so predictions come with the
+// usual regression prediction interval.
+type Trend struct {
+	Model     TrendModel
+	A, B      float64
+	birthDate time.Time
+	n         int
+	meanX     float64
+	sxx       float64
+	residual  float64 // standard error of the regression, in transformed units
+	rmse      float64 // root-mean-square error in milliseconds
+}
+
+// TrendEstimate is a predicted time with its prediction band.
+type TrendEstimate struct {
+	TimeMS  int
+	LowerMS int
+	UpperMS int
+}
+
+// FitTrend fits a model of time against the swimmer's age to the samples.
+// With TrendAuto both models are fitted and the one with the lower error in
+// milliseconds is returned.
+func FitTrend(model TrendModel, birthDate time.Time, samples []TrendSample) (*Trend, error) {
+	if model != TrendAuto {
+		return fitTrend(model, birthDate, samples)
+	}
+	logTrend, err := fitTrend(TrendLog, birthDate, samples)
+	if err != nil {
+		return nil, err
+	}
+	expTrend, err := fitTrend(TrendExponential, birthDate, samples)
+	if err != nil {
+		return nil, err
+	}
+	if expTrend.rmse < logTrend.rmse {
+		return expTrend, nil
+	}
+	return logTrend, nil
+}
+
+func fitTrend(model TrendModel, birthDate time.Time, samples []TrendSample) (*Trend, error) {
+	xs := make([]float64, 0, len(samples))
+	ys := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		age := ageInYears(birthDate, sample.Date)
+		if age <= 0 || sample.TimeMS <= 0 {
+			continue
+		}
+		x, y := age, float64(sample.TimeMS)
+		switch model {
+		case TrendLog:
+			x = math.Log(age)
+		case TrendExponential:
+			y = math.Log(y)
+		}
+		xs = append(xs, x)
+		ys = append(ys, y)
+	}
+
+	n := len(xs)
+	if n < MinTrendSwims {
+		return nil, ErrNotEnoughSwims
+	}
+	var meanX, meanY float64
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= float64(n)
+	meanY /= float64(n)
+
+	var sxx, sxy float64
+	for i := range xs {
+		sxx += (xs[i] - meanX) * (xs[i] - meanX)
+		sxy += (xs[i] - meanX) * (ys[i] - meanY)
+	}
+	if sxx <= 1e-12 {
+		return nil, ErrNotEnoughSwims
+	}
+
+	t := &Trend{
+		Model:     model,
+		B:         sxy / sxx,
+		birthDate: birthDate,
+		n:         n,
+		meanX:     meanX,
+		sxx:       sxx,
+	}
+	t.A = meanY - t.B*meanX
+
+	var sse, sseMS float64
+	for i := range xs {
+		fitted := t.A + t.B*xs[i]
+		sse += (ys[i] - fitted) * (ys[i] - fitted)
+		actualMS, fittedMS := ys[i], fitted
+		if model == TrendExponential {
+			actualMS, fittedMS = math.Exp(ys[i]), math.Exp(fitted)
+		}
+		sseMS += (actualMS - fittedMS) * (actualMS - fittedMS)
+	}
+	t.residual = math.Sqrt(sse / float64(n-2))
+	t.rmse = math.Sqrt(sseMS / float64(n))
+	return t, nil
+}
+
+// SampleSize returns the number of swims the trend was fitted to.
+func (t *Trend) SampleSize() int {
+	return t.n
+}
+
+// RMSE returns the root-mean-square error of the fit in milliseconds.
+func (t *Trend) RMSE() int {
+	return int(math.Round(t.rmse))
+}
+
+// minPredictedMS is the smallest time a trend predicts. The log model keeps
+// falling with age and would otherwise reach zero or negative times far ahead.
+const minPredictedMS = 1
+
+// Predict returns the predicted time on a date with its prediction band.
+// Predicted times are never below minPredictedMS.
+func (t *Trend) Predict(date time.Time) TrendEstimate {
+	x := ageInYears(t.birthDate, date)
+	if t.Model == TrendLog {
+		x = math.Log(math.Max(x, 1e-3))
+	}
+	fitted := t.A + t.B*x
+	margin := studentT975(t.n-2) * t.residual * math.Sqrt(1+1/float64(t.n)+(x-t.meanX)*(x-t.meanX)/t.sxx)
+
+	low, mid, high := fitted-margin, fitted, fitted+margin
+	if t.Model == TrendExponential {
+		low, mid, high = math.Exp(low), math.Exp(mid), math.Exp(high)
+	}
+	return TrendEstimate{
+		TimeMS:  int(math.Round(math.Max(mid, minPredictedMS))),
+		LowerMS: int(math.Round(math.Max(low, 0))),
+		UpperMS: int(math.Round(math.Max(high, minPredictedMS))),
+	}
+}
+
+// ageInYears returns the exact age in years on a date.
+func ageInYears(birthDate, date time.Time) float64 {
+	return date.Sub(birthDate).Hours() / 24 / 365.25
+}
+
+// studentT975 returns the 97.5th percentile of Student's t distribution,
+// used for two-sided 95% bands. Degrees of freedom between table entries use
+// the next lower entry, which keeps the band conservative.
+func studentT975(df int) float64 {
+	table := []float64{
+		12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+		2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+		2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+	}
+	switch {
+	case df < 1:
+		return math.Inf(1)
+	case df <= len(table):
+		return table[df-1]
+	case df < 60:
+		return 2.042
+	case df < 120:
+		return 2.000
+	default:
+		return 1.980
+	}
+}
@@ -3,16 +3,22 @@ package domain
 
 import "fmt"
 
-// CourseType represents the pool length.
+// CourseType represents the pool length, or open water.
 type CourseType string
 
 const (
-	Course25m CourseType = "25m"
-	Course50m CourseType = "50m"
+	Course25m       CourseType = "25m"
+	Course50m       CourseType = "50m"
+	CourseOpenWater CourseType = "open_water"
 )
 
 // IsValid checks if the course type is valid.
 func (c CourseType) IsValid() bool {
+	return c.IsPool() || c == CourseOpenWater
+}
+
+// IsPool reports whether the course type is a pool length.
+func (c CourseType) IsPool() bool {
 	return c == Course25m || c == Course50m
 }
 
@@ -27,11 +33,26 @@ type Gender string
 const (
 	GenderFemale Gender = "female"
 	GenderMale   Gender = "male"
+	// GenderX is the non-binary / X category offered by some governing bodies.
+	GenderX Gender = "x"
+	// GenderMixed marks gender-neutral standards; it is not a swimmer gender.
+	GenderMixed Gender = "mixed"
 )
 
-// IsValid checks if the gender is valid.
+// IsValid checks if the gender is valid for a swimmer.
 func (g Gender) IsValid() bool {
-	return g == GenderFemale || g == GenderMale
+	return g == GenderFemale || g == GenderMale || g == GenderX
+}
+
+// IsValidForStandard checks if the gender is valid for a time standard.
+func (g Gender) IsValidForStandard() bool {
+	return g.IsValid() || g == GenderMixed
+}
+
+// AppliesTo reports whether a standard of this gender applies to a swimmer:
+// standards apply to swimmers of the same gender, and mixed standards apply to everyone.
+func (g Gender) AppliesTo(swimmer Gender) bool {
+	return g == GenderMixed || g == swimmer
 }
 
 // String returns the string representation.
@@ -39,89 +60,76 @@ func (g Gender) String() string {
 	return string(g)
 }
 
-// AgeGroup represents competition age groups per Swimming Canada.
-type AgeGroup string
+// TimingMethod represents how a time was recorded.
+type TimingMethod string
 
 const (
-	AgeGroup10U   AgeGroup = "10U"
-	AgeGroup11_12 AgeGroup = "11-12"
-	AgeGroup13_14 AgeGroup = "13-14"
-	AgeGroup15_17 AgeGroup = "15-17"
-	AgeGroupOpen  AgeGroup = "OPEN"
+	TimingElectronic    TimingMethod = "electronic"
+	TimingSemiAutomatic TimingMethod = "semi_automatic"
+	TimingManual        TimingMethod = "manual"
 )
 
-// IsValid checks if the age group is valid.
-func (a AgeGroup) IsValid() bool {
-	switch a {
-	case AgeGroup10U, AgeGroup11_12, AgeGroup13_14, AgeGroup15_17, AgeGroupOpen:
-		return true
-	default:
-		return false
-	}
+// IsValid checks if the timing method is valid.
+func (t TimingMethod) IsValid() bool {
+	return t == TimingElectronic || t == TimingSemiAutomatic || t == TimingManual
 }
 
 // String returns the string representation.
-func (a AgeGroup) String() string {
-	return string(a)
+func (t TimingMethod) String() string {
+	return string(t)
 }
 
-// EventCode represents standard swimming events.
-type EventCode string
+// ManualTimePolicy controls how a standard treats manual and semi-automatic times.
+type ManualTimePolicy string
 
-// Freestyle events.
 const (
-	Event50FR   EventCode = "50FR"
-	Event100FR  EventCode = "100FR"
-	Event200FR  EventCode = "200FR"
-	Event400FR  EventCode = "400FR"
-	Event800FR  EventCode = "800FR"
-	Event1500FR EventCode = "1500FR"
+	// ManualTimeAccept compares non-electronic times as recorded.
+	ManualTimeAccept ManualTimePolicy = "accept"
+	// ManualTimeAdjust adds the swimmer's timing adjustment before comparing.
+	ManualTimeAdjust ManualTimePolicy = "adjust"
+	// ManualTimeIneligible ignores non-electronic times.
+	ManualTimeIneligible ManualTimePolicy = "ineligible"
 )
 
-// Backstroke events.
-const (
-	Event50BK  EventCode = "50BK"
-	Event100BK EventCode = "100BK"
-	Event200BK EventCode = "200BK"
-)
+// IsValid checks if the policy is valid.
+func (p ManualTimePolicy) IsValid() bool {
+	return p == ManualTimeAccept || p == ManualTimeAdjust || p == ManualTimeIneligible
+}
 
-// Breaststroke events.
-const (
-	Event50BR  EventCode = "50BR"
-	Event100BR EventCode = "100BR"
-	Event200BR EventCode = "200BR"
-)
+// String returns the string representation.
+func (p ManualTimePolicy) String() string {
+	return string(p)
+}
 
-// Butterfly events.
-const (
-	Event50FL  EventCode = "50FL"
-	Event100FL EventCode = "100FL"
-	Event200FL EventCode = "200FL"
-)
+// AgeGroup represents competition age groups per Swimming Canada,
+// or a masters age band such as "25-29".
+type AgeGroup string
 
-// Individual Medley events.
 const (
-	Event200IM EventCode = "200IM"
-	Event400IM EventCode = "400IM"
+	AgeGroup10U   AgeGroup = "10U"
+	AgeGroup11_12 AgeGroup = "11-12"
+	AgeGroup13_14 AgeGroup = "13-14"
+	AgeGroup15_17 AgeGroup = "15-17"
+	AgeGroupOpen  AgeGroup = "OPEN"
 )
 
-// ValidEventCodes contains all valid event codes.
-var ValidEventCodes = []EventCode{
-	Event50FR, Event100FR, Event200FR, Event400FR, Event800FR, Event1500FR,
-	Event50BK, Event100BK, Event200BK,
-	Event50BR, Event100BR, Event200BR,
-	Event50FL, Event100FL, Event200FL,
-	Event200IM, Event400IM,
+// IsValid checks if the age group is valid in either the youth or masters scheme.
+func (a AgeGroup) IsValid() bool {
+	return YouthScheme{}.IsValid(a) || MastersScheme{}.IsValid(a)
+}
+
+// String returns the string representation.
+func (a AgeGroup) String() string {
+	return string(a)
 }
 
-// IsValid checks if the event code is valid.
+// EventCode represents a swimming event from the event catalogue.
+type EventCode string
+
+// IsValid checks if the event code is in the event catalogue.
 func (e EventCode) IsValid() bool {
-	for _, valid := range ValidEventCodes {
-		if e == valid {
-			return true
-		}
-	}
-	return false
+	_, ok := LookupEvent(e)
+	return ok
 }
 
 // String returns the string representation.
@@ -131,58 +139,28 @@ func (e EventCode) String() string {
 
 // Description returns human-readable event name.
 func (e EventCode) Description() string {
-	descriptions := map[EventCode]string{
-		Event50FR:   "50m Freestyle",
-		Event100FR:  "100m Freestyle",
-		Event200FR:  "200m Freestyle",
-		Event400FR:  "400m Freestyle",
-		Event800FR:  "800m Freestyle",
-		Event1500FR: "1500m Freestyle",
-		Event50BK:   "50m Backstroke",
-		Event100BK:  "100m Backstroke",
-		Event200BK:  "200m Backstroke",
-		Event50BR:   "50m Breaststroke",
-		Event100BR:  "100m Breaststroke",
-		Event200BR:  "200m Breaststroke",
-		Event50FL:   "50m Butterfly",
-		Event100FL:  "100m Butterfly",
-		Event200FL:  "200m Butterfly",
-		Event200IM:  "200m Individual Medley",
-		Event400IM:  "400m Individual Medley",
-	}
-	if desc, ok := descriptions[e]; ok {
-		return desc
+	if event, ok := LookupEvent(e); ok {
+		return event.Name
 	}
 	return string(e)
 }
 
-// Stroke returns the stroke type for the event.
+// Stroke returns the stroke name for the event.
 func (e EventCode) Stroke() string {
-	switch e {
-	case Event50FR, Event100FR, Event200FR, Event400FR, Event800FR, Event1500FR:
-		return "Freestyle"
-	case Event50BK, Event100BK, Event200BK:
-		return "Backstroke"
-	case Event50BR, Event100BR, Event200BR:
-		return "Breaststroke"
-	case Event50FL, Event100FL, Event200FL:
-		return "Butterfly"
-	case Event200IM, Event400IM:
-		return "Individual Medley"
-	default:
-		return "Unknown"
+	if event, ok := LookupEvent(e); ok {
+		return event.Stroke.Name()
 	}
+	return "Unknown"
 }
 
-// EventsByStroke returns events grouped by stroke type.
+// EventsByStroke returns catalogued events grouped by stroke name.
 func EventsByStroke() map[string][]EventCode {
-	return map[string][]EventCode{
-		"Freestyle":         {Event50FR, Event100FR, Event200FR, Event400FR, Event800FR, Event1500FR},
-		"Backstroke":        {Event50BK, Event100BK, Event200BK},
-		"Breaststroke":      {Event50BR, Event100BR, Event200BR},
-		"Butterfly":         {Event50FL, Event100FL, Event200FL},
-		"Individual Medley": {Event200IM, Event400IM},
+	byStroke := make(map[string][]EventCode)
+	for _, event := range CatalogueEvents() {
+		name := event.Stroke.Name()
+		byStroke[name] = append(byStroke[name], event.Code)
 	}
+	return byStroke
 }
 
 // ValidationError represents a domain validation error.
@@ -97,6 +97,43 @@ func (q *Queries) GetMeet(ctx context.Context, id uuid.UUID) (Meet, error) {
 	return i, err
 }
 
+const getMeetResultSummary = `-- name: GetMeetResultSummary :one
+SELECT
+    COUNT(*) FILTER (WHERE COALESCE(age_group_place, overall_place) = 1)::int AS gold,
+    COUNT(*) FILTER (WHERE COALESCE(age_group_place, overall_place) = 2)::int AS silver,
+    COUNT(*) FILTER (WHERE COALESCE(age_group_place, overall_place) = 3)::int AS bronze,
+    COUNT(*) FILTER (WHERE COALESCE(age_group_place, overall_place) <= 8)::int AS top_eight,
+    COUNT(*) FILTER (WHERE age_group_place IS NOT NULL OR overall_place IS NOT NULL)::int AS placed_count,
+    COALESCE(SUM(points_earned), 0)::float8 AS total_points
+FROM times
+WHERE meet_id = $1
+`
+
+type GetMeetResultSummaryRow struct {
+	Gold        int32   `json:"gold"`
+	Silver      int32   `json:"silver"`
+	Bronze      int32   `json:"bronze"`
+	TopEight    int32   `json:"top_eight"`
+	PlacedCount int32   `json:"placed_count"`
+	TotalPoints float64 `json:"total_points"`
+}
+
+// Returns medal and top-8 counts for a meet.
+// Places use the age-group place when recorded, otherwise the overall place.
+func (q *Queries) GetMeetResultSummary(ctx context.Context, meetID uuid.UUID) (GetMeetResultSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getMeetResultSummary, meetID)
+	var i GetMeetResultSummaryRow
+	err := row.Scan(
+		&i.Gold,
+		&i.Silver,
+		&i.Bronze,
+		&i.TopEight,
+		&i.PlacedCount,
+		&i.TotalPoints,
+	)
+	return i, err
+}
+
 const getMeetWithTimeCount = `-- name: GetMeetWithTimeCount :one
 SELECT 
     m.id, 
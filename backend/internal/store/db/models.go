@@ -11,6 +11,44 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ComparisonThreshold struct {
+	ID          uuid.UUID      `json:"id"`
+	StandardID  pgtype.UUID    `json:"standard_id"`
+	Event       pgtype.Text    `json:"event"`
+	MinDistance pgtype.Int4    `json:"min_distance"`
+	MaxDistance pgtype.Int4    `json:"max_distance"`
+	Percent     pgtype.Numeric `json:"percent"`
+	TimeMs      pgtype.Int4    `json:"time_ms"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+type Event struct {
+	Code        string    `json:"code"`
+	Distance    int32     `json:"distance"`
+	Stroke      string    `json:"stroke"`
+	CourseTypes []string  `json:"course_types"`
+	Name        string    `json:"name"`
+	SortOrder   int32     `json:"sort_order"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type Goal struct {
+	ID             uuid.UUID   `json:"id"`
+	SwimmerID      uuid.UUID   `json:"swimmer_id"`
+	Event          string      `json:"event"`
+	CourseType     string      `json:"course_type"`
+	TargetTimeMs   pgtype.Int4 `json:"target_time_ms"`
+	StandardID     pgtype.UUID `json:"standard_id"`
+	Deadline       pgtype.Date `json:"deadline"`
+	Notes          pgtype.Text `json:"notes"`
+	Status         string      `json:"status"`
+	AchievedTimeID pgtype.UUID `json:"achieved_time_id"`
+	AchievedDate   pgtype.Date `json:"achieved_date"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
+
 type Meet struct {
 	ID         uuid.UUID   `json:"id"`
 	Name       string      `json:"name"`
@@ -23,6 +61,97 @@ type Meet struct {
 	UpdatedAt  time.Time   `json:"updated_at"`
 }
 
+type ParaBaseTime struct {
+	SportClass string    `json:"sport_class"`
+	Event      string    `json:"event"`
+	Gender     string    `json:"gender"`
+	CourseType string    `json:"course_type"`
+	TimeMs     int32     `json:"time_ms"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type RankingEntry struct {
+	ID          uuid.UUID   `json:"id"`
+	SnapshotID  uuid.UUID   `json:"snapshot_id"`
+	Rank        int32       `json:"rank"`
+	SwimmerName pgtype.Text `json:"swimmer_name"`
+	Club        pgtype.Text `json:"club"`
+	TimeMs      int32       `json:"time_ms"`
+}
+
+type RankingSnapshot struct {
+	ID         uuid.UUID   `json:"id"`
+	Source     string      `json:"source"`
+	Season     string      `json:"season"`
+	Event      string      `json:"event"`
+	CourseType string      `json:"course_type"`
+	Gender     string      `json:"gender"`
+	AgeGroup   string      `json:"age_group"`
+	CapturedOn pgtype.Date `json:"captured_on"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+type Record struct {
+	ID            uuid.UUID   `json:"id"`
+	RecordTableID uuid.UUID   `json:"record_table_id"`
+	Event         string      `json:"event"`
+	CourseType    string      `json:"course_type"`
+	Gender        string      `json:"gender"`
+	AgeGroup      string      `json:"age_group"`
+	TimeMs        int32       `json:"time_ms"`
+	Holder        pgtype.Text `json:"holder"`
+	RecordDate    pgtype.Date `json:"record_date"`
+	Location      pgtype.Text `json:"location"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+type RecordTable struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	Scope       string      `json:"scope"`
+	Description pgtype.Text `json:"description"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+type ReferenceSwimmer struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	BirthDate   pgtype.Date `json:"birth_date"`
+	Gender      string      `json:"gender"`
+	Description pgtype.Text `json:"description"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+type ReferenceTime struct {
+	ID                 uuid.UUID   `json:"id"`
+	ReferenceSwimmerID uuid.UUID   `json:"reference_swimmer_id"`
+	Event              string      `json:"event"`
+	CourseType         string      `json:"course_type"`
+	TimeMs             int32       `json:"time_ms"`
+	EventDate          pgtype.Date `json:"event_date"`
+	MeetName           pgtype.Text `json:"meet_name"`
+	CreatedAt          time.Time   `json:"created_at"`
+}
+
+type StandardLadder struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	Description pgtype.Text `json:"description"`
+	CourseType  string      `json:"course_type"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+type StandardLadderTier struct {
+	LadderID   uuid.UUID   `json:"ladder_id"`
+	Position   int32       `json:"position"`
+	StandardID uuid.UUID   `json:"standard_id"`
+	Label      pgtype.Text `json:"label"`
+}
+
 type StandardTime struct {
 	ID         uuid.UUID `json:"id"`
 	StandardID uuid.UUID `json:"standard_id"`
@@ -31,37 +160,64 @@ type StandardTime struct {
 	TimeMs     int32     `json:"time_ms"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
+	SportClass string    `json:"sport_class"`
 }
 
 type Swimmer struct {
-	ID               uuid.UUID      `json:"id"`
-	Name             string         `json:"name"`
-	BirthDate        pgtype.Date    `json:"birth_date"`
-	Gender           string         `json:"gender"`
-	CreatedAt        time.Time      `json:"created_at"`
-	UpdatedAt        time.Time      `json:"updated_at"`
-	ThresholdPercent pgtype.Numeric `json:"threshold_percent"`
+	ID                        uuid.UUID      `json:"id"`
+	Name                      string         `json:"name"`
+	BirthDate                 pgtype.Date    `json:"birth_date"`
+	Gender                    string         `json:"gender"`
+	CreatedAt                 time.Time      `json:"created_at"`
+	UpdatedAt                 time.Time      `json:"updated_at"`
+	ThresholdPercent          pgtype.Numeric `json:"threshold_percent"`
+	ManualAdjustmentMs        int32          `json:"manual_adjustment_ms"`
+	SemiAutomaticAdjustmentMs int32          `json:"semi_automatic_adjustment_ms"`
+	Category                  string         `json:"category"`
+	SportClassS               pgtype.Text    `json:"sport_class_s"`
+	SportClassSb              pgtype.Text    `json:"sport_class_sb"`
+	SportClassSm              pgtype.Text    `json:"sport_class_sm"`
 }
 
 type Time struct {
-	ID        uuid.UUID   `json:"id"`
-	SwimmerID uuid.UUID   `json:"swimmer_id"`
-	MeetID    uuid.UUID   `json:"meet_id"`
-	Event     string      `json:"event"`
-	TimeMs    int32       `json:"time_ms"`
-	EventDate pgtype.Date `json:"event_date"`
-	Notes     pgtype.Text `json:"notes"`
-	CreatedAt time.Time   `json:"created_at"`
-	UpdatedAt time.Time   `json:"updated_at"`
+	ID                uuid.UUID      `json:"id"`
+	SwimmerID         uuid.UUID      `json:"swimmer_id"`
+	MeetID            uuid.UUID      `json:"meet_id"`
+	Event             string         `json:"event"`
+	TimeMs            int32          `json:"time_ms"`
+	EventDate         pgtype.Date    `json:"event_date"`
+	Notes             pgtype.Text    `json:"notes"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	Heat              pgtype.Int4    `json:"heat"`
+	Lane              pgtype.Int4    `json:"lane"`
+	HeatPlace         pgtype.Int4    `json:"heat_place"`
+	OverallPlace      pgtype.Int4    `json:"overall_place"`
+	AgeGroupPlace     pgtype.Int4    `json:"age_group_place"`
+	PointsEarned      pgtype.Numeric `json:"points_earned"`
+	TimingMethod      string         `json:"timing_method"`
+	ReactionTimeMs    pgtype.Int4    `json:"reaction_time_ms"`
+	WaterTemperatureC pgtype.Numeric `json:"water_temperature_c"`
+	Wetsuit           pgtype.Bool    `json:"wetsuit"`
 }
 
 type TimeStandard struct {
-	ID          uuid.UUID   `json:"id"`
-	Name        string      `json:"name"`
-	Description pgtype.Text `json:"description"`
-	CourseType  string      `json:"course_type"`
-	Gender      string      `json:"gender"`
-	IsPreloaded bool        `json:"is_preloaded"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	ID               uuid.UUID   `json:"id"`
+	Name             string      `json:"name"`
+	Description      pgtype.Text `json:"description"`
+	CourseType       string      `json:"course_type"`
+	Gender           string      `json:"gender"`
+	IsPreloaded      bool        `json:"is_preloaded"`
+	CreatedAt        time.Time   `json:"created_at"`
+	UpdatedAt        time.Time   `json:"updated_at"`
+	ManualTimePolicy string      `json:"manual_time_policy"`
+	Category         string      `json:"category"`
+}
+
+type WorldAquaticsBaseTime struct {
+	Event      string    `json:"event"`
+	Gender     string    `json:"gender"`
+	CourseType string    `json:"course_type"`
+	TimeMs     int32     `json:"time_ms"`
+	CreatedAt  time.Time `json:"created_at"`
 }
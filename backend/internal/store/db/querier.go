@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
@@ -16,30 +17,71 @@ type Querier interface {
 	CountTimes(ctx context.Context, arg CountTimesParams) (int64, error)
 	// Returns count of times per event for a swimmer
 	CountTimesByEvent(ctx context.Context, arg CountTimesByEventParams) ([]CountTimesByEventRow, error)
+	CreateEvent(ctx context.Context, arg CreateEventParams) (Event, error)
+	CreateGoal(ctx context.Context, arg CreateGoalParams) (Goal, error)
+	CreateLadder(ctx context.Context, arg CreateLadderParams) (StandardLadder, error)
+	CreateLadderTier(ctx context.Context, arg CreateLadderTierParams) error
 	CreateMeet(ctx context.Context, arg CreateMeetParams) (Meet, error)
+	CreateRankingEntry(ctx context.Context, arg CreateRankingEntryParams) (RankingEntry, error)
+	CreateRankingSnapshot(ctx context.Context, arg CreateRankingSnapshotParams) (RankingSnapshot, error)
+	CreateRecord(ctx context.Context, arg CreateRecordParams) (Record, error)
+	CreateRecordTable(ctx context.Context, arg CreateRecordTableParams) (RecordTable, error)
+	CreateReferenceSwimmer(ctx context.Context, arg CreateReferenceSwimmerParams) (ReferenceSwimmer, error)
+	CreateReferenceTime(ctx context.Context, arg CreateReferenceTimeParams) (ReferenceTime, error)
 	CreateStandard(ctx context.Context, arg CreateStandardParams) (TimeStandard, error)
 	CreateStandardTime(ctx context.Context, arg CreateStandardTimeParams) (StandardTime, error)
 	CreateSwimmer(ctx context.Context, arg CreateSwimmerParams) (CreateSwimmerRow, error)
+	CreateThreshold(ctx context.Context, arg CreateThresholdParams) (ComparisonThreshold, error)
 	CreateTime(ctx context.Context, arg CreateTimeParams) (Time, error)
+	DeleteGoal(ctx context.Context, id uuid.UUID) error
+	DeleteLadder(ctx context.Context, id uuid.UUID) error
+	DeleteLadderTiers(ctx context.Context, ladderID uuid.UUID) error
 	DeleteMeet(ctx context.Context, id uuid.UUID) error
+	DeleteParaBaseTimes(ctx context.Context, arg DeleteParaBaseTimesParams) error
+	DeleteRankingEntriesBySnapshot(ctx context.Context, snapshotID uuid.UUID) error
+	DeleteRankingSnapshot(ctx context.Context, id uuid.UUID) error
+	DeleteRecordTable(ctx context.Context, id uuid.UUID) error
+	DeleteRecordsByTable(ctx context.Context, recordTableID uuid.UUID) error
+	DeleteReferenceSwimmer(ctx context.Context, id uuid.UUID) error
+	DeleteReferenceTimesBySwimmer(ctx context.Context, referenceSwimmerID uuid.UUID) error
 	DeleteStandard(ctx context.Context, id uuid.UUID) error
 	DeleteStandardTime(ctx context.Context, id uuid.UUID) error
 	DeleteStandardTimesByStandardID(ctx context.Context, standardID uuid.UUID) error
 	DeleteSwimmer(ctx context.Context, id uuid.UUID) error
+	DeleteThreshold(ctx context.Context, id uuid.UUID) error
 	DeleteTime(ctx context.Context, id uuid.UUID) error
 	DeleteTimesByMeet(ctx context.Context, meetID uuid.UUID) error
+	DeleteWorldAquaticsBaseTimes(ctx context.Context, arg DeleteWorldAquaticsBaseTimesParams) error
 	// Check if an event already exists for a specific meet and swimmer
 	EventExistsForMeet(ctx context.Context, arg EventExistsForMeetParams) (bool, error)
+	// Returns the fastest time for each event after adding timing adjustments
+	// $3/$4 are the manual/semi-automatic adjustments in ms; $5 = false drops non-electronic times
+	// $6, when set, keeps only times swum on or before that date
+	GetAdjustedPersonalBests(ctx context.Context, arg GetAdjustedPersonalBestsParams) ([]GetAdjustedPersonalBestsRow, error)
+	GetGoal(ctx context.Context, id uuid.UUID) (Goal, error)
+	GetLadder(ctx context.Context, id uuid.UUID) (StandardLadder, error)
 	GetMeet(ctx context.Context, id uuid.UUID) (Meet, error)
+	// Returns medal and top-8 counts for a meet.
+	// Places use the age-group place when recorded, otherwise the overall place.
+	GetMeetResultSummary(ctx context.Context, meetID uuid.UUID) (GetMeetResultSummaryRow, error)
 	GetMeetWithTimeCount(ctx context.Context, id uuid.UUID) (GetMeetWithTimeCountRow, error)
 	// Returns the fastest time for a specific event
 	GetPersonalBestForEvent(ctx context.Context, arg GetPersonalBestForEventParams) (GetPersonalBestForEventRow, error)
 	// Returns the fastest time for each event for a swimmer in a specific course type
+	// Optionally restricted to a single timing method
 	GetPersonalBests(ctx context.Context, arg GetPersonalBestsParams) ([]GetPersonalBestsRow, error)
 	// Returns time progression for a specific event over time
 	// Used for progress charts visualization
 	GetProgressData(ctx context.Context, arg GetProgressDataParams) ([]GetProgressDataRow, error)
+	GetRankingSnapshot(ctx context.Context, id uuid.UUID) (RankingSnapshot, error)
+	GetRankingSnapshotByKey(ctx context.Context, arg GetRankingSnapshotByKeyParams) (RankingSnapshot, error)
 	GetRecentMeets(ctx context.Context, arg GetRecentMeetsParams) ([]GetRecentMeetsRow, error)
+	GetRecordTable(ctx context.Context, id uuid.UUID) (RecordTable, error)
+	GetRecordTableByName(ctx context.Context, name string) (RecordTable, error)
+	// Returns the fastest time for each event for a reference swimmer in a specific course type
+	GetReferencePersonalBests(ctx context.Context, arg GetReferencePersonalBestsParams) ([]ReferenceTime, error)
+	GetReferenceSwimmer(ctx context.Context, id uuid.UUID) (ReferenceSwimmer, error)
+	GetReferenceSwimmerByName(ctx context.Context, name string) (ReferenceSwimmer, error)
 	GetStandard(ctx context.Context, id uuid.UUID) (TimeStandard, error)
 	GetStandardTime(ctx context.Context, id uuid.UUID) (StandardTime, error)
 	GetStandardTimeForEventAndAge(ctx context.Context, arg GetStandardTimeForEventAndAgeParams) (StandardTime, error)
@@ -47,26 +89,67 @@ type Querier interface {
 	// In a multi-user scenario, this would filter by user_id
 	// For single-user MVP, just return the first swimmer
 	GetSwimmerByUserID(ctx context.Context) (GetSwimmerByUserIDRow, error)
+	GetThreshold(ctx context.Context, id uuid.UUID) (ComparisonThreshold, error)
 	GetTime(ctx context.Context, id uuid.UUID) (Time, error)
 	GetTimeWithMeet(ctx context.Context, id uuid.UUID) (GetTimeWithMeetRow, error)
 	GetTotalMeetCount(ctx context.Context, swimmerID uuid.UUID) (int32, error)
 	GetTotalTimeCount(ctx context.Context, swimmerID uuid.UUID) (int32, error)
 	// Check if a given time is faster than all existing times for this event/course
 	IsPersonalBest(ctx context.Context, arg IsPersonalBestParams) (bool, error)
+	LadderNameExists(ctx context.Context, arg LadderNameExistsParams) (bool, error)
+	// Returns the records that apply to a swimmer of a gender: the same gender plus mixed records
+	// Optionally restricted to a course type, an event and a record table
+	ListApplicableRecords(ctx context.Context, arg ListApplicableRecordsParams) ([]ListApplicableRecordsRow, error)
+	ListEvents(ctx context.Context) ([]Event, error)
+	ListGoals(ctx context.Context, swimmerID uuid.UUID) ([]Goal, error)
+	ListLadderTiers(ctx context.Context, ladderID uuid.UUID) ([]ListLadderTiersRow, error)
+	ListLadders(ctx context.Context, column1 string) ([]StandardLadder, error)
 	ListMeets(ctx context.Context, arg ListMeetsParams) ([]ListMeetsRow, error)
+	ListParaBaseTimes(ctx context.Context, arg ListParaBaseTimesParams) ([]ParaBaseTime, error)
+	ListRankingEntries(ctx context.Context, snapshotID uuid.UUID) ([]RankingEntry, error)
+	// Optionally restricted to a season, course type, event, gender and age group
+	ListRankingSnapshots(ctx context.Context, arg ListRankingSnapshotsParams) ([]ListRankingSnapshotsRow, error)
+	// Returns every recorded reaction time for a swimmer in chronological order
+	ListReactionTimes(ctx context.Context, arg ListReactionTimesParams) ([]ListReactionTimesRow, error)
+	ListRecordTables(ctx context.Context) ([]ListRecordTablesRow, error)
+	ListRecordsByTable(ctx context.Context, recordTableID uuid.UUID) ([]Record, error)
+	ListReferenceSwimmers(ctx context.Context) ([]ListReferenceSwimmersRow, error)
+	// Returns every time of a reference swimmer in chronological order
+	// Optionally restricted to a single course type
+	ListReferenceTimes(ctx context.Context, arg ListReferenceTimesParams) ([]ReferenceTime, error)
+	// Events are ordered by the event catalogue; unknown events sort last
 	ListStandardTimes(ctx context.Context, standardID uuid.UUID) ([]StandardTime, error)
 	ListStandards(ctx context.Context, arg ListStandardsParams) ([]TimeStandard, error)
 	ListSwimmers(ctx context.Context) ([]ListSwimmersRow, error)
+	ListThresholds(ctx context.Context) ([]ComparisonThreshold, error)
+	// Lists the thresholds that apply to a standard, including those for every standard.
+	ListThresholdsForStandard(ctx context.Context, standardID pgtype.UUID) ([]ComparisonThreshold, error)
+	// Returns every time for a swimmer in chronological order
+	// Optionally restricted to a single course type
+	ListTimeHistory(ctx context.Context, arg ListTimeHistoryParams) ([]ListTimeHistoryRow, error)
+	// Returns the times for a swimmer in the given events and course types in
+	// chronological order
+	ListTimeHistoryForEvents(ctx context.Context, arg ListTimeHistoryForEventsParams) ([]ListTimeHistoryForEventsRow, error)
 	ListTimes(ctx context.Context, arg ListTimesParams) ([]ListTimesRow, error)
 	ListTimesByMeet(ctx context.Context, meetID uuid.UUID) ([]Time, error)
+	ListWorldAquaticsBaseTimes(ctx context.Context, arg ListWorldAquaticsBaseTimesParams) ([]WorldAquaticsBaseTime, error)
 	StandardExists(ctx context.Context, id uuid.UUID) (bool, error)
 	StandardNameExists(ctx context.Context, arg StandardNameExistsParams) (bool, error)
+	UpdateGoal(ctx context.Context, arg UpdateGoalParams) (Goal, error)
+	UpdateGoalStatus(ctx context.Context, arg UpdateGoalStatusParams) error
+	UpdateLadder(ctx context.Context, arg UpdateLadderParams) (StandardLadder, error)
 	UpdateMeet(ctx context.Context, arg UpdateMeetParams) (Meet, error)
+	UpdateRankingSnapshot(ctx context.Context, arg UpdateRankingSnapshotParams) (RankingSnapshot, error)
+	UpdateRecordTable(ctx context.Context, arg UpdateRecordTableParams) (RecordTable, error)
+	UpdateReferenceSwimmer(ctx context.Context, arg UpdateReferenceSwimmerParams) (ReferenceSwimmer, error)
 	UpdateStandard(ctx context.Context, arg UpdateStandardParams) (TimeStandard, error)
 	UpdateStandardTime(ctx context.Context, arg UpdateStandardTimeParams) (StandardTime, error)
 	UpdateSwimmer(ctx context.Context, arg UpdateSwimmerParams) (UpdateSwimmerRow, error)
+	UpdateThreshold(ctx context.Context, arg UpdateThresholdParams) (ComparisonThreshold, error)
 	UpdateTime(ctx context.Context, arg UpdateTimeParams) (Time, error)
+	UpsertParaBaseTime(ctx context.Context, arg UpsertParaBaseTimeParams) (ParaBaseTime, error)
 	UpsertStandardTime(ctx context.Context, arg UpsertStandardTimeParams) (StandardTime, error)
+	UpsertWorldAquaticsBaseTime(ctx context.Context, arg UpsertWorldAquaticsBaseTimeParams) (WorldAquaticsBaseTime, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -13,17 +13,19 @@ import (
 )
 
 const createStandard = `-- name: CreateStandard :one
-INSERT INTO time_standards (name, description, course_type, gender, is_preloaded)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, name, description, course_type, gender, is_preloaded, created_at, updated_at
+INSERT INTO time_standards (name, description, course_type, gender, is_preloaded, manual_time_policy, category)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, description, course_type, gender, is_preloaded, created_at, updated_at, manual_time_policy, category
 `
 
 type CreateStandardParams struct {
-	Name        string      `json:"name"`
-	Description pgtype.Text `json:"description"`
-	CourseType  string      `json:"course_type"`
-	Gender      string      `json:"gender"`
-	IsPreloaded bool        `json:"is_preloaded"`
+	Name             string      `json:"name"`
+	Description      pgtype.Text `json:"description"`
+	CourseType       string      `json:"course_type"`
+	Gender           string      `json:"gender"`
+	IsPreloaded      bool        `json:"is_preloaded"`
+	ManualTimePolicy string      `json:"manual_time_policy"`
+	Category         string      `json:"category"`
 }
 
 func (q *Queries) CreateStandard(ctx context.Context, arg CreateStandardParams) (TimeStandard, error) {
@@ -33,6 +35,8 @@ func (q *Queries) CreateStandard(ctx context.Context, arg CreateStandardParams)
 		arg.CourseType,
 		arg.Gender,
 		arg.IsPreloaded,
+		arg.ManualTimePolicy,
+		arg.Category,
 	)
 	var i TimeStandard
 	err := row.Scan(
@@ -44,6 +48,8 @@ func (q *Queries) CreateStandard(ctx context.Context, arg CreateStandardParams)
 		&i.IsPreloaded,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ManualTimePolicy,
+		&i.Category,
 	)
 	return i, err
 }
@@ -59,7 +65,7 @@ func (q *Queries) DeleteStandard(ctx context.Context, id uuid.UUID) error {
 }
 
 const getStandard = `-- name: GetStandard :one
-SELECT id, name, description, course_type, gender, is_preloaded, created_at, updated_at
+SELECT id, name, description, course_type, gender, is_preloaded, created_at, updated_at, manual_time_policy, category
 FROM time_standards
 WHERE id = $1
 `
@@ -76,25 +82,36 @@ func (q *Queries) GetStandard(ctx context.Context, id uuid.UUID) (TimeStandard,
 		&i.IsPreloaded,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ManualTimePolicy,
+		&i.Category,
 	)
 	return i, err
 }
 
 const listStandards = `-- name: ListStandards :many
-SELECT id, name, description, course_type, gender, is_preloaded, created_at, updated_at
+SELECT id, name, description, course_type, gender, is_preloaded, created_at, updated_at, manual_time_policy, category
 FROM time_standards
 WHERE ($1::varchar = '' OR course_type = $1)
   AND ($2::varchar = '' OR gender = $2)
+  AND ($3::varchar = '' OR category = $3)
+  AND ($4::varchar = '' OR gender = $4 OR gender = 'mixed')
 ORDER BY is_preloaded DESC, name ASC
 `
 
 type ListStandardsParams struct {
 	Column1 string `json:"column_1"`
 	Column2 string `json:"column_2"`
+	Column3 string `json:"column_3"`
+	Column4 string `json:"column_4"`
 }
 
 func (q *Queries) ListStandards(ctx context.Context, arg ListStandardsParams) ([]TimeStandard, error) {
-	rows, err := q.db.Query(ctx, listStandards, arg.Column1, arg.Column2)
+	rows, err := q.db.Query(ctx, listStandards,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +128,8 @@ func (q *Queries) ListStandards(ctx context.Context, arg ListStandardsParams) ([
 			&i.IsPreloaded,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ManualTimePolicy,
+			&i.Category,
 		); err != nil {
 			return nil, err
 		}
@@ -151,17 +170,19 @@ func (q *Queries) StandardNameExists(ctx context.Context, arg StandardNameExists
 
 const updateStandard = `-- name: UpdateStandard :one
 UPDATE time_standards
-SET name = $2, description = $3, course_type = $4, gender = $5
+SET name = $2, description = $3, course_type = $4, gender = $5, manual_time_policy = $6, category = $7
 WHERE id = $1
-RETURNING id, name, description, course_type, gender, is_preloaded, created_at, updated_at
+RETURNING id, name, description, course_type, gender, is_preloaded, created_at, updated_at, manual_time_policy, category
 `
 
 type UpdateStandardParams struct {
-	ID          uuid.UUID   `json:"id"`
-	Name        string      `json:"name"`
-	Description pgtype.Text `json:"description"`
-	CourseType  string      `json:"course_type"`
-	Gender      string      `json:"gender"`
+	ID               uuid.UUID   `json:"id"`
+	Name             string      `json:"name"`
+	Description      pgtype.Text `json:"description"`
+	CourseType       string      `json:"course_type"`
+	Gender           string      `json:"gender"`
+	ManualTimePolicy string      `json:"manual_time_policy"`
+	Category         string      `json:"category"`
 }
 
 func (q *Queries) UpdateStandard(ctx context.Context, arg UpdateStandardParams) (TimeStandard, error) {
@@ -171,6 +192,8 @@ func (q *Queries) UpdateStandard(ctx context.Context, arg UpdateStandardParams)
 		arg.Description,
 		arg.CourseType,
 		arg.Gender,
+		arg.ManualTimePolicy,
+		arg.Category,
 	)
 	var i TimeStandard
 	err := row.Scan(
@@ -182,6 +205,8 @@ func (q *Queries) UpdateStandard(ctx context.Context, arg UpdateStandardParams)
 		&i.IsPreloaded,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ManualTimePolicy,
+		&i.Category,
 	)
 	return i, err
 }
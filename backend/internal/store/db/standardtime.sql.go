@@ -12,9 +12,9 @@ import (
 )
 
 const createStandardTime = `-- name: CreateStandardTime :one
-INSERT INTO standard_times (standard_id, event, age_group, time_ms)
-VALUES ($1, $2, $3, $4)
-RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at
+INSERT INTO standard_times (standard_id, event, age_group, time_ms, sport_class)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at, sport_class
 `
 
 type CreateStandardTimeParams struct {
@@ -22,6 +22,7 @@ type CreateStandardTimeParams struct {
 	Event      string    `json:"event"`
 	AgeGroup   string    `json:"age_group"`
 	TimeMs     int32     `json:"time_ms"`
+	SportClass string    `json:"sport_class"`
 }
 
 func (q *Queries) CreateStandardTime(ctx context.Context, arg CreateStandardTimeParams) (StandardTime, error) {
@@ -30,6 +31,7 @@ func (q *Queries) CreateStandardTime(ctx context.Context, arg CreateStandardTime
 		arg.Event,
 		arg.AgeGroup,
 		arg.TimeMs,
+		arg.SportClass,
 	)
 	var i StandardTime
 	err := row.Scan(
@@ -40,6 +42,7 @@ func (q *Queries) CreateStandardTime(ctx context.Context, arg CreateStandardTime
 		&i.TimeMs,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SportClass,
 	)
 	return i, err
 }
@@ -65,7 +68,7 @@ func (q *Queries) DeleteStandardTimesByStandardID(ctx context.Context, standardI
 }
 
 const getStandardTime = `-- name: GetStandardTime :one
-SELECT id, standard_id, event, age_group, time_ms, created_at, updated_at
+SELECT id, standard_id, event, age_group, time_ms, created_at, updated_at, sport_class
 FROM standard_times
 WHERE id = $1
 `
@@ -81,14 +84,15 @@ func (q *Queries) GetStandardTime(ctx context.Context, id uuid.UUID) (StandardTi
 		&i.TimeMs,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SportClass,
 	)
 	return i, err
 }
 
 const getStandardTimeForEventAndAge = `-- name: GetStandardTimeForEventAndAge :one
-SELECT id, standard_id, event, age_group, time_ms, created_at, updated_at
+SELECT id, standard_id, event, age_group, time_ms, created_at, updated_at, sport_class
 FROM standard_times
-WHERE standard_id = $1 AND event = $2 AND age_group = $3
+WHERE standard_id = $1 AND event = $2 AND age_group = $3 AND sport_class = ''
 `
 
 type GetStandardTimeForEventAndAgeParams struct {
@@ -108,29 +112,31 @@ func (q *Queries) GetStandardTimeForEventAndAge(ctx context.Context, arg GetStan
 		&i.TimeMs,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SportClass,
 	)
 	return i, err
 }
 
 const listStandardTimes = `-- name: ListStandardTimes :many
-SELECT id, standard_id, event, age_group, time_ms, created_at, updated_at
-FROM standard_times
-WHERE standard_id = $1
+SELECT st.id, st.standard_id, st.event, st.age_group, st.time_ms, st.created_at, st.updated_at, st.sport_class
+FROM standard_times st
+LEFT JOIN events e ON e.code = st.event
+WHERE st.standard_id = $1
 ORDER BY 
-    CASE event
-        WHEN '50FR' THEN 1 WHEN '100FR' THEN 2 WHEN '200FR' THEN 3 WHEN '400FR' THEN 4 WHEN '800FR' THEN 5 WHEN '1500FR' THEN 6
-        WHEN '50BK' THEN 7 WHEN '100BK' THEN 8 WHEN '200BK' THEN 9
-        WHEN '50BR' THEN 10 WHEN '100BR' THEN 11 WHEN '200BR' THEN 12
-        WHEN '50FL' THEN 13 WHEN '100FL' THEN 14 WHEN '200FL' THEN 15
-        WHEN '200IM' THEN 16 WHEN '400IM' THEN 17
-        ELSE 99
-    END,
-    CASE age_group
+    COALESCE(e.sort_order, 2147483647),
+    st.event,
+    CASE st.age_group
         WHEN '10U' THEN 1 WHEN '11-12' THEN 2 WHEN '13-14' THEN 3 WHEN '15-17' THEN 4 WHEN 'OPEN' THEN 5
         ELSE 99
-    END
+    END,
+    -- Masters bands ("25-29", ...) sort by their lower age
+    CASE WHEN st.age_group ~ '^[0-9]+-' THEN split_part(st.age_group, '-', 1)::int END,
+    -- Times for every class come first, then sport classes by family and number
+    substring(st.sport_class from '^[A-Z]*'),
+    NULLIF(substring(st.sport_class from '[0-9]*$'), '')::int
 `
 
+// Events are ordered by the event catalogue; unknown events sort last
 func (q *Queries) ListStandardTimes(ctx context.Context, standardID uuid.UUID) ([]StandardTime, error) {
 	rows, err := q.db.Query(ctx, listStandardTimes, standardID)
 	if err != nil {
@@ -148,6 +154,7 @@ func (q *Queries) ListStandardTimes(ctx context.Context, standardID uuid.UUID) (
 			&i.TimeMs,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SportClass,
 		); err != nil {
 			return nil, err
 		}
@@ -161,16 +168,17 @@ func (q *Queries) ListStandardTimes(ctx context.Context, standardID uuid.UUID) (
 
 const updateStandardTime = `-- name: UpdateStandardTime :one
 UPDATE standard_times
-SET event = $2, age_group = $3, time_ms = $4
+SET event = $2, age_group = $3, time_ms = $4, sport_class = $5
 WHERE id = $1
-RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at
+RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at, sport_class
 `
 
 type UpdateStandardTimeParams struct {
-	ID       uuid.UUID `json:"id"`
-	Event    string    `json:"event"`
-	AgeGroup string    `json:"age_group"`
-	TimeMs   int32     `json:"time_ms"`
+	ID         uuid.UUID `json:"id"`
+	Event      string    `json:"event"`
+	AgeGroup   string    `json:"age_group"`
+	TimeMs     int32     `json:"time_ms"`
+	SportClass string    `json:"sport_class"`
 }
 
 func (q *Queries) UpdateStandardTime(ctx context.Context, arg UpdateStandardTimeParams) (StandardTime, error) {
@@ -179,6 +187,7 @@ func (q *Queries) UpdateStandardTime(ctx context.Context, arg UpdateStandardTime
 		arg.Event,
 		arg.AgeGroup,
 		arg.TimeMs,
+		arg.SportClass,
 	)
 	var i StandardTime
 	err := row.Scan(
@@ -189,16 +198,17 @@ func (q *Queries) UpdateStandardTime(ctx context.Context, arg UpdateStandardTime
 		&i.TimeMs,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SportClass,
 	)
 	return i, err
 }
 
 const upsertStandardTime = `-- name: UpsertStandardTime :one
-INSERT INTO standard_times (standard_id, event, age_group, time_ms)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (standard_id, event, age_group) 
+INSERT INTO standard_times (standard_id, event, age_group, time_ms, sport_class)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (standard_id, event, age_group, sport_class)
 DO UPDATE SET time_ms = EXCLUDED.time_ms
-RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at
+RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at, sport_class
 `
 
 type UpsertStandardTimeParams struct {
@@ -206,6 +216,7 @@ type UpsertStandardTimeParams struct {
 	Event      string    `json:"event"`
 	AgeGroup   string    `json:"age_group"`
 	TimeMs     int32     `json:"time_ms"`
+	SportClass string    `json:"sport_class"`
 }
 
 func (q *Queries) UpsertStandardTime(ctx context.Context, arg UpsertStandardTimeParams) (StandardTime, error) {
@@ -214,6 +225,7 @@ func (q *Queries) UpsertStandardTime(ctx context.Context, arg UpsertStandardTime
 		arg.Event,
 		arg.AgeGroup,
 		arg.TimeMs,
+		arg.SportClass,
 	)
 	var i StandardTime
 	err := row.Scan(
@@ -224,6 +236,7 @@ func (q *Queries) UpsertStandardTime(ctx context.Context, arg UpsertStandardTime
 		&i.TimeMs,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SportClass,
 	)
 	return i, err
 }
@@ -25,26 +25,39 @@ func (q *Queries) CountSwimmers(ctx context.Context) (int64, error) {
 }
 
 const createSwimmer = `-- name: CreateSwimmer :one
-INSERT INTO swimmers (name, birth_date, gender, threshold_percent)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, birth_date, gender, threshold_percent, created_at, updated_at
+INSERT INTO swimmers (name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category,
+    sport_class_s, sport_class_sb, sport_class_sm)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category, sport_class_s, sport_class_sb, sport_class_sm, created_at, updated_at
 `
 
 type CreateSwimmerParams struct {
-	Name             string         `json:"name"`
-	BirthDate        pgtype.Date    `json:"birth_date"`
-	Gender           string         `json:"gender"`
-	ThresholdPercent pgtype.Numeric `json:"threshold_percent"`
+	Name                      string         `json:"name"`
+	BirthDate                 pgtype.Date    `json:"birth_date"`
+	Gender                    string         `json:"gender"`
+	ThresholdPercent          pgtype.Numeric `json:"threshold_percent"`
+	ManualAdjustmentMs        int32          `json:"manual_adjustment_ms"`
+	SemiAutomaticAdjustmentMs int32          `json:"semi_automatic_adjustment_ms"`
+	Category                  string         `json:"category"`
+	SportClassS               pgtype.Text    `json:"sport_class_s"`
+	SportClassSb              pgtype.Text    `json:"sport_class_sb"`
+	SportClassSm              pgtype.Text    `json:"sport_class_sm"`
 }
 
 type CreateSwimmerRow struct {
-	ID               uuid.UUID      `json:"id"`
-	Name             string         `json:"name"`
-	BirthDate        pgtype.Date    `json:"birth_date"`
-	Gender           string         `json:"gender"`
-	ThresholdPercent pgtype.Numeric `json:"threshold_percent"`
-	CreatedAt        time.Time      `json:"created_at"`
-	UpdatedAt        time.Time      `json:"updated_at"`
+	ID                        uuid.UUID      `json:"id"`
+	Name                      string         `json:"name"`
+	BirthDate                 pgtype.Date    `json:"birth_date"`
+	Gender                    string         `json:"gender"`
+	ThresholdPercent          pgtype.Numeric `json:"threshold_percent"`
+	ManualAdjustmentMs        int32          `json:"manual_adjustment_ms"`
+	SemiAutomaticAdjustmentMs int32          `json:"semi_automatic_adjustment_ms"`
+	Category                  string         `json:"category"`
+	SportClassS               pgtype.Text    `json:"sport_class_s"`
+	SportClassSb              pgtype.Text    `json:"sport_class_sb"`
+	SportClassSm              pgtype.Text    `json:"sport_class_sm"`
+	CreatedAt                 time.Time      `json:"created_at"`
+	UpdatedAt                 time.Time      `json:"updated_at"`
 }
 
 func (q *Queries) CreateSwimmer(ctx context.Context, arg CreateSwimmerParams) (CreateSwimmerRow, error) {
@@ -53,6 +66,12 @@ func (q *Queries) CreateSwimmer(ctx context.Context, arg CreateSwimmerParams) (C
 		arg.BirthDate,
 		arg.Gender,
 		arg.ThresholdPercent,
+		arg.ManualAdjustmentMs,
+		arg.SemiAutomaticAdjustmentMs,
+		arg.Category,
+		arg.SportClassS,
+		arg.SportClassSb,
+		arg.SportClassSm,
 	)
 	var i CreateSwimmerRow
 	err := row.Scan(
@@ -61,6 +80,12 @@ func (q *Queries) CreateSwimmer(ctx context.Context, arg CreateSwimmerParams) (C
 		&i.BirthDate,
 		&i.Gender,
 		&i.ThresholdPercent,
+		&i.ManualAdjustmentMs,
+		&i.SemiAutomaticAdjustmentMs,
+		&i.Category,
+		&i.SportClassS,
+		&i.SportClassSb,
+		&i.SportClassSm,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -78,19 +103,25 @@ func (q *Queries) DeleteSwimmer(ctx context.Context, id uuid.UUID) error {
 }
 
 const getSwimmer = `-- name: GetSwimmer :one
-SELECT id, name, birth_date, gender, threshold_percent, created_at, updated_at
+SELECT id, name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category, sport_class_s, sport_class_sb, sport_class_sm, created_at, updated_at
 FROM swimmers
 WHERE id = $1
 `
 
 type GetSwimmerRow struct {
-	ID               uuid.UUID      `json:"id"`
-	Name             string         `json:"name"`
-	BirthDate        pgtype.Date    `json:"birth_date"`
-	Gender           string         `json:"gender"`
-	ThresholdPercent pgtype.Numeric `json:"threshold_percent"`
-	CreatedAt        time.Time      `json:"created_at"`
-	UpdatedAt        time.Time      `json:"updated_at"`
+	ID                        uuid.UUID      `json:"id"`
+	Name                      string         `json:"name"`
+	BirthDate                 pgtype.Date    `json:"birth_date"`
+	Gender                    string         `json:"gender"`
+	ThresholdPercent          pgtype.Numeric `json:"threshold_percent"`
+	ManualAdjustmentMs        int32          `json:"manual_adjustment_ms"`
+	SemiAutomaticAdjustmentMs int32          `json:"semi_automatic_adjustment_ms"`
+	Category                  string         `json:"category"`
+	SportClassS               pgtype.Text    `json:"sport_class_s"`
+	SportClassSb              pgtype.Text    `json:"sport_class_sb"`
+	SportClassSm              pgtype.Text    `json:"sport_class_sm"`
+	CreatedAt                 time.Time      `json:"created_at"`
+	UpdatedAt                 time.Time      `json:"updated_at"`
 }
 
 func (q *Queries) GetSwimmer(ctx context.Context, id uuid.UUID) (GetSwimmerRow, error) {
@@ -102,6 +133,12 @@ func (q *Queries) GetSwimmer(ctx context.Context, id uuid.UUID) (GetSwimmerRow,
 		&i.BirthDate,
 		&i.Gender,
 		&i.ThresholdPercent,
+		&i.ManualAdjustmentMs,
+		&i.SemiAutomaticAdjustmentMs,
+		&i.Category,
+		&i.SportClassS,
+		&i.SportClassSb,
+		&i.SportClassSm,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -109,19 +146,25 @@ func (q *Queries) GetSwimmer(ctx context.Context, id uuid.UUID) (GetSwimmerRow,
 }
 
 const getSwimmerByUserID = `-- name: GetSwimmerByUserID :one
-SELECT id, name, birth_date, gender, threshold_percent, created_at, updated_at
+SELECT id, name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category, sport_class_s, sport_class_sb, sport_class_sm, created_at, updated_at
 FROM swimmers
 LIMIT 1
 `
 
 type GetSwimmerByUserIDRow struct {
-	ID               uuid.UUID      `json:"id"`
-	Name             string         `json:"name"`
-	BirthDate        pgtype.Date    `json:"birth_date"`
-	Gender           string         `json:"gender"`
-	ThresholdPercent pgtype.Numeric `json:"threshold_percent"`
-	CreatedAt        time.Time      `json:"created_at"`
-	UpdatedAt        time.Time      `json:"updated_at"`
+	ID                        uuid.UUID      `json:"id"`
+	Name                      string         `json:"name"`
+	BirthDate                 pgtype.Date    `json:"birth_date"`
+	Gender                    string         `json:"gender"`
+	ThresholdPercent          pgtype.Numeric `json:"threshold_percent"`
+	ManualAdjustmentMs        int32          `json:"manual_adjustment_ms"`
+	SemiAutomaticAdjustmentMs int32          `json:"semi_automatic_adjustment_ms"`
+	Category                  string         `json:"category"`
+	SportClassS               pgtype.Text    `json:"sport_class_s"`
+	SportClassSb              pgtype.Text    `json:"sport_class_sb"`
+	SportClassSm              pgtype.Text    `json:"sport_class_sm"`
+	CreatedAt                 time.Time      `json:"created_at"`
+	UpdatedAt                 time.Time      `json:"updated_at"`
 }
 
 // In a multi-user scenario, this would filter by user_id
@@ -135,6 +178,12 @@ func (q *Queries) GetSwimmerByUserID(ctx context.Context) (GetSwimmerByUserIDRow
 		&i.BirthDate,
 		&i.Gender,
 		&i.ThresholdPercent,
+		&i.ManualAdjustmentMs,
+		&i.SemiAutomaticAdjustmentMs,
+		&i.Category,
+		&i.SportClassS,
+		&i.SportClassSb,
+		&i.SportClassSm,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -142,19 +191,25 @@ func (q *Queries) GetSwimmerByUserID(ctx context.Context) (GetSwimmerByUserIDRow
 }
 
 const listSwimmers = `-- name: ListSwimmers :many
-SELECT id, name, birth_date, gender, threshold_percent, created_at, updated_at
+SELECT id, name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category, sport_class_s, sport_class_sb, sport_class_sm, created_at, updated_at
 FROM swimmers
 ORDER BY name
 `
 
 type ListSwimmersRow struct {
-	ID               uuid.UUID      `json:"id"`
-	Name             string         `json:"name"`
-	BirthDate        pgtype.Date    `json:"birth_date"`
-	Gender           string         `json:"gender"`
-	ThresholdPercent pgtype.Numeric `json:"threshold_percent"`
-	CreatedAt        time.Time      `json:"created_at"`
-	UpdatedAt        time.Time      `json:"updated_at"`
+	ID                        uuid.UUID      `json:"id"`
+	Name                      string         `json:"name"`
+	BirthDate                 pgtype.Date    `json:"birth_date"`
+	Gender                    string         `json:"gender"`
+	ThresholdPercent          pgtype.Numeric `json:"threshold_percent"`
+	ManualAdjustmentMs        int32          `json:"manual_adjustment_ms"`
+	SemiAutomaticAdjustmentMs int32          `json:"semi_automatic_adjustment_ms"`
+	Category                  string         `json:"category"`
+	SportClassS               pgtype.Text    `json:"sport_class_s"`
+	SportClassSb              pgtype.Text    `json:"sport_class_sb"`
+	SportClassSm              pgtype.Text    `json:"sport_class_sm"`
+	CreatedAt                 time.Time      `json:"created_at"`
+	UpdatedAt                 time.Time      `json:"updated_at"`
 }
 
 func (q *Queries) ListSwimmers(ctx context.Context) ([]ListSwimmersRow, error) {
@@ -172,6 +227,12 @@ func (q *Queries) ListSwimmers(ctx context.Context) ([]ListSwimmersRow, error) {
 			&i.BirthDate,
 			&i.Gender,
 			&i.ThresholdPercent,
+			&i.ManualAdjustmentMs,
+			&i.SemiAutomaticAdjustmentMs,
+			&i.Category,
+			&i.SportClassS,
+			&i.SportClassSb,
+			&i.SportClassSm,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -187,27 +248,41 @@ func (q *Queries) ListSwimmers(ctx context.Context) ([]ListSwimmersRow, error) {
 
 const updateSwimmer = `-- name: UpdateSwimmer :one
 UPDATE swimmers
-SET name = $2, birth_date = $3, gender = $4, threshold_percent = $5
+SET name = $2, birth_date = $3, gender = $4, threshold_percent = $5,
+    manual_adjustment_ms = $6, semi_automatic_adjustment_ms = $7, category = $8,
+    sport_class_s = $9, sport_class_sb = $10, sport_class_sm = $11
 WHERE id = $1
-RETURNING id, name, birth_date, gender, threshold_percent, created_at, updated_at
+RETURNING id, name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category, sport_class_s, sport_class_sb, sport_class_sm, created_at, updated_at
 `
 
 type UpdateSwimmerParams struct {
-	ID               uuid.UUID      `json:"id"`
-	Name             string         `json:"name"`
-	BirthDate        pgtype.Date    `json:"birth_date"`
-	Gender           string         `json:"gender"`
-	ThresholdPercent pgtype.Numeric `json:"threshold_percent"`
+	ID                        uuid.UUID      `json:"id"`
+	Name                      string         `json:"name"`
+	BirthDate                 pgtype.Date    `json:"birth_date"`
+	Gender                    string         `json:"gender"`
+	ThresholdPercent          pgtype.Numeric `json:"threshold_percent"`
+	ManualAdjustmentMs        int32          `json:"manual_adjustment_ms"`
+	SemiAutomaticAdjustmentMs int32          `json:"semi_automatic_adjustment_ms"`
+	Category                  string         `json:"category"`
+	SportClassS               pgtype.Text    `json:"sport_class_s"`
+	SportClassSb              pgtype.Text    `json:"sport_class_sb"`
+	SportClassSm              pgtype.Text    `json:"sport_class_sm"`
 }
 
 type UpdateSwimmerRow struct {
-	ID               uuid.UUID      `json:"id"`
-	Name             string         `json:"name"`
-	BirthDate        pgtype.Date    `json:"birth_date"`
-	Gender           string         `json:"gender"`
-	ThresholdPercent pgtype.Numeric `json:"threshold_percent"`
-	CreatedAt        time.Time      `json:"created_at"`
-	UpdatedAt        time.Time      `json:"updated_at"`
+	ID                        uuid.UUID      `json:"id"`
+	Name                      string         `json:"name"`
+	BirthDate                 pgtype.Date    `json:"birth_date"`
+	Gender                    string         `json:"gender"`
+	ThresholdPercent          pgtype.Numeric `json:"threshold_percent"`
+	ManualAdjustmentMs        int32          `json:"manual_adjustment_ms"`
+	SemiAutomaticAdjustmentMs int32          `json:"semi_automatic_adjustment_ms"`
+	Category                  string         `json:"category"`
+	SportClassS               pgtype.Text    `json:"sport_class_s"`
+	SportClassSb              pgtype.Text    `json:"sport_class_sb"`
+	SportClassSm              pgtype.Text    `json:"sport_class_sm"`
+	CreatedAt                 time.Time      `json:"created_at"`
+	UpdatedAt                 time.Time      `json:"updated_at"`
 }
 
 func (q *Queries) UpdateSwimmer(ctx context.Context, arg UpdateSwimmerParams) (UpdateSwimmerRow, error) {
@@ -217,6 +292,12 @@ func (q *Queries) UpdateSwimmer(ctx context.Context, arg UpdateSwimmerParams) (U
 		arg.BirthDate,
 		arg.Gender,
 		arg.ThresholdPercent,
+		arg.ManualAdjustmentMs,
+		arg.SemiAutomaticAdjustmentMs,
+		arg.Category,
+		arg.SportClassS,
+		arg.SportClassSb,
+		arg.SportClassSm,
 	)
 	var i UpdateSwimmerRow
 	err := row.Scan(
@@ -225,6 +306,12 @@ func (q *Queries) UpdateSwimmer(ctx context.Context, arg UpdateSwimmerParams) (U
 		&i.BirthDate,
 		&i.Gender,
 		&i.ThresholdPercent,
+		&i.ManualAdjustmentMs,
+		&i.SemiAutomaticAdjustmentMs,
+		&i.Category,
+		&i.SportClassS,
+		&i.SportClassSb,
+		&i.SportClassSm,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -83,18 +83,34 @@ func (q *Queries) CountTimesByEvent(ctx context.Context, arg CountTimesByEventPa
 }
 
 const createTime = `-- name: CreateTime :one
-INSERT INTO times (swimmer_id, meet_id, event, time_ms, event_date, notes)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, swimmer_id, meet_id, event, time_ms, event_date, notes, created_at, updated_at
+INSERT INTO times (
+    swimmer_id, meet_id, event, time_ms, event_date, notes,
+    heat, lane, heat_place, overall_place, age_group_place, points_earned, timing_method,
+    reaction_time_ms, water_temperature_c, wetsuit
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+RETURNING id, swimmer_id, meet_id, event, time_ms, event_date, notes, created_at, updated_at,
+    heat, lane, heat_place, overall_place, age_group_place, points_earned, timing_method,
+    reaction_time_ms, water_temperature_c, wetsuit
 `
 
 type CreateTimeParams struct {
-	SwimmerID uuid.UUID   `json:"swimmer_id"`
-	MeetID    uuid.UUID   `json:"meet_id"`
-	Event     string      `json:"event"`
-	TimeMs    int32       `json:"time_ms"`
-	EventDate pgtype.Date `json:"event_date"`
-	Notes     pgtype.Text `json:"notes"`
+	SwimmerID         uuid.UUID      `json:"swimmer_id"`
+	MeetID            uuid.UUID      `json:"meet_id"`
+	Event             string         `json:"event"`
+	TimeMs            int32          `json:"time_ms"`
+	EventDate         pgtype.Date    `json:"event_date"`
+	Notes             pgtype.Text    `json:"notes"`
+	Heat              pgtype.Int4    `json:"heat"`
+	Lane              pgtype.Int4    `json:"lane"`
+	HeatPlace         pgtype.Int4    `json:"heat_place"`
+	OverallPlace      pgtype.Int4    `json:"overall_place"`
+	AgeGroupPlace     pgtype.Int4    `json:"age_group_place"`
+	PointsEarned      pgtype.Numeric `json:"points_earned"`
+	TimingMethod      string         `json:"timing_method"`
+	ReactionTimeMs    pgtype.Int4    `json:"reaction_time_ms"`
+	WaterTemperatureC pgtype.Numeric `json:"water_temperature_c"`
+	Wetsuit           pgtype.Bool    `json:"wetsuit"`
 }
 
 func (q *Queries) CreateTime(ctx context.Context, arg CreateTimeParams) (Time, error) {
@@ -105,6 +121,16 @@ func (q *Queries) CreateTime(ctx context.Context, arg CreateTimeParams) (Time, e
 		arg.TimeMs,
 		arg.EventDate,
 		arg.Notes,
+		arg.Heat,
+		arg.Lane,
+		arg.HeatPlace,
+		arg.OverallPlace,
+		arg.AgeGroupPlace,
+		arg.PointsEarned,
+		arg.TimingMethod,
+		arg.ReactionTimeMs,
+		arg.WaterTemperatureC,
+		arg.Wetsuit,
 	)
 	var i Time
 	err := row.Scan(
@@ -117,6 +143,16 @@ func (q *Queries) CreateTime(ctx context.Context, arg CreateTimeParams) (Time, e
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Heat,
+		&i.Lane,
+		&i.HeatPlace,
+		&i.OverallPlace,
+		&i.AgeGroupPlace,
+		&i.PointsEarned,
+		&i.TimingMethod,
+		&i.ReactionTimeMs,
+		&i.WaterTemperatureC,
+		&i.Wetsuit,
 	)
 	return i, err
 }
@@ -164,6 +200,85 @@ func (q *Queries) EventExistsForMeet(ctx context.Context, arg EventExistsForMeet
 	return exists, err
 }
 
+const getAdjustedPersonalBests = `-- name: GetAdjustedPersonalBests :many
+SELECT DISTINCT ON (t.event)
+    t.id,
+    t.event,
+    t.time_ms,
+    t.timing_method,
+    (t.time_ms + CASE t.timing_method
+        WHEN 'manual' THEN $3::int
+        WHEN 'semi_automatic' THEN $4::int
+        ELSE 0
+    END)::int AS adjusted_time_ms,
+    m.name AS meet_name,
+    m.start_date AS meet_date
+FROM times t
+JOIN meets m ON m.id = t.meet_id
+WHERE t.swimmer_id = $1
+  AND m.course_type = $2
+  AND ($5::bool OR t.timing_method = 'electronic')
+  AND ($6::date IS NULL OR COALESCE(t.event_date, m.start_date) <= $6::date)
+ORDER BY t.event, adjusted_time_ms ASC, COALESCE(t.event_date, m.start_date) DESC
+`
+
+type GetAdjustedPersonalBestsParams struct {
+	SwimmerID  uuid.UUID   `json:"swimmer_id"`
+	CourseType string      `json:"course_type"`
+	Column3    int32       `json:"column_3"`
+	Column4    int32       `json:"column_4"`
+	Column5    bool        `json:"column_5"`
+	Column6    pgtype.Date `json:"column_6"`
+}
+
+type GetAdjustedPersonalBestsRow struct {
+	ID             uuid.UUID   `json:"id"`
+	Event          string      `json:"event"`
+	TimeMs         int32       `json:"time_ms"`
+	TimingMethod   string      `json:"timing_method"`
+	AdjustedTimeMs int32       `json:"adjusted_time_ms"`
+	MeetName       string      `json:"meet_name"`
+	MeetDate       pgtype.Date `json:"meet_date"`
+}
+
+// Returns the fastest time for each event after adding timing adjustments
+// $3/$4 are the manual/semi-automatic adjustments in ms; $5 = false drops non-electronic times
+// $6, when set, keeps only times swum on or before that date
+func (q *Queries) GetAdjustedPersonalBests(ctx context.Context, arg GetAdjustedPersonalBestsParams) ([]GetAdjustedPersonalBestsRow, error) {
+	rows, err := q.db.Query(ctx, getAdjustedPersonalBests,
+		arg.SwimmerID,
+		arg.CourseType,
+		arg.Column3,
+		arg.Column4,
+		arg.Column5,
+		arg.Column6,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetAdjustedPersonalBestsRow{}
+	for rows.Next() {
+		var i GetAdjustedPersonalBestsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Event,
+			&i.TimeMs,
+			&i.TimingMethod,
+			&i.AdjustedTimeMs,
+			&i.MeetName,
+			&i.MeetDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPersonalBestForEvent = `-- name: GetPersonalBestForEvent :one
 SELECT 
     t.id,
@@ -237,37 +352,58 @@ SELECT DISTINCT ON (t.event)
     t.notes,
     t.created_at,
     t.updated_at,
+    t.timing_method,
+    t.water_temperature_c,
+    t.wetsuit,
     m.name AS meet_name,
-    m.start_date AS meet_date
+    m.start_date AS meet_date,
+    b.time_ms AS base_time_ms
 FROM times t
 JOIN meets m ON m.id = t.meet_id
+JOIN swimmers s ON s.id = t.swimmer_id
+LEFT JOIN world_aquatics_base_times b
+    ON b.event = t.event AND b.gender = s.gender AND b.course_type = m.course_type
 WHERE t.swimmer_id = $1
   AND m.course_type = $2
+  AND ($3::varchar = '' OR t.timing_method = $3)
+  AND ($4::date IS NULL OR COALESCE(t.event_date, m.start_date) <= $4::date)
 ORDER BY t.event, t.time_ms ASC, COALESCE(t.event_date, m.start_date) DESC
 `
 
 type GetPersonalBestsParams struct {
-	SwimmerID  uuid.UUID `json:"swimmer_id"`
-	CourseType string    `json:"course_type"`
+	SwimmerID  uuid.UUID   `json:"swimmer_id"`
+	CourseType string      `json:"course_type"`
+	Column3    string      `json:"column_3"`
+	Column4    pgtype.Date `json:"column_4"`
 }
 
 type GetPersonalBestsRow struct {
-	ID        uuid.UUID   `json:"id"`
-	SwimmerID uuid.UUID   `json:"swimmer_id"`
-	MeetID    uuid.UUID   `json:"meet_id"`
-	Event     string      `json:"event"`
-	TimeMs    int32       `json:"time_ms"`
-	EventDate pgtype.Date `json:"event_date"`
-	Notes     pgtype.Text `json:"notes"`
-	CreatedAt time.Time   `json:"created_at"`
-	UpdatedAt time.Time   `json:"updated_at"`
-	MeetName  string      `json:"meet_name"`
-	MeetDate  pgtype.Date `json:"meet_date"`
+	ID                uuid.UUID      `json:"id"`
+	SwimmerID         uuid.UUID      `json:"swimmer_id"`
+	MeetID            uuid.UUID      `json:"meet_id"`
+	Event             string         `json:"event"`
+	TimeMs            int32          `json:"time_ms"`
+	EventDate         pgtype.Date    `json:"event_date"`
+	Notes             pgtype.Text    `json:"notes"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	TimingMethod      string         `json:"timing_method"`
+	WaterTemperatureC pgtype.Numeric `json:"water_temperature_c"`
+	Wetsuit           pgtype.Bool    `json:"wetsuit"`
+	MeetName          string         `json:"meet_name"`
+	MeetDate          pgtype.Date    `json:"meet_date"`
+	BaseTimeMs        pgtype.Int4    `json:"base_time_ms"`
 }
 
 // Returns the fastest time for each event for a swimmer in a specific course type
+// Optionally restricted to a single timing method
 func (q *Queries) GetPersonalBests(ctx context.Context, arg GetPersonalBestsParams) ([]GetPersonalBestsRow, error) {
-	rows, err := q.db.Query(ctx, getPersonalBests, arg.SwimmerID, arg.CourseType)
+	rows, err := q.db.Query(ctx, getPersonalBests,
+		arg.SwimmerID,
+		arg.CourseType,
+		arg.Column3,
+		arg.Column4,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -285,8 +421,12 @@ func (q *Queries) GetPersonalBests(ctx context.Context, arg GetPersonalBestsPara
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TimingMethod,
+			&i.WaterTemperatureC,
+			&i.Wetsuit,
 			&i.MeetName,
 			&i.MeetDate,
+			&i.BaseTimeMs,
 		); err != nil {
 			return nil, err
 		}
@@ -306,6 +446,9 @@ SELECT
     COALESCE(t.event_date, m.start_date) AS date,
     m.name AS meet_name,
     t.event,
+    t.water_temperature_c,
+    t.wetsuit,
+    b.time_ms AS base_time_ms,
     -- Check if this time is the personal best (fastest time for this event/course)
     (t.time_ms = (
         SELECT MIN(t2.time_ms)
@@ -317,6 +460,9 @@ SELECT
     )) AS is_pb
 FROM times t
 JOIN meets m ON m.id = t.meet_id
+JOIN swimmers s ON s.id = t.swimmer_id
+LEFT JOIN world_aquatics_base_times b
+    ON b.event = t.event AND b.gender = s.gender AND b.course_type = m.course_type
 WHERE t.swimmer_id = $1
   AND m.course_type = $2
   AND t.event = $3
@@ -334,13 +480,16 @@ type GetProgressDataParams struct {
 }
 
 type GetProgressDataRow struct {
-	ID       uuid.UUID   `json:"id"`
-	MeetID   uuid.UUID   `json:"meet_id"`
-	TimeMs   int32       `json:"time_ms"`
-	Date     pgtype.Date `json:"date"`
-	MeetName string      `json:"meet_name"`
-	Event    string      `json:"event"`
-	IsPb     bool        `json:"is_pb"`
+	ID                uuid.UUID      `json:"id"`
+	MeetID            uuid.UUID      `json:"meet_id"`
+	TimeMs            int32          `json:"time_ms"`
+	Date              pgtype.Date    `json:"date"`
+	MeetName          string         `json:"meet_name"`
+	Event             string         `json:"event"`
+	WaterTemperatureC pgtype.Numeric `json:"water_temperature_c"`
+	Wetsuit           pgtype.Bool    `json:"wetsuit"`
+	BaseTimeMs        pgtype.Int4    `json:"base_time_ms"`
+	IsPb              bool           `json:"is_pb"`
 }
 
 // Returns time progression for a specific event over time
@@ -367,6 +516,9 @@ func (q *Queries) GetProgressData(ctx context.Context, arg GetProgressDataParams
 			&i.Date,
 			&i.MeetName,
 			&i.Event,
+			&i.WaterTemperatureC,
+			&i.Wetsuit,
+			&i.BaseTimeMs,
 			&i.IsPb,
 		); err != nil {
 			return nil, err
@@ -389,7 +541,17 @@ SELECT
     t.event_date,
     t.notes, 
     t.created_at, 
-    t.updated_at
+    t.updated_at,
+    t.heat,
+    t.lane,
+    t.heat_place,
+    t.overall_place,
+    t.age_group_place,
+    t.points_earned,
+    t.timing_method,
+    t.reaction_time_ms,
+    t.water_temperature_c,
+    t.wetsuit
 FROM times t
 WHERE t.id = $1
 `
@@ -407,6 +569,16 @@ func (q *Queries) GetTime(ctx context.Context, id uuid.UUID) (Time, error) {
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Heat,
+		&i.Lane,
+		&i.HeatPlace,
+		&i.OverallPlace,
+		&i.AgeGroupPlace,
+		&i.PointsEarned,
+		&i.TimingMethod,
+		&i.ReactionTimeMs,
+		&i.WaterTemperatureC,
+		&i.Wetsuit,
 	)
 	return i, err
 }
@@ -422,31 +594,56 @@ SELECT
     t.notes, 
     t.created_at, 
     t.updated_at,
+    t.heat,
+    t.lane,
+    t.heat_place,
+    t.overall_place,
+    t.age_group_place,
+    t.points_earned,
+    t.timing_method,
+    t.reaction_time_ms,
+    t.water_temperature_c,
+    t.wetsuit,
     m.name AS meet_name,
     m.city AS meet_city,
     m.start_date AS meet_start_date,
     m.end_date AS meet_end_date,
-    m.course_type AS meet_course_type
+    m.course_type AS meet_course_type,
+    b.time_ms AS base_time_ms
 FROM times t
 JOIN meets m ON m.id = t.meet_id
+JOIN swimmers s ON s.id = t.swimmer_id
+LEFT JOIN world_aquatics_base_times b
+    ON b.event = t.event AND b.gender = s.gender AND b.course_type = m.course_type
 WHERE t.id = $1
 `
 
 type GetTimeWithMeetRow struct {
-	ID             uuid.UUID   `json:"id"`
-	SwimmerID      uuid.UUID   `json:"swimmer_id"`
-	MeetID         uuid.UUID   `json:"meet_id"`
-	Event          string      `json:"event"`
-	TimeMs         int32       `json:"time_ms"`
-	EventDate      pgtype.Date `json:"event_date"`
-	Notes          pgtype.Text `json:"notes"`
-	CreatedAt      time.Time   `json:"created_at"`
-	UpdatedAt      time.Time   `json:"updated_at"`
-	MeetName       string      `json:"meet_name"`
-	MeetCity       string      `json:"meet_city"`
-	MeetStartDate  pgtype.Date `json:"meet_start_date"`
-	MeetEndDate    pgtype.Date `json:"meet_end_date"`
-	MeetCourseType string      `json:"meet_course_type"`
+	ID                uuid.UUID      `json:"id"`
+	SwimmerID         uuid.UUID      `json:"swimmer_id"`
+	MeetID            uuid.UUID      `json:"meet_id"`
+	Event             string         `json:"event"`
+	TimeMs            int32          `json:"time_ms"`
+	EventDate         pgtype.Date    `json:"event_date"`
+	Notes             pgtype.Text    `json:"notes"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	Heat              pgtype.Int4    `json:"heat"`
+	Lane              pgtype.Int4    `json:"lane"`
+	HeatPlace         pgtype.Int4    `json:"heat_place"`
+	OverallPlace      pgtype.Int4    `json:"overall_place"`
+	AgeGroupPlace     pgtype.Int4    `json:"age_group_place"`
+	PointsEarned      pgtype.Numeric `json:"points_earned"`
+	TimingMethod      string         `json:"timing_method"`
+	ReactionTimeMs    pgtype.Int4    `json:"reaction_time_ms"`
+	WaterTemperatureC pgtype.Numeric `json:"water_temperature_c"`
+	Wetsuit           pgtype.Bool    `json:"wetsuit"`
+	MeetName          string         `json:"meet_name"`
+	MeetCity          string         `json:"meet_city"`
+	MeetStartDate     pgtype.Date    `json:"meet_start_date"`
+	MeetEndDate       pgtype.Date    `json:"meet_end_date"`
+	MeetCourseType    string         `json:"meet_course_type"`
+	BaseTimeMs        pgtype.Int4    `json:"base_time_ms"`
 }
 
 func (q *Queries) GetTimeWithMeet(ctx context.Context, id uuid.UUID) (GetTimeWithMeetRow, error) {
@@ -462,11 +659,22 @@ func (q *Queries) GetTimeWithMeet(ctx context.Context, id uuid.UUID) (GetTimeWit
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Heat,
+		&i.Lane,
+		&i.HeatPlace,
+		&i.OverallPlace,
+		&i.AgeGroupPlace,
+		&i.PointsEarned,
+		&i.TimingMethod,
+		&i.ReactionTimeMs,
+		&i.WaterTemperatureC,
+		&i.Wetsuit,
 		&i.MeetName,
 		&i.MeetCity,
 		&i.MeetStartDate,
 		&i.MeetEndDate,
 		&i.MeetCourseType,
+		&i.BaseTimeMs,
 	)
 	return i, err
 }
@@ -529,6 +737,194 @@ func (q *Queries) IsPersonalBest(ctx context.Context, arg IsPersonalBestParams)
 	return is_pb, err
 }
 
+const listReactionTimes = `-- name: ListReactionTimes :many
+SELECT
+    t.id,
+    t.event,
+    t.reaction_time_ms::int AS reaction_time_ms,
+    COALESCE(t.event_date, m.start_date) AS date,
+    m.name AS meet_name,
+    m.course_type
+FROM times t
+JOIN meets m ON m.id = t.meet_id
+WHERE t.swimmer_id = $1
+  AND t.reaction_time_ms IS NOT NULL
+  AND ($2::varchar = '' OR m.course_type = $2)
+ORDER BY COALESCE(t.event_date, m.start_date) ASC, t.event
+`
+
+type ListReactionTimesParams struct {
+	SwimmerID uuid.UUID `json:"swimmer_id"`
+	Column2   string    `json:"column_2"`
+}
+
+type ListReactionTimesRow struct {
+	ID             uuid.UUID   `json:"id"`
+	Event          string      `json:"event"`
+	ReactionTimeMs int32       `json:"reaction_time_ms"`
+	Date           pgtype.Date `json:"date"`
+	MeetName       string      `json:"meet_name"`
+	CourseType     string      `json:"course_type"`
+}
+
+// Returns every recorded reaction time for a swimmer in chronological order
+func (q *Queries) ListReactionTimes(ctx context.Context, arg ListReactionTimesParams) ([]ListReactionTimesRow, error) {
+	rows, err := q.db.Query(ctx, listReactionTimes, arg.SwimmerID, arg.Column2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListReactionTimesRow{}
+	for rows.Next() {
+		var i ListReactionTimesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Event,
+			&i.ReactionTimeMs,
+			&i.Date,
+			&i.MeetName,
+			&i.CourseType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTimeHistory = `-- name: ListTimeHistory :many
+SELECT
+    t.id,
+    t.meet_id,
+    t.event,
+    t.time_ms,
+    t.timing_method,
+    COALESCE(t.event_date, m.start_date) AS date,
+    m.name AS meet_name,
+    m.course_type
+FROM times t
+JOIN meets m ON m.id = t.meet_id
+WHERE t.swimmer_id = $1
+  AND ($2::varchar = '' OR m.course_type = $2)
+ORDER BY COALESCE(t.event_date, m.start_date) ASC, t.event, t.time_ms
+`
+
+type ListTimeHistoryParams struct {
+	SwimmerID uuid.UUID `json:"swimmer_id"`
+	Column2   string    `json:"column_2"`
+}
+
+type ListTimeHistoryRow struct {
+	ID           uuid.UUID   `json:"id"`
+	MeetID       uuid.UUID   `json:"meet_id"`
+	Event        string      `json:"event"`
+	TimeMs       int32       `json:"time_ms"`
+	TimingMethod string      `json:"timing_method"`
+	Date         pgtype.Date `json:"date"`
+	MeetName     string      `json:"meet_name"`
+	CourseType   string      `json:"course_type"`
+}
+
+// Returns every time for a swimmer in chronological order
+// Optionally restricted to a single course type
+func (q *Queries) ListTimeHistory(ctx context.Context, arg ListTimeHistoryParams) ([]ListTimeHistoryRow, error) {
+	rows, err := q.db.Query(ctx, listTimeHistory, arg.SwimmerID, arg.Column2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTimeHistoryRow{}
+	for rows.Next() {
+		var i ListTimeHistoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MeetID,
+			&i.Event,
+			&i.TimeMs,
+			&i.TimingMethod,
+			&i.Date,
+			&i.MeetName,
+			&i.CourseType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTimeHistoryForEvents = `-- name: ListTimeHistoryForEvents :many
+SELECT
+    t.id,
+    t.meet_id,
+    t.event,
+    t.time_ms,
+    t.timing_method,
+    COALESCE(t.event_date, m.start_date) AS date,
+    m.name AS meet_name,
+    m.course_type
+FROM times t
+JOIN meets m ON m.id = t.meet_id
+WHERE t.swimmer_id = $1
+  AND t.event = ANY($2::varchar[])
+  AND m.course_type = ANY($3::varchar[])
+ORDER BY COALESCE(t.event_date, m.start_date) ASC, t.event, t.time_ms
+`
+
+type ListTimeHistoryForEventsParams struct {
+	SwimmerID uuid.UUID `json:"swimmer_id"`
+	Column2   []string  `json:"column2"`
+	Column3   []string  `json:"column3"`
+}
+
+type ListTimeHistoryForEventsRow struct {
+	ID           uuid.UUID   `json:"id"`
+	MeetID       uuid.UUID   `json:"meet_id"`
+	Event        string      `json:"event"`
+	TimeMs       int32       `json:"time_ms"`
+	TimingMethod string      `json:"timing_method"`
+	Date         pgtype.Date `json:"date"`
+	MeetName     string      `json:"meet_name"`
+	CourseType   string      `json:"course_type"`
+}
+
+// Returns the times for a swimmer in the given events and course types in
+// chronological order
+func (q *Queries) ListTimeHistoryForEvents(ctx context.Context, arg ListTimeHistoryForEventsParams) ([]ListTimeHistoryForEventsRow, error) {
+	rows, err := q.db.Query(ctx, listTimeHistoryForEvents, arg.SwimmerID, arg.Column2, arg.Column3)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTimeHistoryForEventsRow{}
+	for rows.Next() {
+		var i ListTimeHistoryForEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MeetID,
+			&i.Event,
+			&i.TimeMs,
+			&i.TimingMethod,
+			&i.Date,
+			&i.MeetName,
+			&i.CourseType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTimes = `-- name: ListTimes :many
 SELECT 
     t.id, 
@@ -540,13 +936,27 @@ SELECT
     t.notes, 
     t.created_at, 
     t.updated_at,
+    t.heat,
+    t.lane,
+    t.heat_place,
+    t.overall_place,
+    t.age_group_place,
+    t.points_earned,
+    t.timing_method,
+    t.reaction_time_ms,
+    t.water_temperature_c,
+    t.wetsuit,
     m.name AS meet_name,
     m.city AS meet_city,
     m.start_date AS meet_start_date,
     m.end_date AS meet_end_date,
-    m.course_type AS meet_course_type
+    m.course_type AS meet_course_type,
+    b.time_ms AS base_time_ms
 FROM times t
 JOIN meets m ON m.id = t.meet_id
+JOIN swimmers s ON s.id = t.swimmer_id
+LEFT JOIN world_aquatics_base_times b
+    ON b.event = t.event AND b.gender = s.gender AND b.course_type = m.course_type
 WHERE t.swimmer_id = $1
   AND ($2::varchar = '' OR m.course_type = $2)
   AND ($3::varchar = '' OR t.event = $3)
@@ -565,20 +975,31 @@ type ListTimesParams struct {
 }
 
 type ListTimesRow struct {
-	ID             uuid.UUID   `json:"id"`
-	SwimmerID      uuid.UUID   `json:"swimmer_id"`
-	MeetID         uuid.UUID   `json:"meet_id"`
-	Event          string      `json:"event"`
-	TimeMs         int32       `json:"time_ms"`
-	EventDate      pgtype.Date `json:"event_date"`
-	Notes          pgtype.Text `json:"notes"`
-	CreatedAt      time.Time   `json:"created_at"`
-	UpdatedAt      time.Time   `json:"updated_at"`
-	MeetName       string      `json:"meet_name"`
-	MeetCity       string      `json:"meet_city"`
-	MeetStartDate  pgtype.Date `json:"meet_start_date"`
-	MeetEndDate    pgtype.Date `json:"meet_end_date"`
-	MeetCourseType string      `json:"meet_course_type"`
+	ID                uuid.UUID      `json:"id"`
+	SwimmerID         uuid.UUID      `json:"swimmer_id"`
+	MeetID            uuid.UUID      `json:"meet_id"`
+	Event             string         `json:"event"`
+	TimeMs            int32          `json:"time_ms"`
+	EventDate         pgtype.Date    `json:"event_date"`
+	Notes             pgtype.Text    `json:"notes"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	Heat              pgtype.Int4    `json:"heat"`
+	Lane              pgtype.Int4    `json:"lane"`
+	HeatPlace         pgtype.Int4    `json:"heat_place"`
+	OverallPlace      pgtype.Int4    `json:"overall_place"`
+	AgeGroupPlace     pgtype.Int4    `json:"age_group_place"`
+	PointsEarned      pgtype.Numeric `json:"points_earned"`
+	TimingMethod      string         `json:"timing_method"`
+	ReactionTimeMs    pgtype.Int4    `json:"reaction_time_ms"`
+	WaterTemperatureC pgtype.Numeric `json:"water_temperature_c"`
+	Wetsuit           pgtype.Bool    `json:"wetsuit"`
+	MeetName          string         `json:"meet_name"`
+	MeetCity          string         `json:"meet_city"`
+	MeetStartDate     pgtype.Date    `json:"meet_start_date"`
+	MeetEndDate       pgtype.Date    `json:"meet_end_date"`
+	MeetCourseType    string         `json:"meet_course_type"`
+	BaseTimeMs        pgtype.Int4    `json:"base_time_ms"`
 }
 
 func (q *Queries) ListTimes(ctx context.Context, arg ListTimesParams) ([]ListTimesRow, error) {
@@ -607,11 +1028,22 @@ func (q *Queries) ListTimes(ctx context.Context, arg ListTimesParams) ([]ListTim
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Heat,
+			&i.Lane,
+			&i.HeatPlace,
+			&i.OverallPlace,
+			&i.AgeGroupPlace,
+			&i.PointsEarned,
+			&i.TimingMethod,
+			&i.ReactionTimeMs,
+			&i.WaterTemperatureC,
+			&i.Wetsuit,
 			&i.MeetName,
 			&i.MeetCity,
 			&i.MeetStartDate,
 			&i.MeetEndDate,
 			&i.MeetCourseType,
+			&i.BaseTimeMs,
 		); err != nil {
 			return nil, err
 		}
@@ -633,7 +1065,17 @@ SELECT
     t.event_date,
     t.notes, 
     t.created_at, 
-    t.updated_at
+    t.updated_at,
+    t.heat,
+    t.lane,
+    t.heat_place,
+    t.overall_place,
+    t.age_group_place,
+    t.points_earned,
+    t.timing_method,
+    t.reaction_time_ms,
+    t.water_temperature_c,
+    t.wetsuit
 FROM times t
 WHERE t.meet_id = $1
 ORDER BY COALESCE(t.event_date, (SELECT start_date FROM meets WHERE id = t.meet_id)), t.event, t.time_ms
@@ -658,6 +1100,16 @@ func (q *Queries) ListTimesByMeet(ctx context.Context, meetID uuid.UUID) ([]Time
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Heat,
+			&i.Lane,
+			&i.HeatPlace,
+			&i.OverallPlace,
+			&i.AgeGroupPlace,
+			&i.PointsEarned,
+			&i.TimingMethod,
+			&i.ReactionTimeMs,
+			&i.WaterTemperatureC,
+			&i.Wetsuit,
 		); err != nil {
 			return nil, err
 		}
@@ -671,18 +1123,32 @@ func (q *Queries) ListTimesByMeet(ctx context.Context, meetID uuid.UUID) ([]Time
 
 const updateTime = `-- name: UpdateTime :one
 UPDATE times
-SET meet_id = $2, event = $3, time_ms = $4, event_date = $5, notes = $6
+SET meet_id = $2, event = $3, time_ms = $4, event_date = $5, notes = $6,
+    heat = $7, lane = $8, heat_place = $9, overall_place = $10, age_group_place = $11, points_earned = $12,
+    timing_method = $13, reaction_time_ms = $14, water_temperature_c = $15, wetsuit = $16
 WHERE id = $1
-RETURNING id, swimmer_id, meet_id, event, time_ms, event_date, notes, created_at, updated_at
+RETURNING id, swimmer_id, meet_id, event, time_ms, event_date, notes, created_at, updated_at,
+    heat, lane, heat_place, overall_place, age_group_place, points_earned, timing_method,
+    reaction_time_ms, water_temperature_c, wetsuit
 `
 
 type UpdateTimeParams struct {
-	ID        uuid.UUID   `json:"id"`
-	MeetID    uuid.UUID   `json:"meet_id"`
-	Event     string      `json:"event"`
-	TimeMs    int32       `json:"time_ms"`
-	EventDate pgtype.Date `json:"event_date"`
-	Notes     pgtype.Text `json:"notes"`
+	ID                uuid.UUID      `json:"id"`
+	MeetID            uuid.UUID      `json:"meet_id"`
+	Event             string         `json:"event"`
+	TimeMs            int32          `json:"time_ms"`
+	EventDate         pgtype.Date    `json:"event_date"`
+	Notes             pgtype.Text    `json:"notes"`
+	Heat              pgtype.Int4    `json:"heat"`
+	Lane              pgtype.Int4    `json:"lane"`
+	HeatPlace         pgtype.Int4    `json:"heat_place"`
+	OverallPlace      pgtype.Int4    `json:"overall_place"`
+	AgeGroupPlace     pgtype.Int4    `json:"age_group_place"`
+	PointsEarned      pgtype.Numeric `json:"points_earned"`
+	TimingMethod      string         `json:"timing_method"`
+	ReactionTimeMs    pgtype.Int4    `json:"reaction_time_ms"`
+	WaterTemperatureC pgtype.Numeric `json:"water_temperature_c"`
+	Wetsuit           pgtype.Bool    `json:"wetsuit"`
 }
 
 func (q *Queries) UpdateTime(ctx context.Context, arg UpdateTimeParams) (Time, error) {
@@ -693,6 +1159,16 @@ func (q *Queries) UpdateTime(ctx context.Context, arg UpdateTimeParams) (Time, e
 		arg.TimeMs,
 		arg.EventDate,
 		arg.Notes,
+		arg.Heat,
+		arg.Lane,
+		arg.HeatPlace,
+		arg.OverallPlace,
+		arg.AgeGroupPlace,
+		arg.PointsEarned,
+		arg.TimingMethod,
+		arg.ReactionTimeMs,
+		arg.WaterTemperatureC,
+		arg.Wetsuit,
 	)
 	var i Time
 	err := row.Scan(
@@ -705,6 +1181,16 @@ func (q *Queries) UpdateTime(ctx context.Context, arg UpdateTimeParams) (Time, e
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Heat,
+		&i.Lane,
+		&i.HeatPlace,
+		&i.OverallPlace,
+		&i.AgeGroupPlace,
+		&i.PointsEarned,
+		&i.TimingMethod,
+		&i.ReactionTimeMs,
+		&i.WaterTemperatureC,
+		&i.Wetsuit,
 	)
 	return i, err
 }
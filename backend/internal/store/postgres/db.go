@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bpg/swimstats/backend/internal/store/db"
 )
 
 // Common errors.
@@ -18,6 +21,29 @@ var (
 	ErrDuplicateEvent = errors.New("event already exists for this meet")
 )
 
+// TxBeginner starts database transactions. *pgxpool.Pool implements it.
+type TxBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// inTx runs fn with queries bound to a new transaction. The transaction is
+// committed when fn succeeds and rolled back otherwise.
+func inTx(ctx context.Context, pool TxBeginner, queries *db.Queries, fn func(q *db.Queries) error) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(queries.WithTx(tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
 // Config holds database connection configuration.
 type Config struct {
 	Host            string
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// EventRepository provides event catalogue data access.
+type EventRepository struct {
+	queries *db.Queries
+}
+
+// NewEventRepository creates a new event repository.
+func NewEventRepository(queries *db.Queries) *EventRepository {
+	return &EventRepository{queries: queries}
+}
+
+// List lists all catalogued events in display order.
+func (r *EventRepository) List(ctx context.Context) ([]db.Event, error) {
+	events, err := r.queries.ListEvents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list events: %w", err)
+	}
+	return events, nil
+}
+
+// Create adds an event to the catalogue.
+func (r *EventRepository) Create(ctx context.Context, params db.CreateEventParams) (*db.Event, error) {
+	event, err := r.queries.CreateEvent(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("create event: %w", err)
+	}
+	return &event, nil
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// GoalRepository provides goal data access.
+type GoalRepository struct {
+	queries *db.Queries
+}
+
+// NewGoalRepository creates a new goal repository.
+func NewGoalRepository(queries *db.Queries) *GoalRepository {
+	return &GoalRepository{queries: queries}
+}
+
+// Get retrieves a goal by ID.
+func (r *GoalRepository) Get(ctx context.Context, id uuid.UUID) (*db.Goal, error) {
+	goal, err := r.queries.GetGoal(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get goal: %w", err)
+	}
+	return &goal, nil
+}
+
+// List lists a swimmer's goals, nearest deadline first.
+func (r *GoalRepository) List(ctx context.Context, swimmerID uuid.UUID) ([]db.Goal, error) {
+	goals, err := r.queries.ListGoals(ctx, swimmerID)
+	if err != nil {
+		return nil, fmt.Errorf("list goals: %w", err)
+	}
+	return goals, nil
+}
+
+// Create creates a new goal.
+func (r *GoalRepository) Create(ctx context.Context, params db.CreateGoalParams) (*db.Goal, error) {
+	goal, err := r.queries.CreateGoal(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("create goal: %w", err)
+	}
+	return &goal, nil
+}
+
+// Update updates an existing goal.
+func (r *GoalRepository) Update(ctx context.Context, params db.UpdateGoalParams) (*db.Goal, error) {
+	goal, err := r.queries.UpdateGoal(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("update goal: %w", err)
+	}
+	return &goal, nil
+}
+
+// UpdateStatus records a goal's status and the swim that achieved it.
+func (r *GoalRepository) UpdateStatus(ctx context.Context, params db.UpdateGoalStatusParams) error {
+	if err := r.queries.UpdateGoalStatus(ctx, params); err != nil {
+		return fmt.Errorf("update goal status: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a goal.
+func (r *GoalRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteGoal(ctx, id); err != nil {
+		return fmt.Errorf("delete goal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// LadderRepository provides standard ladder data access.
+type LadderRepository struct {
+	queries *db.Queries
+}
+
+// NewLadderRepository creates a new ladder repository.
+func NewLadderRepository(queries *db.Queries) *LadderRepository {
+	return &LadderRepository{queries: queries}
+}
+
+// Get retrieves a ladder by ID.
+func (r *LadderRepository) Get(ctx context.Context, id uuid.UUID) (*db.StandardLadder, error) {
+	ladder, err := r.queries.GetLadder(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get ladder: %w", err)
+	}
+	return &ladder, nil
+}
+
+// List lists ladders, optionally filtered by course type.
+func (r *LadderRepository) List(ctx context.Context, courseType *string) ([]db.StandardLadder, error) {
+	ct := ""
+	if courseType != nil {
+		ct = *courseType
+	}
+
+	ladders, err := r.queries.ListLadders(ctx, ct)
+	if err != nil {
+		return nil, fmt.Errorf("list ladders: %w", err)
+	}
+	return ladders, nil
+}
+
+// Create creates a new ladder.
+func (r *LadderRepository) Create(ctx context.Context, params db.CreateLadderParams) (*db.StandardLadder, error) {
+	ladder, err := r.queries.CreateLadder(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("create ladder: %w", err)
+	}
+	return &ladder, nil
+}
+
+// Update updates an existing ladder.
+func (r *LadderRepository) Update(ctx context.Context, params db.UpdateLadderParams) (*db.StandardLadder, error) {
+	ladder, err := r.queries.UpdateLadder(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("update ladder: %w", err)
+	}
+	return &ladder, nil
+}
+
+// Delete deletes a ladder and its tiers.
+func (r *LadderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteLadder(ctx, id); err != nil {
+		return fmt.Errorf("delete ladder: %w", err)
+	}
+	return nil
+}
+
+// NameExists checks if a ladder name is already taken (excluding the given ID).
+func (r *LadderRepository) NameExists(ctx context.Context, name string, excludeID uuid.UUID) (bool, error) {
+	exists, err := r.queries.LadderNameExists(ctx, db.LadderNameExistsParams{
+		Name: name,
+		ID:   excludeID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("check ladder name exists: %w", err)
+	}
+	return exists, nil
+}
+
+// ListTiers lists the tiers of a ladder from the lowest to the highest.
+func (r *LadderRepository) ListTiers(ctx context.Context, ladderID uuid.UUID) ([]db.ListLadderTiersRow, error) {
+	tiers, err := r.queries.ListLadderTiers(ctx, ladderID)
+	if err != nil {
+		return nil, fmt.Errorf("list ladder tiers: %w", err)
+	}
+	return tiers, nil
+}
+
+// CreateTier adds a tier to a ladder.
+func (r *LadderRepository) CreateTier(ctx context.Context, params db.CreateLadderTierParams) error {
+	if err := r.queries.CreateLadderTier(ctx, params); err != nil {
+		return fmt.Errorf("create ladder tier: %w", err)
+	}
+	return nil
+}
+
+// DeleteTiers deletes all tiers of a ladder.
+func (r *LadderRepository) DeleteTiers(ctx context.Context, ladderID uuid.UUID) error {
+	if err := r.queries.DeleteLadderTiers(ctx, ladderID); err != nil {
+		return fmt.Errorf("delete ladder tiers: %w", err)
+	}
+	return nil
+}
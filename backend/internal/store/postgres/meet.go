@@ -45,6 +45,15 @@ func (r *MeetRepository) GetWithTimeCount(ctx context.Context, id uuid.UUID) (*d
 	return &meet, nil
 }
 
+// GetResultSummary retrieves medal and top-8 counts for a meet.
+func (r *MeetRepository) GetResultSummary(ctx context.Context, id uuid.UUID) (*db.GetMeetResultSummaryRow, error) {
+	summary, err := r.queries.GetMeetResultSummary(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get meet result summary: %w", err)
+	}
+	return &summary, nil
+}
+
 // ListMeetsParams contains parameters for listing meets.
 type ListMeetsParams struct {
 	CourseType *string
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TextOrNull returns s as a text value, or NULL when it is empty.
+func TextOrNull(s string) pgtype.Text {
+	if s == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: s, Valid: true}
+}
+
+// DateOrNull parses a YYYY-MM-DD date, or returns NULL when s is empty.
+// Callers validate the date beforehand.
+func DateOrNull(s string) pgtype.Date {
+	if s == "" {
+		return pgtype.Date{}
+	}
+	t, _ := time.Parse("2006-01-02", s)
+	return pgtype.Date{Time: t, Valid: true}
+}
+
+// IntOrNull returns i as an integer value, or NULL when it is nil.
+func IntOrNull(i *int) pgtype.Int4 {
+	if i == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*i), Valid: true}
+}
+
+// UUIDOrNull returns id as a UUID value, or NULL when it is nil.
+func UUIDOrNull(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
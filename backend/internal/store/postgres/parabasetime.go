@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// ParaBaseTimeRepository provides World Para Swimming base time data access.
+type ParaBaseTimeRepository struct {
+	queries *db.Queries
+	pool    TxBeginner
+}
+
+// NewParaBaseTimeRepository creates a new para base time repository.
+func NewParaBaseTimeRepository(queries *db.Queries, pool TxBeginner) *ParaBaseTimeRepository {
+	return &ParaBaseTimeRepository{queries: queries, pool: pool}
+}
+
+// List lists base times, optionally filtered by course type and gender.
+func (r *ParaBaseTimeRepository) List(ctx context.Context, courseType, gender *string) ([]db.ParaBaseTime, error) {
+	ct := ""
+	if courseType != nil {
+		ct = *courseType
+	}
+	g := ""
+	if gender != nil {
+		g = *gender
+	}
+
+	times, err := r.queries.ListParaBaseTimes(ctx, db.ListParaBaseTimesParams{
+		Column1: ct,
+		Column2: g,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list para base times: %w", err)
+	}
+	return times, nil
+}
+
+// ReplaceTable replaces all base times for a course type and gender in one
+// transaction, so a failed import leaves the existing table in place.
+func (r *ParaBaseTimeRepository) ReplaceTable(ctx context.Context, courseType, gender string, params []db.UpsertParaBaseTimeParams) ([]db.ParaBaseTime, error) {
+	times := make([]db.ParaBaseTime, 0, len(params))
+	err := inTx(ctx, r.pool, r.queries, func(q *db.Queries) error {
+		if err := q.DeleteParaBaseTimes(ctx, db.DeleteParaBaseTimesParams{
+			CourseType: courseType,
+			Gender:     gender,
+		}); err != nil {
+			return fmt.Errorf("delete para base times: %w", err)
+		}
+		for _, p := range params {
+			bt, err := q.UpsertParaBaseTime(ctx, p)
+			if err != nil {
+				return fmt.Errorf("upsert para base time: %w", err)
+			}
+			times = append(times, bt)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return times, nil
+}
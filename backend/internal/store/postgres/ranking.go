@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// RankingRepository provides ranking snapshot data access.
+type RankingRepository struct {
+	queries *db.Queries
+}
+
+// NewRankingRepository creates a new ranking repository.
+func NewRankingRepository(queries *db.Queries) *RankingRepository {
+	return &RankingRepository{queries: queries}
+}
+
+// GetSnapshot retrieves a ranking snapshot by ID.
+func (r *RankingRepository) GetSnapshot(ctx context.Context, id uuid.UUID) (*db.RankingSnapshot, error) {
+	snapshot, err := r.queries.GetRankingSnapshot(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get ranking snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// GetSnapshotByKey retrieves the ranking snapshot of a source for a season,
+// event, course type, gender and age group.
+func (r *RankingRepository) GetSnapshotByKey(ctx context.Context, params db.GetRankingSnapshotByKeyParams) (*db.RankingSnapshot, error) {
+	snapshot, err := r.queries.GetRankingSnapshotByKey(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get ranking snapshot by key: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// ListSnapshotsParams contains parameters for listing ranking snapshots.
+// Empty fields are not filtered on.
+type ListSnapshotsParams struct {
+	Season     string
+	CourseType string
+	Event      string
+	Gender     string
+	AgeGroup   string
+}
+
+// ListSnapshots lists ranking snapshots with their entry counts.
+func (r *RankingRepository) ListSnapshots(ctx context.Context, params ListSnapshotsParams) ([]db.ListRankingSnapshotsRow, error) {
+	snapshots, err := r.queries.ListRankingSnapshots(ctx, db.ListRankingSnapshotsParams{
+		Column1: params.Season,
+		Column2: params.CourseType,
+		Column3: params.Event,
+		Column4: params.Gender,
+		Column5: params.AgeGroup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list ranking snapshots: %w", err)
+	}
+	return snapshots, nil
+}
+
+// CreateSnapshot creates a new ranking snapshot.
+func (r *RankingRepository) CreateSnapshot(ctx context.Context, params db.CreateRankingSnapshotParams) (*db.RankingSnapshot, error) {
+	snapshot, err := r.queries.CreateRankingSnapshot(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("create ranking snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// UpdateSnapshot updates the capture date of a ranking snapshot.
+func (r *RankingRepository) UpdateSnapshot(ctx context.Context, params db.UpdateRankingSnapshotParams) (*db.RankingSnapshot, error) {
+	snapshot, err := r.queries.UpdateRankingSnapshot(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("update ranking snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// DeleteSnapshot deletes a ranking snapshot and its entries.
+func (r *RankingRepository) DeleteSnapshot(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteRankingSnapshot(ctx, id); err != nil {
+		return fmt.Errorf("delete ranking snapshot: %w", err)
+	}
+	return nil
+}
+
+// CreateEntry adds an entry to a ranking snapshot.
+func (r *RankingRepository) CreateEntry(ctx context.Context, params db.CreateRankingEntryParams) (*db.RankingEntry, error) {
+	entry, err := r.queries.CreateRankingEntry(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("create ranking entry: %w", err)
+	}
+	return &entry, nil
+}
+
+// DeleteEntries deletes every entry in a ranking snapshot.
+func (r *RankingRepository) DeleteEntries(ctx context.Context, snapshotID uuid.UUID) error {
+	if err := r.queries.DeleteRankingEntriesBySnapshot(ctx, snapshotID); err != nil {
+		return fmt.Errorf("delete ranking entries: %w", err)
+	}
+	return nil
+}
+
+// ListEntries lists the entries of a ranking snapshot, fastest first.
+func (r *RankingRepository) ListEntries(ctx context.Context, snapshotID uuid.UUID) ([]db.RankingEntry, error) {
+	entries, err := r.queries.ListRankingEntries(ctx, snapshotID)
+	if err != nil {
+		return nil, fmt.Errorf("list ranking entries: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// RecordRepository provides record table data access.
+type RecordRepository struct {
+	queries *db.Queries
+	pool    TxBeginner
+}
+
+// NewRecordRepository creates a new record repository.
+func NewRecordRepository(queries *db.Queries, pool TxBeginner) *RecordRepository {
+	return &RecordRepository{queries: queries, pool: pool}
+}
+
+// GetTable retrieves a record table by ID.
+func (r *RecordRepository) GetTable(ctx context.Context, id uuid.UUID) (*db.RecordTable, error) {
+	table, err := r.queries.GetRecordTable(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get record table: %w", err)
+	}
+	return &table, nil
+}
+
+// ListTables lists record tables with their record counts, club tables first.
+func (r *RecordRepository) ListTables(ctx context.Context) ([]db.ListRecordTablesRow, error) {
+	tables, err := r.queries.ListRecordTables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list record tables: %w", err)
+	}
+	return tables, nil
+}
+
+// ReplaceTable creates the record table with the given name, or updates the
+// existing one and replaces its records, in one transaction so a failed
+// import leaves the existing table in place. It reports whether a table was
+// replaced.
+func (r *RecordRepository) ReplaceTable(ctx context.Context, table db.CreateRecordTableParams, records []db.CreateRecordParams) (*db.RecordTable, bool, error) {
+	var saved db.RecordTable
+	replaced := false
+	err := inTx(ctx, r.pool, r.queries, func(q *db.Queries) error {
+		existing, err := q.GetRecordTableByName(ctx, table.Name)
+		switch {
+		case err == nil:
+			saved, err = q.UpdateRecordTable(ctx, db.UpdateRecordTableParams{
+				ID:          existing.ID,
+				Scope:       table.Scope,
+				Description: table.Description,
+			})
+			if err != nil {
+				return fmt.Errorf("update record table: %w", err)
+			}
+			if err := q.DeleteRecordsByTable(ctx, saved.ID); err != nil {
+				return fmt.Errorf("delete records: %w", err)
+			}
+			replaced = true
+		case errors.Is(err, pgx.ErrNoRows):
+			saved, err = q.CreateRecordTable(ctx, table)
+			if err != nil {
+				return fmt.Errorf("create record table: %w", err)
+			}
+		default:
+			return fmt.Errorf("get record table by name: %w", err)
+		}
+
+		for _, params := range records {
+			params.RecordTableID = saved.ID
+			if _, err := q.CreateRecord(ctx, params); err != nil {
+				return fmt.Errorf("create record: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &saved, replaced, nil
+}
+
+// DeleteTable deletes a record table and its records.
+func (r *RecordRepository) DeleteTable(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteRecordTable(ctx, id); err != nil {
+		return fmt.Errorf("delete record table: %w", err)
+	}
+	return nil
+}
+
+// ListRecords lists the records in a table.
+func (r *RecordRepository) ListRecords(ctx context.Context, tableID uuid.UUID) ([]db.Record, error) {
+	records, err := r.queries.ListRecordsByTable(ctx, tableID)
+	if err != nil {
+		return nil, fmt.Errorf("list records: %w", err)
+	}
+	return records, nil
+}
+
+// ApplicableRecordsParams contains parameters for listing the records that
+// apply to a swimmer.
+type ApplicableRecordsParams struct {
+	Gender     string
+	CourseType *string
+	Event      *string
+	TableID    *uuid.UUID
+}
+
+// ListApplicable lists the records of the swimmer's gender and mixed records
+// with optional filtering.
+func (r *RecordRepository) ListApplicable(ctx context.Context, params ApplicableRecordsParams) ([]db.ListApplicableRecordsRow, error) {
+	courseType := ""
+	event := ""
+	tableID := uuid.Nil
+
+	if params.CourseType != nil {
+		courseType = *params.CourseType
+	}
+	if params.Event != nil {
+		event = *params.Event
+	}
+	if params.TableID != nil {
+		tableID = *params.TableID
+	}
+
+	records, err := r.queries.ListApplicableRecords(ctx, db.ListApplicableRecordsParams{
+		Gender:  params.Gender,
+		Column2: courseType,
+		Column3: event,
+		Column4: tableID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list applicable records: %w", err)
+	}
+	return records, nil
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// ReferenceRepository provides reference swimmer data access.
+type ReferenceRepository struct {
+	queries *db.Queries
+	pool    TxBeginner
+}
+
+// NewReferenceRepository creates a new reference swimmer repository.
+func NewReferenceRepository(queries *db.Queries, pool TxBeginner) *ReferenceRepository {
+	return &ReferenceRepository{queries: queries, pool: pool}
+}
+
+// GetSwimmer retrieves a reference swimmer by ID.
+func (r *ReferenceRepository) GetSwimmer(ctx context.Context, id uuid.UUID) (*db.ReferenceSwimmer, error) {
+	swimmer, err := r.queries.GetReferenceSwimmer(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get reference swimmer: %w", err)
+	}
+	return &swimmer, nil
+}
+
+// ListSwimmers lists reference swimmers with their time counts.
+func (r *ReferenceRepository) ListSwimmers(ctx context.Context) ([]db.ListReferenceSwimmersRow, error) {
+	swimmers, err := r.queries.ListReferenceSwimmers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list reference swimmers: %w", err)
+	}
+	return swimmers, nil
+}
+
+// ReplaceSwimmer creates the reference swimmer with the given name, or
+// updates the existing one and replaces their times, in one transaction so a
+// failed import leaves the existing swimmer in place. It reports whether a
+// swimmer was replaced.
+func (r *ReferenceRepository) ReplaceSwimmer(ctx context.Context, swimmer db.CreateReferenceSwimmerParams, times []db.CreateReferenceTimeParams) (*db.ReferenceSwimmer, bool, error) {
+	var saved db.ReferenceSwimmer
+	replaced := false
+	err := inTx(ctx, r.pool, r.queries, func(q *db.Queries) error {
+		existing, err := q.GetReferenceSwimmerByName(ctx, swimmer.Name)
+		switch {
+		case err == nil:
+			saved, err = q.UpdateReferenceSwimmer(ctx, db.UpdateReferenceSwimmerParams{
+				ID:          existing.ID,
+				BirthDate:   swimmer.BirthDate,
+				Gender:      swimmer.Gender,
+				Description: swimmer.Description,
+			})
+			if err != nil {
+				return fmt.Errorf("update reference swimmer: %w", err)
+			}
+			if err := q.DeleteReferenceTimesBySwimmer(ctx, saved.ID); err != nil {
+				return fmt.Errorf("delete reference times: %w", err)
+			}
+			replaced = true
+		case errors.Is(err, pgx.ErrNoRows):
+			saved, err = q.CreateReferenceSwimmer(ctx, swimmer)
+			if err != nil {
+				return fmt.Errorf("create reference swimmer: %w", err)
+			}
+		default:
+			return fmt.Errorf("get reference swimmer by name: %w", err)
+		}
+
+		for _, params := range times {
+			params.ReferenceSwimmerID = saved.ID
+			if _, err := q.CreateReferenceTime(ctx, params); err != nil {
+				return fmt.Errorf("create reference time: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &saved, replaced, nil
+}
+
+// DeleteSwimmer deletes a reference swimmer and their times.
+func (r *ReferenceRepository) DeleteSwimmer(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteReferenceSwimmer(ctx, id); err != nil {
+		return fmt.Errorf("delete reference swimmer: %w", err)
+	}
+	return nil
+}
+
+// ListTimes lists a reference swimmer's times in chronological order,
+// optionally for a single course type.
+func (r *ReferenceRepository) ListTimes(ctx context.Context, swimmerID uuid.UUID, courseType *string) ([]db.ReferenceTime, error) {
+	ct := ""
+	if courseType != nil {
+		ct = *courseType
+	}
+
+	times, err := r.queries.ListReferenceTimes(ctx, db.ListReferenceTimesParams{
+		ReferenceSwimmerID: swimmerID,
+		Column2:            ct,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list reference times: %w", err)
+	}
+	return times, nil
+}
+
+// GetPersonalBests retrieves the fastest time per event for a reference
+// swimmer in a course type.
+func (r *ReferenceRepository) GetPersonalBests(ctx context.Context, swimmerID uuid.UUID, courseType string) ([]db.ReferenceTime, error) {
+	pbs, err := r.queries.GetReferencePersonalBests(ctx, db.GetReferencePersonalBestsParams{
+		ReferenceSwimmerID: swimmerID,
+		CourseType:         courseType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get reference personal bests: %w", err)
+	}
+	return pbs, nil
+}
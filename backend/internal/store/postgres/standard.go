@@ -37,6 +37,9 @@ func (r *StandardRepository) Get(ctx context.Context, id uuid.UUID) (*db.TimeSta
 type ListStandardsParams struct {
 	CourseType *string
 	Gender     *string
+	Category   *string
+	// SwimmerGender limits the list to standards that apply to a swimmer of that gender.
+	SwimmerGender *string
 }
 
 // List lists standards with optional filtering.
@@ -51,9 +54,21 @@ func (r *StandardRepository) List(ctx context.Context, params ListStandardsParam
 		gender = *params.Gender
 	}
 
+	category := ""
+	if params.Category != nil {
+		category = *params.Category
+	}
+
+	swimmerGender := ""
+	if params.SwimmerGender != nil {
+		swimmerGender = *params.SwimmerGender
+	}
+
 	standards, err := r.queries.ListStandards(ctx, db.ListStandardsParams{
 		Column1: courseType,
 		Column2: gender,
+		Column3: category,
+		Column4: swimmerGender,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("list standards: %w", err)
@@ -31,13 +31,19 @@ func (r *SwimmerRepository) Get(ctx context.Context, id uuid.UUID) (*db.Swimmer,
 		return nil, fmt.Errorf("get swimmer: %w", err)
 	}
 	return &db.Swimmer{
-		ID:               row.ID,
-		Name:             row.Name,
-		BirthDate:        row.BirthDate,
-		Gender:           row.Gender,
-		ThresholdPercent: row.ThresholdPercent,
-		CreatedAt:        row.CreatedAt,
-		UpdatedAt:        row.UpdatedAt,
+		ID:                        row.ID,
+		Name:                      row.Name,
+		BirthDate:                 row.BirthDate,
+		Gender:                    row.Gender,
+		ThresholdPercent:          row.ThresholdPercent,
+		ManualAdjustmentMs:        row.ManualAdjustmentMs,
+		SemiAutomaticAdjustmentMs: row.SemiAutomaticAdjustmentMs,
+		Category:                  row.Category,
+		SportClassS:               row.SportClassS,
+		SportClassSb:              row.SportClassSb,
+		SportClassSm:              row.SportClassSm,
+		CreatedAt:                 row.CreatedAt,
+		UpdatedAt:                 row.UpdatedAt,
 	}, nil
 }
 
@@ -51,13 +57,19 @@ func (r *SwimmerRepository) GetFirst(ctx context.Context) (*db.Swimmer, error) {
 		return nil, fmt.Errorf("get first swimmer: %w", err)
 	}
 	return &db.Swimmer{
-		ID:               row.ID,
-		Name:             row.Name,
-		BirthDate:        row.BirthDate,
-		Gender:           row.Gender,
-		ThresholdPercent: row.ThresholdPercent,
-		CreatedAt:        row.CreatedAt,
-		UpdatedAt:        row.UpdatedAt,
+		ID:                        row.ID,
+		Name:                      row.Name,
+		BirthDate:                 row.BirthDate,
+		Gender:                    row.Gender,
+		ThresholdPercent:          row.ThresholdPercent,
+		ManualAdjustmentMs:        row.ManualAdjustmentMs,
+		SemiAutomaticAdjustmentMs: row.SemiAutomaticAdjustmentMs,
+		Category:                  row.Category,
+		SportClassS:               row.SportClassS,
+		SportClassSb:              row.SportClassSb,
+		SportClassSm:              row.SportClassSm,
+		CreatedAt:                 row.CreatedAt,
+		UpdatedAt:                 row.UpdatedAt,
 	}, nil
 }
 
@@ -68,13 +80,19 @@ func (r *SwimmerRepository) Create(ctx context.Context, params db.CreateSwimmerP
 		return nil, fmt.Errorf("create swimmer: %w", err)
 	}
 	return &db.Swimmer{
-		ID:               row.ID,
-		Name:             row.Name,
-		BirthDate:        row.BirthDate,
-		Gender:           row.Gender,
-		ThresholdPercent: row.ThresholdPercent,
-		CreatedAt:        row.CreatedAt,
-		UpdatedAt:        row.UpdatedAt,
+		ID:                        row.ID,
+		Name:                      row.Name,
+		BirthDate:                 row.BirthDate,
+		Gender:                    row.Gender,
+		ThresholdPercent:          row.ThresholdPercent,
+		ManualAdjustmentMs:        row.ManualAdjustmentMs,
+		SemiAutomaticAdjustmentMs: row.SemiAutomaticAdjustmentMs,
+		Category:                  row.Category,
+		SportClassS:               row.SportClassS,
+		SportClassSb:              row.SportClassSb,
+		SportClassSm:              row.SportClassSm,
+		CreatedAt:                 row.CreatedAt,
+		UpdatedAt:                 row.UpdatedAt,
 	}, nil
 }
 
@@ -88,13 +106,19 @@ func (r *SwimmerRepository) Update(ctx context.Context, params db.UpdateSwimmerP
 		return nil, fmt.Errorf("update swimmer: %w", err)
 	}
 	return &db.Swimmer{
-		ID:               row.ID,
-		Name:             row.Name,
-		BirthDate:        row.BirthDate,
-		Gender:           row.Gender,
-		ThresholdPercent: row.ThresholdPercent,
-		CreatedAt:        row.CreatedAt,
-		UpdatedAt:        row.UpdatedAt,
+		ID:                        row.ID,
+		Name:                      row.Name,
+		BirthDate:                 row.BirthDate,
+		Gender:                    row.Gender,
+		ThresholdPercent:          row.ThresholdPercent,
+		ManualAdjustmentMs:        row.ManualAdjustmentMs,
+		SemiAutomaticAdjustmentMs: row.SemiAutomaticAdjustmentMs,
+		Category:                  row.Category,
+		SportClassS:               row.SportClassS,
+		SportClassSb:              row.SportClassSb,
+		SportClassSm:              row.SportClassSm,
+		CreatedAt:                 row.CreatedAt,
+		UpdatedAt:                 row.UpdatedAt,
 	}, nil
 }
 
@@ -115,13 +139,19 @@ func (r *SwimmerRepository) List(ctx context.Context) ([]db.Swimmer, error) {
 	swimmers := make([]db.Swimmer, len(rows))
 	for i, row := range rows {
 		swimmers[i] = db.Swimmer{
-			ID:               row.ID,
-			Name:             row.Name,
-			BirthDate:        row.BirthDate,
-			Gender:           row.Gender,
-			ThresholdPercent: row.ThresholdPercent,
-			CreatedAt:        row.CreatedAt,
-			UpdatedAt:        row.UpdatedAt,
+			ID:                        row.ID,
+			Name:                      row.Name,
+			BirthDate:                 row.BirthDate,
+			Gender:                    row.Gender,
+			ThresholdPercent:          row.ThresholdPercent,
+			ManualAdjustmentMs:        row.ManualAdjustmentMs,
+			SemiAutomaticAdjustmentMs: row.SemiAutomaticAdjustmentMs,
+			Category:                  row.Category,
+			SportClassS:               row.SportClassS,
+			SportClassSb:              row.SportClassSb,
+			SportClassSm:              row.SportClassSm,
+			CreatedAt:                 row.CreatedAt,
+			UpdatedAt:                 row.UpdatedAt,
 		}
 	}
 	return swimmers, nil
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// ThresholdRepository provides "almost there" threshold data access.
+type ThresholdRepository struct {
+	queries *db.Queries
+}
+
+// NewThresholdRepository creates a new threshold repository.
+func NewThresholdRepository(queries *db.Queries) *ThresholdRepository {
+	return &ThresholdRepository{queries: queries}
+}
+
+// Get retrieves a threshold by ID.
+func (r *ThresholdRepository) Get(ctx context.Context, id uuid.UUID) (*db.ComparisonThreshold, error) {
+	threshold, err := r.queries.GetThreshold(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get threshold: %w", err)
+	}
+	return &threshold, nil
+}
+
+// List lists all thresholds.
+func (r *ThresholdRepository) List(ctx context.Context) ([]db.ComparisonThreshold, error) {
+	thresholds, err := r.queries.ListThresholds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list thresholds: %w", err)
+	}
+	return thresholds, nil
+}
+
+// ListForStandard lists the thresholds that apply to a standard, including
+// those set for every standard.
+func (r *ThresholdRepository) ListForStandard(ctx context.Context, standardID uuid.UUID) ([]db.ComparisonThreshold, error) {
+	thresholds, err := r.queries.ListThresholdsForStandard(ctx, pgtype.UUID{Bytes: standardID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("list thresholds for standard: %w", err)
+	}
+	return thresholds, nil
+}
+
+// Create creates a new threshold.
+func (r *ThresholdRepository) Create(ctx context.Context, params db.CreateThresholdParams) (*db.ComparisonThreshold, error) {
+	threshold, err := r.queries.CreateThreshold(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("create threshold: %w", err)
+	}
+	return &threshold, nil
+}
+
+// Update updates an existing threshold.
+func (r *ThresholdRepository) Update(ctx context.Context, params db.UpdateThresholdParams) (*db.ComparisonThreshold, error) {
+	threshold, err := r.queries.UpdateThreshold(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("update threshold: %w", err)
+	}
+	return &threshold, nil
+}
+
+// Delete deletes a threshold.
+func (r *ThresholdRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteThreshold(ctx, id); err != nil {
+		return fmt.Errorf("delete threshold: %w", err)
+	}
+	return nil
+}
@@ -161,9 +161,32 @@ func (r *TimeRepository) ListByMeet(ctx context.Context, meetID uuid.UUID) ([]db
 
 // GetPersonalBests retrieves personal bests for a swimmer in a course type.
 func (r *TimeRepository) GetPersonalBests(ctx context.Context, swimmerID uuid.UUID, courseType string) ([]db.GetPersonalBestsRow, error) {
+	return r.ListPersonalBests(ctx, PersonalBestsParams{SwimmerID: swimmerID, CourseType: courseType})
+}
+
+// PersonalBestsParams contains parameters for listing personal bests.
+type PersonalBestsParams struct {
+	SwimmerID  uuid.UUID
+	CourseType string
+	// TimingMethod, when set, keeps only times recorded with that method.
+	TimingMethod string
+	// AsOf, when set, keeps only times swum on or before that date.
+	AsOf *time.Time
+}
+
+// ListPersonalBests retrieves the fastest time per event for a swimmer in a
+// course type.
+func (r *TimeRepository) ListPersonalBests(ctx context.Context, params PersonalBestsParams) ([]db.GetPersonalBestsRow, error) {
+	var asOf pgtype.Date
+	if params.AsOf != nil {
+		asOf = pgtype.Date{Time: *params.AsOf, Valid: true}
+	}
+
 	pbs, err := r.queries.GetPersonalBests(ctx, db.GetPersonalBestsParams{
-		SwimmerID:  swimmerID,
-		CourseType: courseType,
+		SwimmerID:  params.SwimmerID,
+		CourseType: params.CourseType,
+		Column3:    params.TimingMethod,
+		Column4:    asOf,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("get personal bests: %w", err)
@@ -171,6 +194,39 @@ func (r *TimeRepository) GetPersonalBests(ctx context.Context, swimmerID uuid.UU
 	return pbs, nil
 }
 
+// AdjustedPersonalBestsParams contains parameters for adjusted personal bests.
+type AdjustedPersonalBestsParams struct {
+	SwimmerID                 uuid.UUID
+	CourseType                string
+	ManualAdjustmentMS        int32
+	SemiAutomaticAdjustmentMS int32
+	// IncludeHandTimed keeps manual and semi-automatic times in the result.
+	IncludeHandTimed bool
+	// AsOf, when set, keeps only times swum on or before that date.
+	AsOf *time.Time
+}
+
+// GetAdjustedPersonalBests retrieves personal bests ranked by adjusted time.
+func (r *TimeRepository) GetAdjustedPersonalBests(ctx context.Context, params AdjustedPersonalBestsParams) ([]db.GetAdjustedPersonalBestsRow, error) {
+	var asOf pgtype.Date
+	if params.AsOf != nil {
+		asOf = pgtype.Date{Time: *params.AsOf, Valid: true}
+	}
+
+	pbs, err := r.queries.GetAdjustedPersonalBests(ctx, db.GetAdjustedPersonalBestsParams{
+		SwimmerID:  params.SwimmerID,
+		CourseType: params.CourseType,
+		Column3:    params.ManualAdjustmentMS,
+		Column4:    params.SemiAutomaticAdjustmentMS,
+		Column5:    params.IncludeHandTimed,
+		Column6:    asOf,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get adjusted personal bests: %w", err)
+	}
+	return pbs, nil
+}
+
 // GetPersonalBestForEvent retrieves the personal best for a specific event.
 func (r *TimeRepository) GetPersonalBestForEvent(ctx context.Context, swimmerID uuid.UUID, courseType, event string) (*db.GetPersonalBestForEventRow, error) {
 	pb, err := r.queries.GetPersonalBestForEvent(ctx, db.GetPersonalBestForEventParams{
@@ -264,3 +320,55 @@ func (r *TimeRepository) GetProgressData(ctx context.Context, swimmerID uuid.UUI
 	}
 	return rows, nil
 }
+
+// ListReactionTimes retrieves all recorded reaction times for a swimmer.
+func (r *TimeRepository) ListReactionTimes(ctx context.Context, swimmerID uuid.UUID, courseType *string) ([]db.ListReactionTimesRow, error) {
+	ct := ""
+	if courseType != nil {
+		ct = *courseType
+	}
+
+	rows, err := r.queries.ListReactionTimes(ctx, db.ListReactionTimesParams{
+		SwimmerID: swimmerID,
+		Column2:   ct,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list reaction times: %w", err)
+	}
+	return rows, nil
+}
+
+// ListTimeHistory retrieves every time for a swimmer in chronological order.
+func (r *TimeRepository) ListTimeHistory(ctx context.Context, swimmerID uuid.UUID, courseType *string) ([]db.ListTimeHistoryRow, error) {
+	ct := ""
+	if courseType != nil {
+		ct = *courseType
+	}
+
+	rows, err := r.queries.ListTimeHistory(ctx, db.ListTimeHistoryParams{
+		SwimmerID: swimmerID,
+		Column2:   ct,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list time history: %w", err)
+	}
+	return rows, nil
+}
+
+// ListTimeHistoryForEvents retrieves a swimmer's times in the given events
+// and course types in chronological order.
+func (r *TimeRepository) ListTimeHistoryForEvents(ctx context.Context, swimmerID uuid.UUID, events, courseTypes []string) ([]db.ListTimeHistoryRow, error) {
+	rows, err := r.queries.ListTimeHistoryForEvents(ctx, db.ListTimeHistoryForEventsParams{
+		SwimmerID: swimmerID,
+		Column2:   events,
+		Column3:   courseTypes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list time history for events: %w", err)
+	}
+	history := make([]db.ListTimeHistoryRow, len(rows))
+	for i, row := range rows {
+		history[i] = db.ListTimeHistoryRow(row)
+	}
+	return history, nil
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bpg/swimstats/backend/internal/store/db"
+)
+
+// WorldAquaticsBaseTimeRepository provides World Aquatics base time data access.
+type WorldAquaticsBaseTimeRepository struct {
+	queries *db.Queries
+	pool    TxBeginner
+}
+
+// NewWorldAquaticsBaseTimeRepository creates a new World Aquatics base time repository.
+func NewWorldAquaticsBaseTimeRepository(queries *db.Queries, pool TxBeginner) *WorldAquaticsBaseTimeRepository {
+	return &WorldAquaticsBaseTimeRepository{queries: queries, pool: pool}
+}
+
+// List lists base times, optionally filtered by course type and gender.
+func (r *WorldAquaticsBaseTimeRepository) List(ctx context.Context, courseType, gender *string) ([]db.WorldAquaticsBaseTime, error) {
+	ct := ""
+	if courseType != nil {
+		ct = *courseType
+	}
+	g := ""
+	if gender != nil {
+		g = *gender
+	}
+
+	times, err := r.queries.ListWorldAquaticsBaseTimes(ctx, db.ListWorldAquaticsBaseTimesParams{
+		Column1: ct,
+		Column2: g,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list World Aquatics base times: %w", err)
+	}
+	return times, nil
+}
+
+// ReplaceTable replaces all base times for a course type and gender in one
+// transaction, so a failed import leaves the existing table in place.
+func (r *WorldAquaticsBaseTimeRepository) ReplaceTable(ctx context.Context, courseType, gender string, params []db.UpsertWorldAquaticsBaseTimeParams) ([]db.WorldAquaticsBaseTime, error) {
+	times := make([]db.WorldAquaticsBaseTime, 0, len(params))
+	err := inTx(ctx, r.pool, r.queries, func(q *db.Queries) error {
+		if err := q.DeleteWorldAquaticsBaseTimes(ctx, db.DeleteWorldAquaticsBaseTimesParams{
+			CourseType: courseType,
+			Gender:     gender,
+		}); err != nil {
+			return fmt.Errorf("delete World Aquatics base times: %w", err)
+		}
+		for _, p := range params {
+			bt, err := q.UpsertWorldAquaticsBaseTime(ctx, p)
+			if err != nil {
+				return fmt.Errorf("upsert World Aquatics base time: %w", err)
+			}
+			times = append(times, bt)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return times, nil
+}
@@ -0,0 +1,9 @@
+-- name: ListEvents :many
+SELECT code, distance, stroke, course_types, name, sort_order, created_at
+FROM events
+ORDER BY sort_order, code;
+
+-- name: CreateEvent :one
+INSERT INTO events (code, distance, stroke, course_types, name, sort_order)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING code, distance, stroke, course_types, name, sort_order, created_at;
@@ -0,0 +1,30 @@
+-- name: GetGoal :one
+SELECT id, swimmer_id, event, course_type, target_time_ms, standard_id, deadline, notes, status, achieved_time_id, achieved_date, created_at, updated_at
+FROM goals
+WHERE id = $1;
+
+-- name: ListGoals :many
+SELECT id, swimmer_id, event, course_type, target_time_ms, standard_id, deadline, notes, status, achieved_time_id, achieved_date, created_at, updated_at
+FROM goals
+WHERE swimmer_id = $1
+ORDER BY deadline NULLS LAST, created_at;
+
+-- name: CreateGoal :one
+INSERT INTO goals (swimmer_id, event, course_type, target_time_ms, standard_id, deadline, notes)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, swimmer_id, event, course_type, target_time_ms, standard_id, deadline, notes, status, achieved_time_id, achieved_date, created_at, updated_at;
+
+-- name: UpdateGoal :one
+UPDATE goals
+SET event = $2, course_type = $3, target_time_ms = $4, standard_id = $5, deadline = $6, notes = $7
+WHERE id = $1
+RETURNING id, swimmer_id, event, course_type, target_time_ms, standard_id, deadline, notes, status, achieved_time_id, achieved_date, created_at, updated_at;
+
+-- name: UpdateGoalStatus :exec
+UPDATE goals
+SET status = $2, achieved_time_id = $3, achieved_date = $4
+WHERE id = $1;
+
+-- name: DeleteGoal :exec
+DELETE FROM goals
+WHERE id = $1;
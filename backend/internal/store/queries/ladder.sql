@@ -0,0 +1,43 @@
+-- name: GetLadder :one
+SELECT id, name, description, course_type, created_at, updated_at
+FROM standard_ladders
+WHERE id = $1;
+
+-- name: ListLadders :many
+SELECT id, name, description, course_type, created_at, updated_at
+FROM standard_ladders
+WHERE ($1::varchar = '' OR course_type = $1)
+ORDER BY name;
+
+-- name: CreateLadder :one
+INSERT INTO standard_ladders (name, description, course_type)
+VALUES ($1, $2, $3)
+RETURNING id, name, description, course_type, created_at, updated_at;
+
+-- name: UpdateLadder :one
+UPDATE standard_ladders
+SET name = $2, description = $3, course_type = $4
+WHERE id = $1
+RETURNING id, name, description, course_type, created_at, updated_at;
+
+-- name: DeleteLadder :exec
+DELETE FROM standard_ladders
+WHERE id = $1;
+
+-- name: LadderNameExists :one
+SELECT EXISTS(SELECT 1 FROM standard_ladders WHERE name = $1 AND id != $2);
+
+-- name: ListLadderTiers :many
+SELECT lt.ladder_id, lt.position, lt.standard_id, lt.label, s.name AS standard_name
+FROM standard_ladder_tiers lt
+JOIN time_standards s ON s.id = lt.standard_id
+WHERE lt.ladder_id = $1
+ORDER BY lt.position;
+
+-- name: CreateLadderTier :exec
+INSERT INTO standard_ladder_tiers (ladder_id, position, standard_id, label)
+VALUES ($1, $2, $3, $4);
+
+-- name: DeleteLadderTiers :exec
+DELETE FROM standard_ladder_tiers
+WHERE ladder_id = $1;
@@ -76,3 +76,16 @@ WHERE ($1::varchar = '' OR m.course_type = $1)
 GROUP BY m.id
 ORDER BY m.start_date DESC
 LIMIT $2;
+
+-- name: GetMeetResultSummary :one
+-- Returns medal and top-8 counts for a meet.
+-- Places use the age-group place when recorded, otherwise the overall place.
+SELECT
+    COUNT(*) FILTER (WHERE COALESCE(age_group_place, overall_place) = 1)::int AS gold,
+    COUNT(*) FILTER (WHERE COALESCE(age_group_place, overall_place) = 2)::int AS silver,
+    COUNT(*) FILTER (WHERE COALESCE(age_group_place, overall_place) = 3)::int AS bronze,
+    COUNT(*) FILTER (WHERE COALESCE(age_group_place, overall_place) <= 8)::int AS top_eight,
+    COUNT(*) FILTER (WHERE age_group_place IS NOT NULL OR overall_place IS NOT NULL)::int AS placed_count,
+    COALESCE(SUM(points_earned), 0)::float8 AS total_points
+FROM times
+WHERE meet_id = $1;
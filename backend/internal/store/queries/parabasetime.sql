@@ -0,0 +1,17 @@
+-- name: ListParaBaseTimes :many
+SELECT sport_class, event, gender, course_type, time_ms, created_at
+FROM para_base_times
+WHERE ($1::varchar = '' OR course_type = $1)
+  AND ($2::varchar = '' OR gender = $2)
+ORDER BY course_type, gender, sport_class, event;
+
+-- name: UpsertParaBaseTime :one
+INSERT INTO para_base_times (sport_class, event, gender, course_type, time_ms)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (sport_class, event, gender, course_type)
+DO UPDATE SET time_ms = EXCLUDED.time_ms
+RETURNING sport_class, event, gender, course_type, time_ms, created_at;
+
+-- name: DeleteParaBaseTimes :exec
+DELETE FROM para_base_times
+WHERE course_type = $1 AND gender = $2;
@@ -0,0 +1,52 @@
+-- name: GetRankingSnapshot :one
+SELECT id, source, season, event, course_type, gender, age_group, captured_on, created_at, updated_at
+FROM ranking_snapshots
+WHERE id = $1;
+
+-- name: GetRankingSnapshotByKey :one
+SELECT id, source, season, event, course_type, gender, age_group, captured_on, created_at, updated_at
+FROM ranking_snapshots
+WHERE source = $1 AND season = $2 AND event = $3 AND course_type = $4 AND gender = $5 AND age_group = $6;
+
+-- name: ListRankingSnapshots :many
+-- Optionally restricted to a season, course type, event, gender and age group
+SELECT rs.id, rs.source, rs.season, rs.event, rs.course_type, rs.gender, rs.age_group, rs.captured_on,
+    rs.created_at, rs.updated_at,
+    (SELECT COUNT(*) FROM ranking_entries re WHERE re.snapshot_id = rs.id) AS entry_count
+FROM ranking_snapshots rs
+WHERE ($1::varchar = '' OR rs.season = $1)
+  AND ($2::varchar = '' OR rs.course_type = $2)
+  AND ($3::varchar = '' OR rs.event = $3)
+  AND ($4::varchar = '' OR rs.gender = $4)
+  AND ($5::varchar = '' OR rs.age_group = $5)
+ORDER BY rs.season DESC, rs.course_type, rs.gender, rs.event, rs.age_group, rs.source;
+
+-- name: CreateRankingSnapshot :one
+INSERT INTO ranking_snapshots (source, season, event, course_type, gender, age_group, captured_on)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, source, season, event, course_type, gender, age_group, captured_on, created_at, updated_at;
+
+-- name: UpdateRankingSnapshot :one
+UPDATE ranking_snapshots
+SET captured_on = $2
+WHERE id = $1
+RETURNING id, source, season, event, course_type, gender, age_group, captured_on, created_at, updated_at;
+
+-- name: DeleteRankingSnapshot :exec
+DELETE FROM ranking_snapshots
+WHERE id = $1;
+
+-- name: CreateRankingEntry :one
+INSERT INTO ranking_entries (snapshot_id, rank, swimmer_name, club, time_ms)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, snapshot_id, rank, swimmer_name, club, time_ms;
+
+-- name: DeleteRankingEntriesBySnapshot :exec
+DELETE FROM ranking_entries
+WHERE snapshot_id = $1;
+
+-- name: ListRankingEntries :many
+SELECT id, snapshot_id, rank, swimmer_name, club, time_ms
+FROM ranking_entries
+WHERE snapshot_id = $1
+ORDER BY time_ms, rank;
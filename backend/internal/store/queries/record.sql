@@ -0,0 +1,60 @@
+-- name: GetRecordTable :one
+SELECT id, name, scope, description, created_at, updated_at
+FROM record_tables
+WHERE id = $1;
+
+-- name: GetRecordTableByName :one
+SELECT id, name, scope, description, created_at, updated_at
+FROM record_tables
+WHERE name = $1;
+
+-- name: ListRecordTables :many
+SELECT rt.id, rt.name, rt.scope, rt.description, rt.created_at, rt.updated_at,
+    (SELECT COUNT(*) FROM records r WHERE r.record_table_id = rt.id) AS record_count
+FROM record_tables rt
+ORDER BY CASE rt.scope WHEN 'club' THEN 1 WHEN 'provincial' THEN 2 ELSE 3 END, rt.name;
+
+-- name: CreateRecordTable :one
+INSERT INTO record_tables (name, scope, description)
+VALUES ($1, $2, $3)
+RETURNING id, name, scope, description, created_at, updated_at;
+
+-- name: UpdateRecordTable :one
+UPDATE record_tables
+SET scope = $2, description = $3
+WHERE id = $1
+RETURNING id, name, scope, description, created_at, updated_at;
+
+-- name: DeleteRecordTable :exec
+DELETE FROM record_tables
+WHERE id = $1;
+
+-- name: CreateRecord :one
+INSERT INTO records (record_table_id, event, course_type, gender, age_group, time_ms, holder, record_date, location)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, record_table_id, event, course_type, gender, age_group, time_ms, holder, record_date, location, created_at;
+
+-- name: DeleteRecordsByTable :exec
+DELETE FROM records
+WHERE record_table_id = $1;
+
+-- name: ListRecordsByTable :many
+SELECT id, record_table_id, event, course_type, gender, age_group, time_ms, holder, record_date, location, created_at
+FROM records
+WHERE record_table_id = $1
+ORDER BY course_type, gender, event, age_group;
+
+-- name: ListApplicableRecords :many
+-- Returns the records that apply to a swimmer of a gender: the same gender plus mixed records
+-- Optionally restricted to a course type, an event and a record table
+SELECT r.id, r.record_table_id, r.event, r.course_type, r.gender, r.age_group, r.time_ms,
+    r.holder, r.record_date, r.location,
+    rt.name AS table_name,
+    rt.scope
+FROM records r
+JOIN record_tables rt ON rt.id = r.record_table_id
+WHERE r.gender IN ($1, 'mixed')
+  AND ($2::varchar = '' OR r.course_type = $2)
+  AND ($3::varchar = '' OR r.event = $3)
+  AND ($4::uuid = '00000000-0000-0000-0000-000000000000' OR r.record_table_id = $4)
+ORDER BY CASE rt.scope WHEN 'club' THEN 1 WHEN 'provincial' THEN 2 ELSE 3 END, rt.name, r.course_type, r.event, r.age_group;
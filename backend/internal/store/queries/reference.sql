@@ -0,0 +1,57 @@
+-- name: GetReferenceSwimmer :one
+SELECT id, name, birth_date, gender, description, created_at, updated_at
+FROM reference_swimmers
+WHERE id = $1;
+
+-- name: GetReferenceSwimmerByName :one
+SELECT id, name, birth_date, gender, description, created_at, updated_at
+FROM reference_swimmers
+WHERE name = $1;
+
+-- name: ListReferenceSwimmers :many
+SELECT rs.id, rs.name, rs.birth_date, rs.gender, rs.description, rs.created_at, rs.updated_at,
+    (SELECT COUNT(*) FROM reference_times rt WHERE rt.reference_swimmer_id = rs.id) AS time_count
+FROM reference_swimmers rs
+ORDER BY rs.name;
+
+-- name: CreateReferenceSwimmer :one
+INSERT INTO reference_swimmers (name, birth_date, gender, description)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, birth_date, gender, description, created_at, updated_at;
+
+-- name: UpdateReferenceSwimmer :one
+UPDATE reference_swimmers
+SET birth_date = $2, gender = $3, description = $4
+WHERE id = $1
+RETURNING id, name, birth_date, gender, description, created_at, updated_at;
+
+-- name: DeleteReferenceSwimmer :exec
+DELETE FROM reference_swimmers
+WHERE id = $1;
+
+-- name: CreateReferenceTime :one
+INSERT INTO reference_times (reference_swimmer_id, event, course_type, time_ms, event_date, meet_name)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, reference_swimmer_id, event, course_type, time_ms, event_date, meet_name, created_at;
+
+-- name: DeleteReferenceTimesBySwimmer :exec
+DELETE FROM reference_times
+WHERE reference_swimmer_id = $1;
+
+-- name: ListReferenceTimes :many
+-- Returns every time of a reference swimmer in chronological order
+-- Optionally restricted to a single course type
+SELECT id, reference_swimmer_id, event, course_type, time_ms, event_date, meet_name, created_at
+FROM reference_times
+WHERE reference_swimmer_id = $1
+  AND ($2::varchar = '' OR course_type = $2)
+ORDER BY event_date, created_at;
+
+-- name: GetReferencePersonalBests :many
+-- Returns the fastest time for each event for a reference swimmer in a specific course type
+SELECT DISTINCT ON (event)
+    id, reference_swimmer_id, event, course_type, time_ms, event_date, meet_name, created_at
+FROM reference_times
+WHERE reference_swimmer_id = $1
+  AND course_type = $2
+ORDER BY event, time_ms, event_date;
@@ -1,25 +1,27 @@
 -- name: GetStandard :one
-SELECT id, name, description, course_type, gender, is_preloaded, created_at, updated_at
+SELECT id, name, description, course_type, gender, is_preloaded, created_at, updated_at, manual_time_policy, category
 FROM time_standards
 WHERE id = $1;
 
 -- name: ListStandards :many
-SELECT id, name, description, course_type, gender, is_preloaded, created_at, updated_at
+SELECT id, name, description, course_type, gender, is_preloaded, created_at, updated_at, manual_time_policy, category
 FROM time_standards
 WHERE ($1::varchar = '' OR course_type = $1)
   AND ($2::varchar = '' OR gender = $2)
+  AND ($3::varchar = '' OR category = $3)
+  AND ($4::varchar = '' OR gender = $4 OR gender = 'mixed')
 ORDER BY is_preloaded DESC, name ASC;
 
 -- name: CreateStandard :one
-INSERT INTO time_standards (name, description, course_type, gender, is_preloaded)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, name, description, course_type, gender, is_preloaded, created_at, updated_at;
+INSERT INTO time_standards (name, description, course_type, gender, is_preloaded, manual_time_policy, category)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, description, course_type, gender, is_preloaded, created_at, updated_at, manual_time_policy, category;
 
 -- name: UpdateStandard :one
 UPDATE time_standards
-SET name = $2, description = $3, course_type = $4, gender = $5
+SET name = $2, description = $3, course_type = $4, gender = $5, manual_time_policy = $6, category = $7
 WHERE id = $1
-RETURNING id, name, description, course_type, gender, is_preloaded, created_at, updated_at;
+RETURNING id, name, description, course_type, gender, is_preloaded, created_at, updated_at, manual_time_policy, category;
 
 -- name: DeleteStandard :exec
 DELETE FROM time_standards
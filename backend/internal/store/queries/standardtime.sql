@@ -1,41 +1,43 @@
 -- name: GetStandardTime :one
-SELECT id, standard_id, event, age_group, time_ms, created_at, updated_at
+SELECT id, standard_id, event, age_group, time_ms, created_at, updated_at, sport_class
 FROM standard_times
 WHERE id = $1;
 
 -- name: ListStandardTimes :many
-SELECT id, standard_id, event, age_group, time_ms, created_at, updated_at
-FROM standard_times
-WHERE standard_id = $1
+-- Events are ordered by the event catalogue; unknown events sort last
+SELECT st.id, st.standard_id, st.event, st.age_group, st.time_ms, st.created_at, st.updated_at, st.sport_class
+FROM standard_times st
+LEFT JOIN events e ON e.code = st.event
+WHERE st.standard_id = $1
 ORDER BY 
-    CASE event
-        WHEN '50FR' THEN 1 WHEN '100FR' THEN 2 WHEN '200FR' THEN 3 WHEN '400FR' THEN 4 WHEN '800FR' THEN 5 WHEN '1500FR' THEN 6
-        WHEN '50BK' THEN 7 WHEN '100BK' THEN 8 WHEN '200BK' THEN 9
-        WHEN '50BR' THEN 10 WHEN '100BR' THEN 11 WHEN '200BR' THEN 12
-        WHEN '50FL' THEN 13 WHEN '100FL' THEN 14 WHEN '200FL' THEN 15
-        WHEN '200IM' THEN 16 WHEN '400IM' THEN 17
-        ELSE 99
-    END,
-    CASE age_group
+    COALESCE(e.sort_order, 2147483647),
+    st.event,
+    CASE st.age_group
         WHEN '10U' THEN 1 WHEN '11-12' THEN 2 WHEN '13-14' THEN 3 WHEN '15-17' THEN 4 WHEN 'OPEN' THEN 5
         ELSE 99
-    END;
+    END,
+    -- Masters bands ("25-29", ...) sort by their lower age
+    CASE WHEN st.age_group ~ '^[0-9]+-' THEN split_part(st.age_group, '-', 1)::int END,
+    -- Times for every class come first, then sport classes by family and number
+    substring(st.sport_class from '^[A-Z]*'),
+    NULLIF(substring(st.sport_class from '[0-9]*$'), '')::int;
 
 -- name: GetStandardTimeForEventAndAge :one
-SELECT id, standard_id, event, age_group, time_ms, created_at, updated_at
+-- Only times that apply to every sport class are considered
+SELECT id, standard_id, event, age_group, time_ms, created_at, updated_at, sport_class
 FROM standard_times
-WHERE standard_id = $1 AND event = $2 AND age_group = $3;
+WHERE standard_id = $1 AND event = $2 AND age_group = $3 AND sport_class = '';
 
 -- name: CreateStandardTime :one
-INSERT INTO standard_times (standard_id, event, age_group, time_ms)
-VALUES ($1, $2, $3, $4)
-RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at;
+INSERT INTO standard_times (standard_id, event, age_group, time_ms, sport_class)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at, sport_class;
 
 -- name: UpdateStandardTime :one
 UPDATE standard_times
-SET event = $2, age_group = $3, time_ms = $4
+SET event = $2, age_group = $3, time_ms = $4, sport_class = $5
 WHERE id = $1
-RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at;
+RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at, sport_class;
 
 -- name: DeleteStandardTime :exec
 DELETE FROM standard_times
@@ -46,8 +48,8 @@ DELETE FROM standard_times
 WHERE standard_id = $1;
 
 -- name: UpsertStandardTime :one
-INSERT INTO standard_times (standard_id, event, age_group, time_ms)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (standard_id, event, age_group) 
+INSERT INTO standard_times (standard_id, event, age_group, time_ms, sport_class)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (standard_id, event, age_group, sport_class)
 DO UPDATE SET time_ms = EXCLUDED.time_ms
-RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at;
+RETURNING id, standard_id, event, age_group, time_ms, created_at, updated_at, sport_class;
@@ -1,32 +1,35 @@
 -- name: GetSwimmer :one
-SELECT id, name, birth_date, gender, threshold_percent, created_at, updated_at
+SELECT id, name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category, sport_class_s, sport_class_sb, sport_class_sm, created_at, updated_at
 FROM swimmers
 WHERE id = $1;
 
 -- name: GetSwimmerByUserID :one
 -- In a multi-user scenario, this would filter by user_id
 -- For single-user MVP, just return the first swimmer
-SELECT id, name, birth_date, gender, threshold_percent, created_at, updated_at
+SELECT id, name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category, sport_class_s, sport_class_sb, sport_class_sm, created_at, updated_at
 FROM swimmers
 LIMIT 1;
 
 -- name: CreateSwimmer :one
-INSERT INTO swimmers (name, birth_date, gender, threshold_percent)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, birth_date, gender, threshold_percent, created_at, updated_at;
+INSERT INTO swimmers (name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category,
+    sport_class_s, sport_class_sb, sport_class_sm)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category, sport_class_s, sport_class_sb, sport_class_sm, created_at, updated_at;
 
 -- name: UpdateSwimmer :one
 UPDATE swimmers
-SET name = $2, birth_date = $3, gender = $4, threshold_percent = $5
+SET name = $2, birth_date = $3, gender = $4, threshold_percent = $5,
+    manual_adjustment_ms = $6, semi_automatic_adjustment_ms = $7, category = $8,
+    sport_class_s = $9, sport_class_sb = $10, sport_class_sm = $11
 WHERE id = $1
-RETURNING id, name, birth_date, gender, threshold_percent, created_at, updated_at;
+RETURNING id, name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category, sport_class_s, sport_class_sb, sport_class_sm, created_at, updated_at;
 
 -- name: DeleteSwimmer :exec
 DELETE FROM swimmers
 WHERE id = $1;
 
 -- name: ListSwimmers :many
-SELECT id, name, birth_date, gender, threshold_percent, created_at, updated_at
+SELECT id, name, birth_date, gender, threshold_percent, manual_adjustment_ms, semi_automatic_adjustment_ms, category, sport_class_s, sport_class_sb, sport_class_sm, created_at, updated_at
 FROM swimmers
 ORDER BY name;
 
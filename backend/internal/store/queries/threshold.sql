@@ -0,0 +1,30 @@
+-- name: GetThreshold :one
+SELECT id, standard_id, event, min_distance, max_distance, percent, time_ms, created_at, updated_at
+FROM comparison_thresholds
+WHERE id = $1;
+
+-- name: ListThresholds :many
+SELECT id, standard_id, event, min_distance, max_distance, percent, time_ms, created_at, updated_at
+FROM comparison_thresholds
+ORDER BY standard_id NULLS FIRST, event NULLS LAST, min_distance NULLS FIRST, max_distance NULLS LAST;
+
+-- name: ListThresholdsForStandard :many
+-- Lists the thresholds that apply to a standard, including those for every standard.
+SELECT id, standard_id, event, min_distance, max_distance, percent, time_ms, created_at, updated_at
+FROM comparison_thresholds
+WHERE standard_id IS NULL OR standard_id = $1;
+
+-- name: CreateThreshold :one
+INSERT INTO comparison_thresholds (standard_id, event, min_distance, max_distance, percent, time_ms)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, standard_id, event, min_distance, max_distance, percent, time_ms, created_at, updated_at;
+
+-- name: UpdateThreshold :one
+UPDATE comparison_thresholds
+SET standard_id = $2, event = $3, min_distance = $4, max_distance = $5, percent = $6, time_ms = $7
+WHERE id = $1
+RETURNING id, standard_id, event, min_distance, max_distance, percent, time_ms, created_at, updated_at;
+
+-- name: DeleteThreshold :exec
+DELETE FROM comparison_thresholds
+WHERE id = $1;
@@ -8,7 +8,17 @@ SELECT
     t.event_date,
     t.notes, 
     t.created_at, 
-    t.updated_at
+    t.updated_at,
+    t.heat,
+    t.lane,
+    t.heat_place,
+    t.overall_place,
+    t.age_group_place,
+    t.points_earned,
+    t.timing_method,
+    t.reaction_time_ms,
+    t.water_temperature_c,
+    t.wetsuit
 FROM times t
 WHERE t.id = $1;
 
@@ -23,13 +33,27 @@ SELECT
     t.notes, 
     t.created_at, 
     t.updated_at,
+    t.heat,
+    t.lane,
+    t.heat_place,
+    t.overall_place,
+    t.age_group_place,
+    t.points_earned,
+    t.timing_method,
+    t.reaction_time_ms,
+    t.water_temperature_c,
+    t.wetsuit,
     m.name AS meet_name,
     m.city AS meet_city,
     m.start_date AS meet_start_date,
     m.end_date AS meet_end_date,
-    m.course_type AS meet_course_type
+    m.course_type AS meet_course_type,
+    b.time_ms AS base_time_ms
 FROM times t
 JOIN meets m ON m.id = t.meet_id
+JOIN swimmers s ON s.id = t.swimmer_id
+LEFT JOIN world_aquatics_base_times b
+    ON b.event = t.event AND b.gender = s.gender AND b.course_type = m.course_type
 WHERE t.id = $1;
 
 -- name: ListTimes :many
@@ -43,13 +67,27 @@ SELECT
     t.notes, 
     t.created_at, 
     t.updated_at,
+    t.heat,
+    t.lane,
+    t.heat_place,
+    t.overall_place,
+    t.age_group_place,
+    t.points_earned,
+    t.timing_method,
+    t.reaction_time_ms,
+    t.water_temperature_c,
+    t.wetsuit,
     m.name AS meet_name,
     m.city AS meet_city,
     m.start_date AS meet_start_date,
     m.end_date AS meet_end_date,
-    m.course_type AS meet_course_type
+    m.course_type AS meet_course_type,
+    b.time_ms AS base_time_ms
 FROM times t
 JOIN meets m ON m.id = t.meet_id
+JOIN swimmers s ON s.id = t.swimmer_id
+LEFT JOIN world_aquatics_base_times b
+    ON b.event = t.event AND b.gender = s.gender AND b.course_type = m.course_type
 WHERE t.swimmer_id = $1
   AND ($2::varchar = '' OR m.course_type = $2)
   AND ($3::varchar = '' OR t.event = $3)
@@ -66,15 +104,25 @@ WHERE t.swimmer_id = $1
   AND ($4::uuid = '00000000-0000-0000-0000-000000000000' OR t.meet_id = $4);
 
 -- name: CreateTime :one
-INSERT INTO times (swimmer_id, meet_id, event, time_ms, event_date, notes)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, swimmer_id, meet_id, event, time_ms, event_date, notes, created_at, updated_at;
+INSERT INTO times (
+    swimmer_id, meet_id, event, time_ms, event_date, notes,
+    heat, lane, heat_place, overall_place, age_group_place, points_earned, timing_method,
+    reaction_time_ms, water_temperature_c, wetsuit
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+RETURNING id, swimmer_id, meet_id, event, time_ms, event_date, notes, created_at, updated_at,
+    heat, lane, heat_place, overall_place, age_group_place, points_earned, timing_method,
+    reaction_time_ms, water_temperature_c, wetsuit;
 
 -- name: UpdateTime :one
 UPDATE times
-SET meet_id = $2, event = $3, time_ms = $4, event_date = $5, notes = $6
+SET meet_id = $2, event = $3, time_ms = $4, event_date = $5, notes = $6,
+    heat = $7, lane = $8, heat_place = $9, overall_place = $10, age_group_place = $11, points_earned = $12,
+    timing_method = $13, reaction_time_ms = $14, water_temperature_c = $15, wetsuit = $16
 WHERE id = $1
-RETURNING id, swimmer_id, meet_id, event, time_ms, event_date, notes, created_at, updated_at;
+RETURNING id, swimmer_id, meet_id, event, time_ms, event_date, notes, created_at, updated_at,
+    heat, lane, heat_place, overall_place, age_group_place, points_earned, timing_method,
+    reaction_time_ms, water_temperature_c, wetsuit;
 
 -- name: DeleteTime :exec
 DELETE FROM times
@@ -94,13 +142,25 @@ SELECT
     t.event_date,
     t.notes, 
     t.created_at, 
-    t.updated_at
+    t.updated_at,
+    t.heat,
+    t.lane,
+    t.heat_place,
+    t.overall_place,
+    t.age_group_place,
+    t.points_earned,
+    t.timing_method,
+    t.reaction_time_ms,
+    t.water_temperature_c,
+    t.wetsuit
 FROM times t
 WHERE t.meet_id = $1
 ORDER BY COALESCE(t.event_date, (SELECT start_date FROM meets WHERE id = t.meet_id)), t.event, t.time_ms;
 
 -- name: GetPersonalBests :many
 -- Returns the fastest time for each event for a swimmer in a specific course type
+-- Optionally restricted to a single timing method
+-- $4, when set, keeps only times swum on or before that date
 SELECT DISTINCT ON (t.event)
     t.id,
     t.swimmer_id,
@@ -111,14 +171,47 @@ SELECT DISTINCT ON (t.event)
     t.notes,
     t.created_at,
     t.updated_at,
+    t.timing_method,
+    t.water_temperature_c,
+    t.wetsuit,
     m.name AS meet_name,
-    m.start_date AS meet_date
+    m.start_date AS meet_date,
+    b.time_ms AS base_time_ms
 FROM times t
 JOIN meets m ON m.id = t.meet_id
+JOIN swimmers s ON s.id = t.swimmer_id
+LEFT JOIN world_aquatics_base_times b
+    ON b.event = t.event AND b.gender = s.gender AND b.course_type = m.course_type
 WHERE t.swimmer_id = $1
   AND m.course_type = $2
+  AND ($3::varchar = '' OR t.timing_method = $3)
+  AND ($4::date IS NULL OR COALESCE(t.event_date, m.start_date) <= $4::date)
 ORDER BY t.event, t.time_ms ASC, COALESCE(t.event_date, m.start_date) DESC;
 
+-- name: GetAdjustedPersonalBests :many
+-- Returns the fastest time for each event after adding timing adjustments
+-- $3/$4 are the manual/semi-automatic adjustments in ms; $5 = false drops non-electronic times
+-- $6, when set, keeps only times swum on or before that date
+SELECT DISTINCT ON (t.event)
+    t.id,
+    t.event,
+    t.time_ms,
+    t.timing_method,
+    (t.time_ms + CASE t.timing_method
+        WHEN 'manual' THEN $3::int
+        WHEN 'semi_automatic' THEN $4::int
+        ELSE 0
+    END)::int AS adjusted_time_ms,
+    m.name AS meet_name,
+    m.start_date AS meet_date
+FROM times t
+JOIN meets m ON m.id = t.meet_id
+WHERE t.swimmer_id = $1
+  AND m.course_type = $2
+  AND ($5::bool OR t.timing_method = 'electronic')
+  AND ($6::date IS NULL OR COALESCE(t.event_date, m.start_date) <= $6::date)
+ORDER BY t.event, adjusted_time_ms ASC, COALESCE(t.event_date, m.start_date) DESC;
+
 -- name: GetPersonalBestForEvent :one
 -- Returns the fastest time for a specific event
 SELECT 
@@ -180,6 +273,22 @@ SELECT EXISTS (
       AND event = $3
 ) AS exists;
 
+-- name: ListReactionTimes :many
+-- Returns every recorded reaction time for a swimmer in chronological order
+SELECT
+    t.id,
+    t.event,
+    t.reaction_time_ms::int AS reaction_time_ms,
+    COALESCE(t.event_date, m.start_date) AS date,
+    m.name AS meet_name,
+    m.course_type
+FROM times t
+JOIN meets m ON m.id = t.meet_id
+WHERE t.swimmer_id = $1
+  AND t.reaction_time_ms IS NOT NULL
+  AND ($2::varchar = '' OR m.course_type = $2)
+ORDER BY COALESCE(t.event_date, m.start_date) ASC, t.event;
+
 -- name: GetProgressData :many
 -- Returns time progression for a specific event over time
 -- Used for progress charts visualization
@@ -190,6 +299,9 @@ SELECT
     COALESCE(t.event_date, m.start_date) AS date,
     m.name AS meet_name,
     t.event,
+    t.water_temperature_c,
+    t.wetsuit,
+    b.time_ms AS base_time_ms,
     -- Check if this time is the personal best (fastest time for this event/course)
     (t.time_ms = (
         SELECT MIN(t2.time_ms)
@@ -201,9 +313,49 @@ SELECT
     )) AS is_pb
 FROM times t
 JOIN meets m ON m.id = t.meet_id
+JOIN swimmers s ON s.id = t.swimmer_id
+LEFT JOIN world_aquatics_base_times b
+    ON b.event = t.event AND b.gender = s.gender AND b.course_type = m.course_type
 WHERE t.swimmer_id = $1
   AND m.course_type = $2
   AND t.event = $3
   AND ($4::date IS NULL OR COALESCE(t.event_date, m.start_date) >= $4)
   AND ($5::date IS NULL OR COALESCE(t.event_date, m.start_date) <= $5)
 ORDER BY COALESCE(t.event_date, m.start_date) ASC, t.time_ms ASC;
+
+-- name: ListTimeHistory :many
+-- Returns every time for a swimmer in chronological order
+-- Optionally restricted to a single course type
+SELECT
+    t.id,
+    t.meet_id,
+    t.event,
+    t.time_ms,
+    t.timing_method,
+    COALESCE(t.event_date, m.start_date) AS date,
+    m.name AS meet_name,
+    m.course_type
+FROM times t
+JOIN meets m ON m.id = t.meet_id
+WHERE t.swimmer_id = $1
+  AND ($2::varchar = '' OR m.course_type = $2)
+ORDER BY COALESCE(t.event_date, m.start_date) ASC, t.event, t.time_ms;
+
+-- name: ListTimeHistoryForEvents :many
+-- Returns the times for a swimmer in the given events and course types in
+-- chronological order
+SELECT
+    t.id,
+    t.meet_id,
+    t.event,
+    t.time_ms,
+    t.timing_method,
+    COALESCE(t.event_date, m.start_date) AS date,
+    m.name AS meet_name,
+    m.course_type
+FROM times t
+JOIN meets m ON m.id = t.meet_id
+WHERE t.swimmer_id = $1
+  AND t.event = ANY($2::varchar[])
+  AND m.course_type = ANY($3::varchar[])
+ORDER BY COALESCE(t.event_date, m.start_date) ASC, t.event, t.time_ms;
@@ -0,0 +1,17 @@
+-- name: ListWorldAquaticsBaseTimes :many
+SELECT event, gender, course_type, time_ms, created_at
+FROM world_aquatics_base_times
+WHERE ($1::varchar = '' OR course_type = $1)
+  AND ($2::varchar = '' OR gender = $2)
+ORDER BY course_type, gender, event;
+
+-- name: UpsertWorldAquaticsBaseTime :one
+INSERT INTO world_aquatics_base_times (event, gender, course_type, time_ms)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (event, gender, course_type)
+DO UPDATE SET time_ms = EXCLUDED.time_ms
+RETURNING event, gender, course_type, time_ms, created_at;
+
+-- name: DeleteWorldAquaticsBaseTimes :exec
+DELETE FROM world_aquatics_base_times
+WHERE course_type = $1 AND gender = $2;
@@ -0,0 +1,7 @@
+ALTER TABLE times
+DROP COLUMN points_earned,
+DROP COLUMN age_group_place,
+DROP COLUMN overall_place,
+DROP COLUMN heat_place,
+DROP COLUMN lane,
+DROP COLUMN heat;
@@ -0,0 +1,8 @@
+-- Add heat, lane and placement details to times
+ALTER TABLE times
+ADD COLUMN heat INTEGER CHECK (heat > 0),
+ADD COLUMN lane INTEGER CHECK (lane >= 0),
+ADD COLUMN heat_place INTEGER CHECK (heat_place > 0),
+ADD COLUMN overall_place INTEGER CHECK (overall_place > 0),
+ADD COLUMN age_group_place INTEGER CHECK (age_group_place > 0),
+ADD COLUMN points_earned DECIMAL(6,2) CHECK (points_earned >= 0);
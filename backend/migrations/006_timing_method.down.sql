@@ -0,0 +1,7 @@
+ALTER TABLE time_standards DROP COLUMN manual_time_policy;
+
+ALTER TABLE swimmers
+DROP COLUMN semi_automatic_adjustment_ms,
+DROP COLUMN manual_adjustment_ms;
+
+ALTER TABLE times DROP COLUMN timing_method;
@@ -0,0 +1,16 @@
+-- Record how each time was taken
+ALTER TABLE times
+ADD COLUMN timing_method VARCHAR(16) NOT NULL DEFAULT 'electronic'
+CHECK (timing_method IN ('electronic', 'semi_automatic', 'manual'));
+
+-- Per-swimmer adjustments added to non-electronic times when comparing
+ALTER TABLE swimmers
+ADD COLUMN manual_adjustment_ms INTEGER NOT NULL DEFAULT 240
+CHECK (manual_adjustment_ms >= 0 AND manual_adjustment_ms <= 10000),
+ADD COLUMN semi_automatic_adjustment_ms INTEGER NOT NULL DEFAULT 140
+CHECK (semi_automatic_adjustment_ms >= 0 AND semi_automatic_adjustment_ms <= 10000);
+
+-- How a standard treats manual and semi-automatic times
+ALTER TABLE time_standards
+ADD COLUMN manual_time_policy VARCHAR(16) NOT NULL DEFAULT 'accept'
+CHECK (manual_time_policy IN ('accept', 'adjust', 'ineligible'));
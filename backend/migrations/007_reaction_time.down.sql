@@ -0,0 +1 @@
+ALTER TABLE times DROP COLUMN reaction_time_ms;
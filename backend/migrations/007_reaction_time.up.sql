@@ -0,0 +1,3 @@
+-- Record the start reaction time reported by touchpads
+ALTER TABLE times
+ADD COLUMN reaction_time_ms INTEGER CHECK (reaction_time_ms >= 0 AND reaction_time_ms <= 3000);
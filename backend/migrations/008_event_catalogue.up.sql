@@ -0,0 +1,31 @@
+-- Catalogue of swimming events recognised by the application
+CREATE TABLE events (
+    code VARCHAR(20) PRIMARY KEY,
+    distance INTEGER NOT NULL CHECK (distance > 0),
+    stroke VARCHAR(2) NOT NULL CHECK (stroke IN ('FR', 'BK', 'BR', 'FL', 'IM')),
+    course_types VARCHAR(10)[] NOT NULL CHECK (cardinality(course_types) > 0),
+    name VARCHAR(100) NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_events_sort_order ON events(sort_order);
+
+INSERT INTO events (code, distance, stroke, course_types, name, sort_order) VALUES
+    ('50FR', 50, 'FR', '{25m,50m}', '50m Freestyle', 10),
+    ('100FR', 100, 'FR', '{25m,50m}', '100m Freestyle', 20),
+    ('200FR', 200, 'FR', '{25m,50m}', '200m Freestyle', 30),
+    ('400FR', 400, 'FR', '{25m,50m}', '400m Freestyle', 40),
+    ('800FR', 800, 'FR', '{25m,50m}', '800m Freestyle', 50),
+    ('1500FR', 1500, 'FR', '{25m,50m}', '1500m Freestyle', 60),
+    ('50BK', 50, 'BK', '{25m,50m}', '50m Backstroke', 70),
+    ('100BK', 100, 'BK', '{25m,50m}', '100m Backstroke', 80),
+    ('200BK', 200, 'BK', '{25m,50m}', '200m Backstroke', 90),
+    ('50BR', 50, 'BR', '{25m,50m}', '50m Breaststroke', 100),
+    ('100BR', 100, 'BR', '{25m,50m}', '100m Breaststroke', 110),
+    ('200BR', 200, 'BR', '{25m,50m}', '200m Breaststroke', 120),
+    ('50FL', 50, 'FL', '{25m,50m}', '50m Butterfly', 130),
+    ('100FL', 100, 'FL', '{25m,50m}', '100m Butterfly', 140),
+    ('200FL', 200, 'FL', '{25m,50m}', '200m Butterfly', 150),
+    ('200IM', 200, 'IM', '{25m,50m}', '200m Individual Medley', 160),
+    ('400IM', 400, 'IM', '{25m,50m}', '400m Individual Medley', 170);
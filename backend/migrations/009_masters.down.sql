@@ -0,0 +1,3 @@
+DROP INDEX idx_standards_category;
+ALTER TABLE time_standards DROP COLUMN category;
+ALTER TABLE swimmers DROP COLUMN category;
@@ -0,0 +1,10 @@
+-- Competition category: age group (youth) or masters
+ALTER TABLE swimmers
+ADD COLUMN category VARCHAR(16) NOT NULL DEFAULT 'age_group'
+CHECK (category IN ('age_group', 'masters'));
+
+ALTER TABLE time_standards
+ADD COLUMN category VARCHAR(16) NOT NULL DEFAULT 'age_group'
+CHECK (category IN ('age_group', 'masters'));
+
+CREATE INDEX idx_standards_category ON time_standards(category);
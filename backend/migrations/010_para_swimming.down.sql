@@ -0,0 +1,28 @@
+-- Refuse to roll back while data depends on sport classes rather than
+-- deleting it; remove class-specific standard times and swimmer classes first
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM standard_times WHERE sport_class <> '') THEN
+        RAISE EXCEPTION 'cannot roll back: class-specific standard times exist';
+    END IF;
+    IF EXISTS (
+        SELECT 1 FROM swimmers
+        WHERE sport_class_s IS NOT NULL OR sport_class_sb IS NOT NULL OR sport_class_sm IS NOT NULL
+    ) THEN
+        RAISE EXCEPTION 'cannot roll back: swimmers with sport classes exist';
+    END IF;
+END $$;
+
+DROP TABLE para_base_times;
+
+ALTER TABLE standard_times
+DROP CONSTRAINT standard_times_standard_id_event_age_group_sport_class_key,
+ADD CONSTRAINT standard_times_standard_id_event_age_group_key
+UNIQUE (standard_id, event, age_group);
+
+ALTER TABLE standard_times DROP COLUMN sport_class;
+
+ALTER TABLE swimmers
+DROP COLUMN sport_class_sm,
+DROP COLUMN sport_class_sb,
+DROP COLUMN sport_class_s;
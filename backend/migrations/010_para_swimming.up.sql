@@ -0,0 +1,26 @@
+-- Para-swimming sport classes, one per stroke family (NULL when unclassified)
+ALTER TABLE swimmers
+ADD COLUMN sport_class_s VARCHAR(4) CHECK (sport_class_s ~ '^S([1-9]|1[0-4])$'),
+ADD COLUMN sport_class_sb VARCHAR(4) CHECK (sport_class_sb ~ '^SB([1-9]|1[0-4])$'),
+ADD COLUMN sport_class_sm VARCHAR(4) CHECK (sport_class_sm ~ '^SM([1-9]|1[0-4])$');
+
+-- Standard times can be specific to a sport class; '' applies to every swimmer
+ALTER TABLE standard_times
+ADD COLUMN sport_class VARCHAR(4) NOT NULL DEFAULT ''
+CHECK (sport_class = '' OR sport_class ~ '^(S|SB|SM)([1-9]|1[0-4])$');
+
+ALTER TABLE standard_times
+DROP CONSTRAINT standard_times_standard_id_event_age_group_key,
+ADD CONSTRAINT standard_times_standard_id_event_age_group_sport_class_key
+UNIQUE (standard_id, event, age_group, sport_class);
+
+-- World Para Swimming base times used to compute para points
+CREATE TABLE para_base_times (
+    sport_class VARCHAR(4) NOT NULL CHECK (sport_class ~ '^(S|SB|SM)([1-9]|1[0-4])$'),
+    event VARCHAR(20) NOT NULL,
+    gender VARCHAR(10) NOT NULL CHECK (gender IN ('female', 'male')),
+    course_type VARCHAR(3) NOT NULL CHECK (course_type IN ('25m', '50m')),
+    time_ms INTEGER NOT NULL CHECK (time_ms > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (sport_class, event, gender, course_type)
+);
@@ -0,0 +1,19 @@
+-- Refuse to roll back while data depends on the X or mixed categories rather
+-- than deleting it; reassign or remove those rows first
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM swimmers WHERE gender = 'x') THEN
+        RAISE EXCEPTION 'cannot roll back: swimmers with gender x exist';
+    END IF;
+    IF EXISTS (SELECT 1 FROM time_standards WHERE gender IN ('x', 'mixed')) THEN
+        RAISE EXCEPTION 'cannot roll back: time standards with gender x or mixed exist';
+    END IF;
+END $$;
+
+ALTER TABLE time_standards
+DROP CONSTRAINT time_standards_gender_check,
+ADD CONSTRAINT time_standards_gender_check CHECK (gender IN ('female', 'male'));
+
+ALTER TABLE swimmers
+DROP CONSTRAINT swimmers_gender_check,
+ADD CONSTRAINT swimmers_gender_check CHECK (gender IN ('female', 'male'));
@@ -0,0 +1,9 @@
+-- Swimmers may be registered in the X (non-binary) category
+ALTER TABLE swimmers
+DROP CONSTRAINT swimmers_gender_check,
+ADD CONSTRAINT swimmers_gender_check CHECK (gender IN ('female', 'male', 'x'));
+
+-- Standards may target the X category or be gender-neutral (mixed)
+ALTER TABLE time_standards
+DROP CONSTRAINT time_standards_gender_check,
+ADD CONSTRAINT time_standards_gender_check CHECK (gender IN ('female', 'male', 'x', 'mixed'));
@@ -0,0 +1,38 @@
+-- Refuse to roll back while open-water data exists rather than deleting it;
+-- remove those meets, standards and custom events first
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM meets WHERE course_type = 'open_water') THEN
+        RAISE EXCEPTION 'cannot roll back: open-water meets exist';
+    END IF;
+    IF EXISTS (SELECT 1 FROM time_standards WHERE course_type = 'open_water') THEN
+        RAISE EXCEPTION 'cannot roll back: open-water time standards exist';
+    END IF;
+    IF EXISTS (
+        SELECT 1 FROM events
+        WHERE stroke = 'OW' AND code NOT IN ('1500OW', '3000OW', '5000OW', '10000OW')
+    ) THEN
+        RAISE EXCEPTION 'cannot roll back: custom open-water events exist';
+    END IF;
+END $$;
+
+ALTER TABLE times
+DROP COLUMN wetsuit,
+DROP COLUMN water_temperature_c;
+
+-- Only the catalogue rows added by this migration remain
+DELETE FROM events WHERE code IN ('1500OW', '3000OW', '5000OW', '10000OW');
+
+ALTER TABLE events
+DROP CONSTRAINT events_stroke_check,
+ADD CONSTRAINT events_stroke_check CHECK (stroke IN ('FR', 'BK', 'BR', 'FL', 'IM'));
+
+ALTER TABLE time_standards DROP CONSTRAINT time_standards_course_type_check;
+ALTER TABLE time_standards ALTER COLUMN course_type TYPE VARCHAR(3);
+ALTER TABLE time_standards
+ADD CONSTRAINT time_standards_course_type_check CHECK (course_type IN ('25m', '50m'));
+
+ALTER TABLE meets DROP CONSTRAINT meets_course_type_check;
+ALTER TABLE meets ALTER COLUMN course_type TYPE VARCHAR(3);
+ALTER TABLE meets
+ADD CONSTRAINT meets_course_type_check CHECK (course_type IN ('25m', '50m'));
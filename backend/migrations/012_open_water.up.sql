@@ -0,0 +1,26 @@
+-- Open-water races are recorded as their own course type
+ALTER TABLE meets DROP CONSTRAINT meets_course_type_check;
+ALTER TABLE meets ALTER COLUMN course_type TYPE VARCHAR(10);
+ALTER TABLE meets
+ADD CONSTRAINT meets_course_type_check CHECK (course_type IN ('25m', '50m', 'open_water'));
+
+ALTER TABLE time_standards DROP CONSTRAINT time_standards_course_type_check;
+ALTER TABLE time_standards ALTER COLUMN course_type TYPE VARCHAR(10);
+ALTER TABLE time_standards
+ADD CONSTRAINT time_standards_course_type_check CHECK (course_type IN ('25m', '50m', 'open_water'));
+
+-- Open-water events use their own stroke code
+ALTER TABLE events
+DROP CONSTRAINT events_stroke_check,
+ADD CONSTRAINT events_stroke_check CHECK (stroke IN ('FR', 'BK', 'BR', 'FL', 'IM', 'OW'));
+
+INSERT INTO events (code, distance, stroke, course_types, name, sort_order) VALUES
+    ('1500OW', 1500, 'OW', '{open_water}', '1.5km Open Water', 180),
+    ('3000OW', 3000, 'OW', '{open_water}', '3km Open Water', 190),
+    ('5000OW', 5000, 'OW', '{open_water}', '5km Open Water', 200),
+    ('10000OW', 10000, 'OW', '{open_water}', '10km Open Water', 210);
+
+-- Race conditions of open-water swims
+ALTER TABLE times
+ADD COLUMN water_temperature_c DECIMAL(3,1) CHECK (water_temperature_c >= 0 AND water_temperature_c <= 40),
+ADD COLUMN wetsuit BOOLEAN;
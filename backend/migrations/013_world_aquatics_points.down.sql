@@ -0,0 +1 @@
+DROP TABLE world_aquatics_base_times;
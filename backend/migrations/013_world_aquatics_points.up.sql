@@ -0,0 +1,9 @@
+-- World Aquatics base times used to compute points for every swim
+CREATE TABLE world_aquatics_base_times (
+    event VARCHAR(20) NOT NULL,
+    gender VARCHAR(10) NOT NULL CHECK (gender IN ('female', 'male')),
+    course_type VARCHAR(3) NOT NULL CHECK (course_type IN ('25m', '50m')),
+    time_ms INTEGER NOT NULL CHECK (time_ms > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event, gender, course_type)
+);
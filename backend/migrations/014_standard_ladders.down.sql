@@ -0,0 +1,2 @@
+DROP TABLE standard_ladder_tiers;
+DROP TABLE standard_ladders;
@@ -0,0 +1,24 @@
+-- Ladders group standards into ordered tiers (e.g. B, BB, A, AA, AAA, AAAA)
+CREATE TABLE standard_ladders (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT,
+    course_type VARCHAR(10) NOT NULL CHECK (course_type IN ('25m', '50m', 'open_water')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER standard_ladders_updated_at BEFORE UPDATE ON standard_ladders
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+-- Tiers are ordered from the easiest (position 1) to the hardest standard
+CREATE TABLE standard_ladder_tiers (
+    ladder_id UUID NOT NULL REFERENCES standard_ladders(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL CHECK (position > 0),
+    standard_id UUID NOT NULL REFERENCES time_standards(id) ON DELETE CASCADE,
+    label VARCHAR(50),
+    PRIMARY KEY (ladder_id, position),
+    UNIQUE (ladder_id, standard_id)
+);
+
+CREATE INDEX idx_standard_ladder_tiers_standard_id ON standard_ladder_tiers(standard_id);
@@ -0,0 +1 @@
+DROP TABLE comparison_thresholds;
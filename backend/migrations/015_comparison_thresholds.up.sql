@@ -0,0 +1,23 @@
+-- "Almost there" thresholds for a standard, an event or a distance bucket.
+-- A threshold is either a percentage of the standard time or an absolute time.
+-- Without a standard, it applies to every standard.
+CREATE TABLE comparison_thresholds (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    standard_id UUID REFERENCES time_standards(id) ON DELETE CASCADE,
+    event VARCHAR(10),
+    min_distance INTEGER CHECK (min_distance > 0),
+    max_distance INTEGER CHECK (max_distance > 0),
+    percent DECIMAL(5,2) CHECK (percent >= 0 AND percent <= 100),
+    time_ms INTEGER CHECK (time_ms >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((percent IS NULL) <> (time_ms IS NULL)),
+    CHECK (event IS NULL OR (min_distance IS NULL AND max_distance IS NULL)),
+    CHECK (min_distance IS NULL OR max_distance IS NULL OR min_distance <= max_distance),
+    CHECK (standard_id IS NOT NULL OR event IS NOT NULL OR min_distance IS NOT NULL OR max_distance IS NOT NULL)
+);
+
+CREATE TRIGGER comparison_thresholds_updated_at BEFORE UPDATE ON comparison_thresholds
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX idx_comparison_thresholds_standard_id ON comparison_thresholds(standard_id);
@@ -0,0 +1,24 @@
+-- Goals are a target time or a target standard in one event and course,
+-- optionally by a deadline. Status is kept up to date as times are recorded.
+CREATE TABLE goals (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    swimmer_id UUID NOT NULL REFERENCES swimmers(id) ON DELETE CASCADE,
+    event VARCHAR(50) NOT NULL,
+    course_type VARCHAR(10) NOT NULL CHECK (course_type IN ('25m', '50m', 'open_water')),
+    target_time_ms INTEGER CHECK (target_time_ms > 0),
+    standard_id UUID REFERENCES time_standards(id) ON DELETE CASCADE,
+    deadline DATE,
+    notes TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'achieved', 'missed')),
+    achieved_time_id UUID REFERENCES times(id) ON DELETE SET NULL,
+    achieved_date DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((target_time_ms IS NULL) <> (standard_id IS NULL))
+);
+
+CREATE TRIGGER goals_updated_at BEFORE UPDATE ON goals
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX idx_goals_swimmer_id ON goals(swimmer_id);
+CREATE INDEX idx_goals_standard_id ON goals(standard_id);
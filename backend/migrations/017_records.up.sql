@@ -0,0 +1,30 @@
+-- Record tables hold club, provincial or national records. Each record is the
+-- fastest time in an event for one course, gender and age group.
+CREATE TABLE record_tables (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL UNIQUE,
+    scope VARCHAR(20) NOT NULL CHECK (scope IN ('club', 'provincial', 'national')),
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER record_tables_updated_at BEFORE UPDATE ON record_tables
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+CREATE TABLE records (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    record_table_id UUID NOT NULL REFERENCES record_tables(id) ON DELETE CASCADE,
+    event VARCHAR(50) NOT NULL,
+    course_type VARCHAR(10) NOT NULL CHECK (course_type IN ('25m', '50m', 'open_water')),
+    gender VARCHAR(10) NOT NULL CHECK (gender IN ('female', 'male', 'x', 'mixed')),
+    age_group VARCHAR(10) NOT NULL,
+    time_ms INTEGER NOT NULL CHECK (time_ms > 0),
+    holder VARCHAR(255),
+    record_date DATE,
+    location VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (record_table_id, event, course_type, gender, age_group)
+);
+
+CREATE INDEX idx_records_lookup ON records(course_type, event, gender, age_group);
@@ -0,0 +1,2 @@
+DROP TABLE ranking_entries;
+DROP TABLE ranking_snapshots;
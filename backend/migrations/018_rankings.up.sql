@@ -0,0 +1,31 @@
+-- Ranking snapshots are imported ranking lists, such as a provincial top 50,
+-- for one season, event, course, gender and age group.
+CREATE TABLE ranking_snapshots (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    source VARCHAR(255) NOT NULL,
+    season VARCHAR(7) NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    course_type VARCHAR(10) NOT NULL CHECK (course_type IN ('25m', '50m', 'open_water')),
+    gender VARCHAR(10) NOT NULL CHECK (gender IN ('female', 'male', 'x')),
+    age_group VARCHAR(10) NOT NULL,
+    captured_on DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (source, season, event, course_type, gender, age_group)
+);
+
+CREATE TRIGGER ranking_snapshots_updated_at BEFORE UPDATE ON ranking_snapshots
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX idx_ranking_snapshots_lookup ON ranking_snapshots(season, course_type, event, gender, age_group);
+
+CREATE TABLE ranking_entries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    snapshot_id UUID NOT NULL REFERENCES ranking_snapshots(id) ON DELETE CASCADE,
+    rank INTEGER NOT NULL CHECK (rank > 0),
+    swimmer_name VARCHAR(255),
+    club VARCHAR(255),
+    time_ms INTEGER NOT NULL CHECK (time_ms > 0)
+);
+
+CREATE INDEX idx_ranking_entries_snapshot ON ranking_entries(snapshot_id, time_ms);
@@ -0,0 +1,2 @@
+DROP TABLE reference_times;
+DROP TABLE reference_swimmers;
@@ -0,0 +1,28 @@
+-- Reference swimmers are other swimmers' histories, such as an older
+-- sibling's archive or a role model's published results, loaded from an
+-- export file to benchmark against.
+CREATE TABLE reference_swimmers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL UNIQUE,
+    birth_date DATE NOT NULL,
+    gender VARCHAR(10) NOT NULL CHECK (gender IN ('female', 'male', 'x')),
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER reference_swimmers_updated_at BEFORE UPDATE ON reference_swimmers
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+CREATE TABLE reference_times (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    reference_swimmer_id UUID NOT NULL REFERENCES reference_swimmers(id) ON DELETE CASCADE,
+    event VARCHAR(50) NOT NULL,
+    course_type VARCHAR(10) NOT NULL CHECK (course_type IN ('25m', '50m', 'open_water')),
+    time_ms INTEGER NOT NULL CHECK (time_ms > 0),
+    event_date DATE NOT NULL,
+    meet_name VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_reference_times_swimmer ON reference_times(reference_swimmer_id, course_type, event);
@@ -0,0 +1,162 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type AchievementEntry struct {
+	Event        string `json:"event"`
+	TimeMS       int    `json:"time_ms"`
+	MeetName     string `json:"meet_name"`
+	Date         string `json:"date"`
+	AgeGroup     string `json:"age_group"`
+	StandardID   string `json:"standard_id"`
+	StandardName string `json:"standard_name"`
+}
+
+type AchievementTimeline struct {
+	Standards []struct {
+		StandardID   string             `json:"standard_id"`
+		StandardName string             `json:"standard_name"`
+		Achievements []AchievementEntry `json:"achievements"`
+	} `json:"standards"`
+	Feed []AchievementEntry `json:"feed"`
+}
+
+func TestAchievementsAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Timeline Swimmer", BirthDate: "2012-03-10", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	createStandard := func(t *testing.T, name, gender string, times []StandardTimeInput) string {
+		t.Helper()
+		rr := client.Post("/api/v1/standards/import", StandardImportInput{
+			Name: name, CourseType: "25m", Gender: gender, Times: times,
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var std StandardWithTimes
+		AssertJSONBody(t, rr, &std)
+		return std.ID
+	}
+
+	provincialID := createStandard(t, "Provincial", "female", []StandardTimeInput{
+		{Event: "50FR", AgeGroup: "11-12", TimeMs: 36000},
+		{Event: "50FR", AgeGroup: "OPEN", TimeMs: 33000},
+		{Event: "100FR", AgeGroup: "OPEN", TimeMs: 75000},
+	})
+	nationalID := createStandard(t, "National", "female", []StandardTimeInput{
+		{Event: "50FR", AgeGroup: "OPEN", TimeMs: 31000},
+	})
+	boysID := createStandard(t, "Boys Provincial", "male", []StandardTimeInput{
+		{Event: "50FR", AgeGroup: "OPEN", TimeMs: 40000},
+	})
+
+	createMeet := func(t *testing.T, name, date string) string {
+		t.Helper()
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: name, City: "Ottawa", StartDate: date, EndDate: date, CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+		return meet.ID
+	}
+	createTime := func(t *testing.T, meetID, event string, timeMS int, date string) {
+		t.Helper()
+		rr := client.Post("/api/v1/times", TimeInput{MeetID: meetID, Event: event, TimeMS: timeMS, EventDate: date})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	// Age 12: meets Provincial for 11-12
+	createTime(t, createMeet(t, "Spring Splash", "2024-05-01"), "50FR", 35500, "2024-05-01")
+	// Age 13: slower than the OPEN time, so no new achievement
+	fallMeet := createMeet(t, "Fall Classic", "2025-10-01")
+	createTime(t, fallMeet, "50FR", 34000, "2025-10-01")
+	createTime(t, fallMeet, "100FR", 74000, "2025-10-01")
+	// Age 14: National
+	createTime(t, createMeet(t, "Winter Champs", "2026-02-01"), "50FR", 30900, "2026-02-01")
+
+	t.Run("GET /achievements lists first achievements per standard", func(t *testing.T) {
+		rr := client.Get("/api/v1/achievements")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var timeline AchievementTimeline
+		AssertJSONBody(t, rr, &timeline)
+		require.Len(t, timeline.Standards, 2, "male standard should not apply")
+
+		byName := make(map[string][]AchievementEntry)
+		for _, std := range timeline.Standards {
+			byName[std.StandardName] = std.Achievements
+		}
+
+		provincial := byName["Provincial"]
+		require.Len(t, provincial, 2)
+		assert.Equal(t, "50FR", provincial[0].Event)
+		assert.Equal(t, "2024-05-01", provincial[0].Date)
+		assert.Equal(t, "Spring Splash", provincial[0].MeetName)
+		assert.Equal(t, "11-12", provincial[0].AgeGroup)
+		assert.Equal(t, "100FR", provincial[1].Event)
+		assert.Equal(t, "2025-10-01", provincial[1].Date)
+
+		national := byName["National"]
+		require.Len(t, national, 1)
+		assert.Equal(t, 30900, national[0].TimeMS)
+		assert.Equal(t, "2026-02-01", national[0].Date)
+	})
+
+	t.Run("GET /achievements returns a chronological feed", func(t *testing.T) {
+		rr := client.Get("/api/v1/achievements?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var timeline AchievementTimeline
+		AssertJSONBody(t, rr, &timeline)
+		require.Len(t, timeline.Feed, 3)
+		assert.Equal(t, "2024-05-01", timeline.Feed[0].Date)
+		assert.Equal(t, provincialID, timeline.Feed[0].StandardID)
+		assert.Equal(t, "100FR", timeline.Feed[1].Event)
+		assert.Equal(t, "National", timeline.Feed[2].StandardName)
+	})
+
+	t.Run("GET /achievements filters by standard", func(t *testing.T) {
+		rr := client.Get("/api/v1/achievements?standard_ids=" + nationalID)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var timeline AchievementTimeline
+		AssertJSONBody(t, rr, &timeline)
+		require.Len(t, timeline.Standards, 1)
+		assert.Len(t, timeline.Feed, 1)
+	})
+
+	t.Run("GET /achievements rejects invalid filters", func(t *testing.T) {
+		rr := client.Get("/api/v1/achievements?standard_ids=" + boysID)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Get("/api/v1/achievements?standard_ids=not-a-uuid")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Get("/api/v1/achievements?course_type=50m&standard_ids=" + nationalID)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Get("/api/v1/achievements?standard_ids=" + uuid.New().String())
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,123 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type AgeGroupBestList struct {
+	CurrentAgeGroup string `json:"current_age_group"`
+	Events          []struct {
+		Event      string `json:"event"`
+		CourseType string `json:"course_type"`
+		AgeGroups  []struct {
+			AgeGroup       string `json:"age_group"`
+			TimeID         string `json:"time_id"`
+			TimeMS         int    `json:"time_ms"`
+			Date           string `json:"date"`
+			IsPersonalBest bool   `json:"is_pb"`
+		} `json:"age_groups"`
+	} `json:"events"`
+}
+
+func TestAgeGroupBestsAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	// Born May 2012: 11-12 until the 13th birthday in May 2025, 13-14 after
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Age Group Swimmer", BirthDate: "2012-05-01", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	swims := []struct {
+		date   string
+		timeMS int
+	}{
+		{"2023-06-01", 85000},
+		{"2024-06-01", 80000},
+		{"2024-11-01", 82000},
+		{"2025-05-15", 79000},
+		{"2025-06-01", 81000},
+	}
+	ids := make(map[int]string)
+	for _, s := range swims {
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: "Age Group Meet " + s.date, City: "Ottawa", StartDate: s.date, EndDate: s.date, CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: "100FR", TimeMS: s.timeMS, EventDate: s.date})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var swim TimeRecord
+		AssertJSONBody(t, rr, &swim)
+		ids[s.timeMS] = swim.ID
+	}
+
+	t.Run("GET /personal-bests/age-groups returns the best per age group", func(t *testing.T) {
+		rr := client.Get("/api/v1/personal-bests/age-groups?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list AgeGroupBestList
+		AssertJSONBody(t, rr, &list)
+
+		require.Len(t, list.Events, 1)
+		groups := list.Events[0].AgeGroups
+		require.Len(t, groups, 2)
+
+		assert.Equal(t, "11-12", groups[0].AgeGroup)
+		assert.Equal(t, 80000, groups[0].TimeMS)
+		assert.Equal(t, ids[80000], groups[0].TimeID)
+		assert.False(t, groups[0].IsPersonalBest)
+
+		assert.Equal(t, "13-14", groups[1].AgeGroup)
+		assert.Equal(t, 79000, groups[1].TimeMS)
+		assert.Equal(t, "2025-05-15", groups[1].Date)
+		assert.True(t, groups[1].IsPersonalBest)
+
+		rr = client.Get("/api/v1/personal-bests/age-groups?course_type=50m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var empty AgeGroupBestList
+		AssertJSONBody(t, rr, &empty)
+		assert.Empty(t, empty.Events)
+
+		rr = client.Get("/api/v1/personal-bests/age-groups?course_type=short")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("GET /times flags age-group bests", func(t *testing.T) {
+		rr := client.Get("/api/v1/times?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list TimeList
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.Times, 5)
+
+		for _, swim := range list.Times {
+			switch swim.TimeMS {
+			case 80000, 79000:
+				assert.True(t, swim.IsAgeGroupBest, swim.TimeMS)
+			default:
+				assert.False(t, swim.IsAgeGroupBest, swim.TimeMS)
+			}
+			if swim.TimeMS >= 82000 || swim.TimeMS == 80000 {
+				assert.Equal(t, "11-12", swim.AgeGroup)
+			} else {
+				assert.Equal(t, "13-14", swim.AgeGroup)
+			}
+		}
+	})
+}
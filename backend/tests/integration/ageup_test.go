@@ -0,0 +1,145 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type AgeUpPlan struct {
+	CurrentAgeGroup string  `json:"current_age_group"`
+	NextAgeGroup    *string `json:"next_age_group"`
+	AgeUpDate       *string `json:"age_up_date"`
+	DaysUntilAgeUp  *int    `json:"days_until_age_up"`
+	Targets         []struct {
+		Rank                 int      `json:"rank"`
+		Event                string   `json:"event"`
+		NewAgeGroup          string   `json:"new_age_group"`
+		NewStandardTimeMS    int      `json:"new_standard_time_ms"`
+		DropMS               *int     `json:"drop_ms"`
+		Achieved             bool     `json:"achieved"`
+		MonthlyImprovementMS *int     `json:"monthly_improvement_ms"`
+		MonthsNeeded         *float64 `json:"months_needed"`
+		OnTrack              bool     `json:"on_track"`
+	} `json:"targets"`
+}
+
+func TestAgeUpPlanAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	// 12 years old now, turning 13 in three months
+	now := time.Now().UTC()
+	birthDate := now.AddDate(-13, 3, 0)
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Age Up Swimmer", BirthDate: birthDate.Format("2006-01-02"), Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	rr = client.Post("/api/v1/standards/import", StandardImportInput{
+		Name: "Provincial", CourseType: "25m", Gender: "female",
+		Times: []StandardTimeInput{
+			{Event: "50FR", AgeGroup: "11-12", TimeMs: 36000},
+			{Event: "50FR", AgeGroup: "13-14", TimeMs: 33000},
+			{Event: "100FR", AgeGroup: "11-12", TimeMs: 80000},
+			{Event: "100FR", AgeGroup: "13-14", TimeMs: 74000},
+			{Event: "100BK", AgeGroup: "13-14", TimeMs: 90000},
+			{Event: "200FR", AgeGroup: "11-12", TimeMs: 170000},
+		},
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	createMeet := func(t *testing.T, name string, date time.Time) string {
+		t.Helper()
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: name, City: "Halifax", StartDate: date.Format("2006-01-02"), EndDate: date.Format("2006-01-02"), CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+		return meet.ID
+	}
+	oldDate, recentDate := now.AddDate(0, -18, 0), now.AddDate(0, -1, 0)
+	oldMeet := createMeet(t, "Old Invitational", oldDate)
+	recentMeet := createMeet(t, "Recent Invitational", recentDate)
+	for _, swim := range []struct {
+		meetID, event string
+		date          time.Time
+		timeMS        int
+	}{
+		{oldMeet, "50FR", oldDate, 36000},
+		{recentMeet, "50FR", recentDate, 34000},
+		{oldMeet, "100FR", oldDate, 80000},
+		{recentMeet, "100FR", recentDate, 74500},
+		{recentMeet, "100BK", recentDate, 89000},
+	} {
+		rr := client.Post("/api/v1/times", TimeInput{MeetID: swim.meetID, Event: swim.event, TimeMS: swim.timeMS, EventDate: swim.date.Format("2006-01-02")})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	t.Run("GET /comparisons/age-up reports the age-up date", func(t *testing.T) {
+		rr := client.Get("/api/v1/comparisons/age-up?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var plan AgeUpPlan
+		AssertJSONBody(t, rr, &plan)
+		assert.Equal(t, "11-12", plan.CurrentAgeGroup)
+		require.NotNil(t, plan.NextAgeGroup)
+		assert.Equal(t, "13-14", *plan.NextAgeGroup)
+		require.NotNil(t, plan.AgeUpDate)
+		assert.Equal(t, birthDate.AddDate(13, 0, 0).Format("2006-01-02"), *plan.AgeUpDate)
+		require.NotNil(t, plan.DaysUntilAgeUp)
+		assert.Greater(t, *plan.DaysUntilAgeUp, 80)
+	})
+
+	t.Run("GET /comparisons/age-up ranks the new cuts by achievability", func(t *testing.T) {
+		rr := client.Get("/api/v1/comparisons/age-up")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var plan AgeUpPlan
+		AssertJSONBody(t, rr, &plan)
+		require.Len(t, plan.Targets, 3, "200FR has no cut after the age-up")
+
+		assert.Equal(t, "100BK", plan.Targets[0].Event)
+		assert.True(t, plan.Targets[0].Achieved)
+		assert.Equal(t, 1, plan.Targets[0].Rank)
+
+		hundred := plan.Targets[1]
+		assert.Equal(t, "100FR", hundred.Event)
+		assert.Equal(t, "13-14", hundred.NewAgeGroup)
+		require.NotNil(t, hundred.DropMS)
+		assert.Equal(t, 500, *hundred.DropMS)
+		require.NotNil(t, hundred.MonthlyImprovementMS)
+		assert.Greater(t, *hundred.MonthlyImprovementMS, 0)
+		assert.True(t, hundred.OnTrack)
+
+		fifty := plan.Targets[2]
+		assert.Equal(t, "50FR", fifty.Event)
+		require.NotNil(t, fifty.DropMS)
+		assert.Equal(t, 1000, *fifty.DropMS)
+		require.NotNil(t, fifty.MonthsNeeded)
+		assert.InDelta(t, 6.0, *fifty.MonthsNeeded, 0.5)
+		assert.False(t, fifty.OnTrack)
+	})
+
+	t.Run("GET /comparisons/age-up validates the filters", func(t *testing.T) {
+		rr := client.Get("/api/v1/comparisons/age-up?course_type=short")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Get("/api/v1/comparisons/age-up?standard_ids=nope")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
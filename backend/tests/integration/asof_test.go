@@ -0,0 +1,124 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type AsOfComparisonResult struct {
+	SwimmerAgeGroup string  `json:"swimmer_age_group"`
+	AsOfDate        *string `json:"as_of_date"`
+	Comparisons     []struct {
+		Event         string `json:"event"`
+		Status        string `json:"status"`
+		AgeGroup      string `json:"age_group"`
+		SwimmerTimeMS *int   `json:"swimmer_time_ms"`
+	} `json:"comparisons"`
+}
+
+func TestComparisonAsOfDate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "History Swimmer", BirthDate: "2012-03-10", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	rr = client.Post("/api/v1/standards/import", StandardImportInput{
+		Name: "Provincial", CourseType: "25m", Gender: "female",
+		Times: []StandardTimeInput{
+			{Event: "50FR", AgeGroup: "11-12", TimeMs: 36000},
+			{Event: "50FR", AgeGroup: "13-14", TimeMs: 33000},
+			{Event: "50FR", AgeGroup: "OPEN", TimeMs: 32000},
+		},
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var std StandardWithTimes
+	AssertJSONBody(t, rr, &std)
+
+	for _, swim := range []struct {
+		name, date string
+		timeMS     int
+	}{
+		{"Spring Splash", "2024-05-01", 35500},
+		{"Winter Champs", "2026-02-01", 31200},
+	} {
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: swim.name, City: "Kingston", StartDate: swim.date, EndDate: swim.date, CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: "50FR", TimeMS: swim.timeMS, EventDate: swim.date})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	// compare returns the comparison result and its 50FR row.
+	compare := func(t *testing.T, query string) (AsOfComparisonResult, int) {
+		t.Helper()
+		rr := client.Get("/api/v1/comparisons?standard_id=" + std.ID + "&course_type=25m" + query)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var result AsOfComparisonResult
+		AssertJSONBody(t, rr, &result)
+		for i, comp := range result.Comparisons {
+			if comp.Event == "50FR" {
+				require.NotNil(t, comp.SwimmerTimeMS)
+				return result, i
+			}
+		}
+		t.Fatal("50FR comparison missing")
+		return result, -1
+	}
+
+	t.Run("GET /comparisons uses current PBs without a date", func(t *testing.T) {
+		result, i := compare(t, "")
+		assert.Nil(t, result.AsOfDate)
+		assert.Equal(t, "achieved", result.Comparisons[i].Status)
+		assert.Equal(t, 31200, *result.Comparisons[i].SwimmerTimeMS)
+	})
+
+	t.Run("GET /comparisons?date uses the age group at that date", func(t *testing.T) {
+		result, i := compare(t, "&date=2024-12-01")
+		require.NotNil(t, result.AsOfDate)
+		assert.Equal(t, "2024-12-01", *result.AsOfDate)
+		assert.Equal(t, "11-12", result.SwimmerAgeGroup)
+		assert.Equal(t, "achieved", result.Comparisons[i].Status)
+		assert.Equal(t, 35500, *result.Comparisons[i].SwimmerTimeMS)
+	})
+
+	t.Run("GET /comparisons?date ignores later times", func(t *testing.T) {
+		result, i := compare(t, "&date=2025-06-01")
+		assert.Equal(t, "13-14", result.SwimmerAgeGroup)
+		assert.Equal(t, "13-14", result.Comparisons[i].AgeGroup)
+		assert.Equal(t, "not_achieved", result.Comparisons[i].Status)
+		assert.Equal(t, 35500, *result.Comparisons[i].SwimmerTimeMS)
+	})
+
+	t.Run("GET /comparisons?date validates the date", func(t *testing.T) {
+		rr := client.Get("/api/v1/comparisons?standard_id=" + std.ID + "&date=01/06/2025")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		future := time.Now().AddDate(0, 1, 0).Format("2006-01-02")
+		rr = client.Get("/api/v1/comparisons?standard_id=" + std.ID + "&date=" + future)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Get("/api/v1/comparisons?standard_id=" + std.ID + "&date=2010-01-01")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,133 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type CatalogueEvent struct {
+	Code        string   `json:"code"`
+	Distance    int      `json:"distance"`
+	Stroke      string   `json:"stroke"`
+	StrokeName  string   `json:"stroke_name"`
+	CourseTypes []string `json:"course_types"`
+	Name        string   `json:"name"`
+	SortOrder   int      `json:"sort_order"`
+}
+
+type EventInput struct {
+	Code        string   `json:"code"`
+	Distance    int      `json:"distance"`
+	Stroke      string   `json:"stroke"`
+	CourseTypes []string `json:"course_types"`
+	Name        string   `json:"name,omitempty"`
+}
+
+type EventList struct {
+	Events []CatalogueEvent `json:"events"`
+}
+
+func TestEventAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	// Events added here must not leak into other tests
+	defer testDB.ExecSQL(t, "DELETE FROM events WHERE code = '25FR'")
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	t.Run("GET /events lists the catalogue in display order", func(t *testing.T) {
+		rr := client.Get("/api/v1/events")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var list EventList
+		AssertJSONBody(t, rr, &list)
+		require.GreaterOrEqual(t, len(list.Events), 17)
+
+		first := list.Events[0]
+		assert.Equal(t, "50FR", first.Code)
+		assert.Equal(t, 50, first.Distance)
+		assert.Equal(t, "FR", first.Stroke)
+		assert.Equal(t, "Freestyle", first.StrokeName)
+		assert.Equal(t, "50m Freestyle", first.Name)
+		assert.ElementsMatch(t, []string{"25m", "50m"}, first.CourseTypes)
+	})
+
+	t.Run("POST /events adds an event usable for times", func(t *testing.T) {
+		rr := client.Post("/api/v1/events", EventInput{Code: "25fr", Distance: 25, Stroke: "FR", CourseTypes: []string{"25m"}})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var created CatalogueEvent
+		AssertJSONBody(t, rr, &created)
+		assert.Equal(t, "25FR", created.Code)
+		assert.Equal(t, "25m Freestyle", created.Name)
+
+		rr = client.Get("/api/v1/events?course_type=50m")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var longCourse EventList
+		AssertJSONBody(t, rr, &longCourse)
+		for _, e := range longCourse.Events {
+			assert.NotEqual(t, "25FR", e.Code)
+		}
+
+		rr = client.Put("/api/v1/swimmer", SwimmerInput{Name: "Event Swimmer", BirthDate: "2018-05-15", Gender: "female"})
+		require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK)
+
+		rr = client.Post("/api/v1/meets", MeetInput{Name: "Mini Meet", City: "Toronto", StartDate: "2026-02-07", CourseType: "25m"})
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var shortCourse Meet
+		AssertJSONBody(t, rr, &shortCourse)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: shortCourse.ID, Event: "25FR", TimeMS: 21450, EventDate: "2026-02-07"})
+		assert.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		rr = client.Post("/api/v1/meets", MeetInput{Name: "Long Course Meet", City: "Toronto", StartDate: "2026-06-06", CourseType: "50m"})
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var longCourseMeet Meet
+		AssertJSONBody(t, rr, &longCourseMeet)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: longCourseMeet.ID, Event: "25FR", TimeMS: 21450, EventDate: "2026-06-06"})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("POST /events validates input", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			input EventInput
+		}{
+			{name: "duplicate code", input: EventInput{Code: "50FR", Distance: 50, Stroke: "FR", CourseTypes: []string{"25m"}}},
+			{name: "invalid stroke", input: EventInput{Code: "50XX", Distance: 50, Stroke: "XX", CourseTypes: []string{"25m"}}},
+			{name: "code without stroke suffix", input: EventInput{Code: "100FR", Distance: 100, Stroke: "BK", CourseTypes: []string{"25m"}}},
+			{name: "missing course types", input: EventInput{Code: "75FR", Distance: 75, Stroke: "FR"}},
+			{name: "invalid course type", input: EventInput{Code: "75FR", Distance: 75, Stroke: "FR", CourseTypes: []string{"33m"}}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := client.Post("/api/v1/events", tc.input)
+				assert.Equal(t, http.StatusBadRequest, rr.Code)
+			})
+		}
+	})
+
+	t.Run("view-only access cannot add events", func(t *testing.T) {
+		client.SetMockUser("view_only")
+		defer client.SetMockUser("full")
+
+		rr := client.Post("/api/v1/events", EventInput{Code: "75FR", Distance: 75, Stroke: "FR", CourseTypes: []string{"25m"}})
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
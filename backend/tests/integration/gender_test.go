@@ -0,0 +1,86 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenderCategoriesAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	t.Run("PUT /swimmer accepts the x gender", func(t *testing.T) {
+		rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "X Swimmer", BirthDate: "2011-09-01", Gender: "x"})
+		require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+		var swimmer Swimmer
+		AssertJSONBody(t, rr, &swimmer)
+		assert.Equal(t, "x", swimmer.Gender)
+	})
+
+	t.Run("PUT /swimmer rejects mixed as a swimmer gender", func(t *testing.T) {
+		rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "X Swimmer", BirthDate: "2011-09-01", Gender: "mixed"})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	createStandard := func(t *testing.T, name, gender string) string {
+		t.Helper()
+		rr := client.Post("/api/v1/standards/import", StandardImportInput{
+			Name: name, CourseType: "25m", Gender: gender,
+			Times: []StandardTimeInput{{Event: "50FR", AgeGroup: "OPEN", TimeMs: 31000}},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var std StandardWithTimes
+		AssertJSONBody(t, rr, &std)
+		return std.ID
+	}
+
+	mixedID := createStandard(t, "Club Mixed", "mixed")
+	xID := createStandard(t, "Provincial X", "x")
+	femaleID := createStandard(t, "Provincial Female", "female")
+
+	t.Run("GET /standards lists standards that apply to a swimmer gender", func(t *testing.T) {
+		rr := client.Get("/api/v1/standards?swimmer_gender=x")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var list StandardList
+		AssertJSONBody(t, rr, &list)
+		ids := make([]string, len(list.Standards))
+		for i, s := range list.Standards {
+			ids[i] = s.ID
+		}
+		assert.ElementsMatch(t, []string{mixedID, xID}, ids)
+
+		rr = client.Get("/api/v1/standards?gender=mixed")
+		require.Equal(t, http.StatusOK, rr.Code)
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.Standards, 1)
+		assert.Equal(t, mixedID, list.Standards[0].ID)
+	})
+
+	t.Run("GET /comparisons only allows standards that apply to the swimmer", func(t *testing.T) {
+		rr := client.Get("/api/v1/comparisons?standard_id=" + mixedID + "&course_type=25m")
+		assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/comparisons?standard_id=" + xID + "&course_type=25m")
+		assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/comparisons?standard_id=" + femaleID + "&course_type=25m")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,213 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type GoalInput struct {
+	Event        string  `json:"event"`
+	CourseType   string  `json:"course_type"`
+	TargetTimeMS *int    `json:"target_time_ms,omitempty"`
+	StandardID   *string `json:"standard_id,omitempty"`
+	Deadline     string  `json:"deadline,omitempty"`
+	Notes        string  `json:"notes,omitempty"`
+}
+
+type Goal struct {
+	ID             string  `json:"id"`
+	Event          string  `json:"event"`
+	Status         string  `json:"status"`
+	AchievedTimeID *string `json:"achieved_time_id"`
+	AchievedDate   *string `json:"achieved_date"`
+	StandardName   *string `json:"standard_name"`
+	GoalTimeMS     *int    `json:"goal_time_ms"`
+	BestTimeMS     *int    `json:"best_time_ms"`
+	GapMS          *int    `json:"gap_ms"`
+	DaysLeft       *int    `json:"days_left"`
+}
+
+type GoalList struct {
+	Goals   []Goal `json:"goals"`
+	Summary struct {
+		Active   int `json:"active"`
+		Achieved int `json:"achieved"`
+		Missed   int `json:"missed"`
+	} `json:"summary"`
+}
+
+func TestGoalAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Goal Swimmer", BirthDate: "2012-05-01", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	rr = client.Post("/api/v1/standards/import", StandardImportInput{
+		Name: "Provincial", CourseType: "25m", Gender: "female",
+		Times: []StandardTimeInput{
+			{Event: "100FR", AgeGroup: "OPEN", TimeMs: 70000},
+		},
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var std StandardWithTimes
+	AssertJSONBody(t, rr, &std)
+
+	rr = client.Post("/api/v1/meets", MeetInput{
+		Name: "Goal Open", City: "Ottawa", StartDate: "2025-11-01", EndDate: "2025-11-01", CourseType: "25m",
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var meet Meet
+	AssertJSONBody(t, rr, &meet)
+
+	rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: "50FR", TimeMS: 31000, EventDate: "2025-11-01"})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: "100FR", TimeMS: 71000, EventDate: "2025-11-01"})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	ms := func(v int) *int { return &v }
+	future := time.Now().AddDate(0, 6, 0).Format("2006-01-02")
+
+	var timeGoal, standardGoal, missedGoal Goal
+
+	t.Run("POST /goals creates a target time goal", func(t *testing.T) {
+		rr := client.Post("/api/v1/goals", GoalInput{Event: "50FR", CourseType: "25m", TargetTimeMS: ms(30000), Deadline: future})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		AssertJSONBody(t, rr, &timeGoal)
+
+		assert.Equal(t, "active", timeGoal.Status)
+		require.NotNil(t, timeGoal.GapMS)
+		assert.Equal(t, 1000, *timeGoal.GapMS)
+		require.NotNil(t, timeGoal.DaysLeft)
+		assert.Greater(t, *timeGoal.DaysLeft, 150)
+	})
+
+	t.Run("POST /goals creates a standard goal", func(t *testing.T) {
+		rr := client.Post("/api/v1/goals", GoalInput{Event: "100FR", CourseType: "25m", StandardID: &std.ID})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		AssertJSONBody(t, rr, &standardGoal)
+
+		assert.Equal(t, "active", standardGoal.Status)
+		require.NotNil(t, standardGoal.StandardName)
+		assert.Equal(t, "Provincial", *standardGoal.StandardName)
+		require.NotNil(t, standardGoal.GoalTimeMS)
+		assert.Equal(t, 70000, *standardGoal.GoalTimeMS)
+		require.NotNil(t, standardGoal.GapMS)
+		assert.Equal(t, 1000, *standardGoal.GapMS)
+	})
+
+	t.Run("POST /goals marks a goal past its deadline as missed", func(t *testing.T) {
+		rr := client.Post("/api/v1/goals", GoalInput{Event: "50FR", CourseType: "25m", TargetTimeMS: ms(29000), Deadline: "2025-12-31"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		AssertJSONBody(t, rr, &missedGoal)
+		assert.Equal(t, "missed", missedGoal.Status)
+	})
+
+	t.Run("POST /goals rejects invalid goals", func(t *testing.T) {
+		rr := client.Post("/api/v1/goals", GoalInput{Event: "50FR", CourseType: "25m"})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Post("/api/v1/goals", GoalInput{Event: "50FR", CourseType: "25m", TargetTimeMS: ms(30000), StandardID: &std.ID})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Post("/api/v1/goals", GoalInput{Event: "100FR", CourseType: "50m", StandardID: &std.ID})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("adding a time updates goal statuses", func(t *testing.T) {
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: "Goal Finals", City: "Ottawa", StartDate: "2025-12-06", EndDate: "2025-12-06", CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var finals Meet
+		AssertJSONBody(t, rr, &finals)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: finals.ID, Event: "100FR", TimeMS: 69500, EventDate: "2025-12-06"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var swim TimeRecord
+		AssertJSONBody(t, rr, &swim)
+
+		rr = client.Get("/api/v1/goals/" + standardGoal.ID)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var g Goal
+		AssertJSONBody(t, rr, &g)
+		assert.Equal(t, "achieved", g.Status)
+		require.NotNil(t, g.AchievedTimeID)
+		assert.Equal(t, swim.ID, *g.AchievedTimeID)
+		require.NotNil(t, g.AchievedDate)
+		assert.Equal(t, "2025-12-06", *g.AchievedDate)
+		assert.Nil(t, g.DaysLeft)
+
+		// Deleting the swim reopens the goal
+		rr = client.Delete("/api/v1/times/" + swim.ID)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/goals/" + standardGoal.ID)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var reopened Goal
+		AssertJSONBody(t, rr, &reopened)
+		assert.Equal(t, "active", reopened.Status)
+		assert.Nil(t, reopened.AchievedTimeID)
+	})
+
+	t.Run("GET /goals filters by status", func(t *testing.T) {
+		rr := client.Get("/api/v1/goals")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list GoalList
+		AssertJSONBody(t, rr, &list)
+		assert.Len(t, list.Goals, 3)
+		assert.Equal(t, 2, list.Summary.Active)
+		assert.Equal(t, 1, list.Summary.Missed)
+
+		rr = client.Get("/api/v1/goals?status=missed")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.Goals, 1)
+		assert.Equal(t, missedGoal.ID, list.Goals[0].ID)
+
+		rr = client.Get("/api/v1/goals?status=done")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("PUT /goals/{id} re-evaluates the goal", func(t *testing.T) {
+		rr := client.Put("/api/v1/goals/"+timeGoal.ID, GoalInput{Event: "50FR", CourseType: "25m", TargetTimeMS: ms(31000), Deadline: future})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var g Goal
+		AssertJSONBody(t, rr, &g)
+		assert.Equal(t, "achieved", g.Status)
+		require.NotNil(t, g.GapMS)
+		assert.Equal(t, 0, *g.GapMS)
+	})
+
+	t.Run("DELETE /goals/{id} deletes the goal", func(t *testing.T) {
+		rr := client.Delete("/api/v1/goals/" + missedGoal.ID)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/goals/" + missedGoal.ID)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("view-only users cannot create goals", func(t *testing.T) {
+		client.SetMockUser("view_only")
+		defer client.SetMockUser("full")
+
+		rr := client.Post("/api/v1/goals", GoalInput{Event: "50FR", CourseType: "25m", TargetTimeMS: ms(30000)})
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
@@ -0,0 +1,267 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type LadderTierInput struct {
+	StandardID string `json:"standard_id"`
+	Label      string `json:"label,omitempty"`
+}
+
+type LadderInput struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	CourseType  string            `json:"course_type"`
+	Tiers       []LadderTierInput `json:"tiers"`
+}
+
+type LadderTier struct {
+	Position     int    `json:"position"`
+	StandardID   string `json:"standard_id"`
+	StandardName string `json:"standard_name"`
+	Label        string `json:"label"`
+}
+
+type Ladder struct {
+	ID         string       `json:"id"`
+	Name       string       `json:"name"`
+	CourseType string       `json:"course_type"`
+	Tiers      []LadderTier `json:"tiers"`
+}
+
+type LadderList struct {
+	Ladders []Ladder `json:"ladders"`
+}
+
+type LadderTierStatus struct {
+	Position      int    `json:"position"`
+	Label         string `json:"label"`
+	Status        string `json:"status"`
+	DifferenceMS  *int   `json:"difference_ms"`
+	FirstAchieved *struct {
+		TimeMS   int    `json:"time_ms"`
+		Date     string `json:"date"`
+		AgeGroup string `json:"age_group"`
+	} `json:"first_achieved"`
+}
+
+type LadderProgress struct {
+	LadderName string `json:"ladder_name"`
+	Tiers      []struct {
+		Label         string `json:"label"`
+		EventsReached int    `json:"events_reached"`
+	} `json:"tiers"`
+	Events []struct {
+		Event         string             `json:"event"`
+		SwimmerTimeMS *int               `json:"swimmer_time_ms"`
+		CurrentTier   *LadderTierStatus  `json:"current_tier"`
+		NextTier      *LadderTierStatus  `json:"next_tier"`
+		Tiers         []LadderTierStatus `json:"tiers"`
+	} `json:"events"`
+}
+
+func TestLadderAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Ladder Swimmer", BirthDate: "2012-03-10", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	createStandard := func(t *testing.T, name, courseType string, times []StandardTimeInput) string {
+		t.Helper()
+		rr := client.Post("/api/v1/standards/import", StandardImportInput{
+			Name: name, CourseType: courseType, Gender: "female", Times: times,
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var std StandardWithTimes
+		AssertJSONBody(t, rr, &std)
+		return std.ID
+	}
+
+	bID := createStandard(t, "Motivational B", "25m", []StandardTimeInput{
+		{Event: "50FR", AgeGroup: "11-12", TimeMs: 36000},
+		{Event: "50FR", AgeGroup: "OPEN", TimeMs: 34000},
+		{Event: "100FR", AgeGroup: "OPEN", TimeMs: 80000},
+	})
+	aID := createStandard(t, "Motivational A", "25m", []StandardTimeInput{
+		{Event: "50FR", AgeGroup: "11-12", TimeMs: 33000},
+		{Event: "50FR", AgeGroup: "OPEN", TimeMs: 31500},
+	})
+	aaID := createStandard(t, "Motivational AA", "25m", []StandardTimeInput{
+		{Event: "50FR", AgeGroup: "OPEN", TimeMs: 30000},
+	})
+	longCourseID := createStandard(t, "Motivational LC", "50m", []StandardTimeInput{
+		{Event: "50FR", AgeGroup: "OPEN", TimeMs: 35000},
+	})
+
+	createMeet := func(t *testing.T, name, date string) string {
+		t.Helper()
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: name, City: "Guelph", StartDate: date, EndDate: date, CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+		return meet.ID
+	}
+	createTime := func(t *testing.T, meetID string, timeMS int, date string) {
+		t.Helper()
+		rr := client.Post("/api/v1/times", TimeInput{MeetID: meetID, Event: "50FR", TimeMS: timeMS, EventDate: date})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	// Age 12: meets B for 11-12
+	createTime(t, createMeet(t, "Spring Splash", "2024-05-01"), 35500, "2024-05-01")
+	// Age 13: still B, not yet A
+	createTime(t, createMeet(t, "Summer Sprint", "2025-06-01"), 32800, "2025-06-01")
+	// Age 13: first A
+	createTime(t, createMeet(t, "Winter Champs", "2026-02-01"), 31200, "2026-02-01")
+
+	var ladderID string
+
+	t.Run("POST /ladders creates an ordered ladder", func(t *testing.T) {
+		rr := client.Post("/api/v1/ladders", LadderInput{
+			Name: "Motivational", CourseType: "25m",
+			Tiers: []LadderTierInput{
+				{StandardID: bID, Label: "B"},
+				{StandardID: aID, Label: "A"},
+				{StandardID: aaID},
+			},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var lad Ladder
+		AssertJSONBody(t, rr, &lad)
+		ladderID = lad.ID
+		require.Len(t, lad.Tiers, 3)
+		assert.Equal(t, 1, lad.Tiers[0].Position)
+		assert.Equal(t, "B", lad.Tiers[0].Label)
+		assert.Equal(t, "Motivational AA", lad.Tiers[2].Label)
+	})
+
+	t.Run("POST /ladders validates the tiers", func(t *testing.T) {
+		rr := client.Post("/api/v1/ladders", LadderInput{
+			Name: "Mixed Courses", CourseType: "25m",
+			Tiers: []LadderTierInput{{StandardID: bID}, {StandardID: longCourseID}},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Post("/api/v1/ladders", LadderInput{
+			Name: "Repeated", CourseType: "25m",
+			Tiers: []LadderTierInput{{StandardID: bID}, {StandardID: bID}},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Post("/api/v1/ladders", LadderInput{
+			Name: "Unknown", CourseType: "25m",
+			Tiers: []LadderTierInput{{StandardID: uuid.New().String()}},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Post("/api/v1/ladders", LadderInput{
+			Name: "Motivational", CourseType: "25m",
+			Tiers: []LadderTierInput{{StandardID: bID}},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("POST /ladders requires write access", func(t *testing.T) {
+		client.SetMockUser("view_only")
+		defer client.SetMockUser("full")
+
+		rr := client.Post("/api/v1/ladders", LadderInput{
+			Name: "Read Only", CourseType: "25m", Tiers: []LadderTierInput{{StandardID: bID}},
+		})
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("GET /ladders/{id}/progress reports tiers per event", func(t *testing.T) {
+		rr := client.Get("/api/v1/ladders/" + ladderID + "/progress")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var progress LadderProgress
+		AssertJSONBody(t, rr, &progress)
+		assert.Equal(t, "Motivational", progress.LadderName)
+		require.Len(t, progress.Tiers, 3)
+		assert.Equal(t, 1, progress.Tiers[0].EventsReached)
+		assert.Equal(t, 1, progress.Tiers[1].EventsReached)
+		assert.Equal(t, 0, progress.Tiers[2].EventsReached)
+
+		require.Len(t, progress.Events, 2)
+		fifty := progress.Events[0]
+		assert.Equal(t, "50FR", fifty.Event)
+		require.NotNil(t, fifty.SwimmerTimeMS)
+		assert.Equal(t, 31200, *fifty.SwimmerTimeMS)
+		require.NotNil(t, fifty.CurrentTier)
+		assert.Equal(t, "A", fifty.CurrentTier.Label)
+		require.NotNil(t, fifty.NextTier)
+		assert.Equal(t, "Motivational AA", fifty.NextTier.Label)
+		require.NotNil(t, fifty.NextTier.DifferenceMS)
+		assert.Equal(t, 1200, *fifty.NextTier.DifferenceMS)
+
+		require.Len(t, fifty.Tiers, 3)
+		require.NotNil(t, fifty.Tiers[0].FirstAchieved)
+		assert.Equal(t, "2024-05-01", fifty.Tiers[0].FirstAchieved.Date)
+		assert.Equal(t, "11-12", fifty.Tiers[0].FirstAchieved.AgeGroup)
+		require.NotNil(t, fifty.Tiers[1].FirstAchieved)
+		assert.Equal(t, "2026-02-01", fifty.Tiers[1].FirstAchieved.Date)
+		assert.Equal(t, 31200, fifty.Tiers[1].FirstAchieved.TimeMS)
+		assert.Nil(t, fifty.Tiers[2].FirstAchieved)
+
+		hundred := progress.Events[1]
+		assert.Equal(t, "100FR", hundred.Event)
+		assert.Nil(t, hundred.CurrentTier)
+		require.NotNil(t, hundred.NextTier)
+		assert.Equal(t, "B", hundred.NextTier.Label)
+		assert.Equal(t, "no_standard", hundred.Tiers[1].Status)
+	})
+
+	t.Run("PUT /ladders/{id} replaces the tiers", func(t *testing.T) {
+		rr := client.Put("/api/v1/ladders/"+ladderID, LadderInput{
+			Name: "Motivational", CourseType: "25m",
+			Tiers: []LadderTierInput{{StandardID: aID, Label: "A"}, {StandardID: aaID, Label: "AA"}},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var lad Ladder
+		AssertJSONBody(t, rr, &lad)
+		require.Len(t, lad.Tiers, 2)
+		assert.Equal(t, "A", lad.Tiers[0].Label)
+
+		rr = client.Get("/api/v1/ladders?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list LadderList
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.Ladders, 1)
+		assert.Len(t, list.Ladders[0].Tiers, 2)
+	})
+
+	t.Run("DELETE /ladders/{id} removes the ladder", func(t *testing.T) {
+		rr := client.Delete("/api/v1/ladders/" + ladderID)
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+
+		rr = client.Get("/api/v1/ladders/" + ladderID)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+
+		rr = client.Get("/api/v1/ladders/" + ladderID + "/progress")
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,196 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type MastersSwimmerInput struct {
+	Name      string `json:"name"`
+	BirthDate string `json:"birth_date"`
+	Gender    string `json:"gender"`
+	Category  string `json:"category,omitempty"`
+}
+
+type MastersStandardImportInput struct {
+	Name       string              `json:"name"`
+	CourseType string              `json:"course_type"`
+	Gender     string              `json:"gender"`
+	Category   string              `json:"category,omitempty"`
+	Times      []StandardTimeInput `json:"times"`
+}
+
+type MastersComparison struct {
+	Event          string  `json:"event"`
+	Status         string  `json:"status"`
+	AgeGroup       string  `json:"age_group"`
+	StandardTimeMS *int    `json:"standard_time_ms,omitempty"`
+	PrevAgeGroup   *string `json:"prev_age_group,omitempty"`
+	NextAgeGroup   *string `json:"next_age_group,omitempty"`
+}
+
+type MastersComparisonResult struct {
+	SwimmerAgeGroup string              `json:"swimmer_age_group"`
+	Category        string              `json:"category"`
+	Comparisons     []MastersComparison `json:"comparisons"`
+}
+
+// mastersBand returns the masters band for a birth year in the current year.
+func mastersBand(birthYear int) string {
+	age := time.Now().Year() - birthYear
+	from := age - age%5
+	return fmt.Sprintf("%d-%d", from, from+4)
+}
+
+func TestMastersAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	// Born in 1980, the swimmer is well clear of the 18-24 band
+	band := mastersBand(1980)
+
+	t.Run("PUT /swimmer stores the masters category", func(t *testing.T) {
+		rr := client.Put("/api/v1/swimmer", MastersSwimmerInput{Name: "Masters Swimmer", BirthDate: "1980-12-20", Gender: "female", Category: "masters"})
+		require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+		var swimmer struct {
+			Category        string `json:"category"`
+			CurrentAgeGroup string `json:"current_age_group"`
+		}
+		AssertJSONBody(t, rr, &swimmer)
+		assert.Equal(t, "masters", swimmer.Category)
+		// Masters age is taken as of December 31, so the December birthday already counts
+		assert.Equal(t, band, swimmer.CurrentAgeGroup)
+	})
+
+	t.Run("PUT /swimmer rejects an unknown category", func(t *testing.T) {
+		rr := client.Put("/api/v1/swimmer", MastersSwimmerInput{Name: "Masters Swimmer", BirthDate: "1980-12-20", Gender: "female", Category: "veterans"})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("POST /standards/import rejects youth age groups in masters standards", func(t *testing.T) {
+		rr := client.Post("/api/v1/standards/import", MastersStandardImportInput{
+			Name: "Masters Youth Mix", CourseType: "25m", Gender: "female", Category: "masters",
+			Times: []StandardTimeInput{{Event: "50FR", AgeGroup: "13-14", TimeMs: 30000}},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("GET /comparisons matches masters standards on masters bands", func(t *testing.T) {
+		var from int
+		_, err := fmt.Sscanf(band, "%d-", &from)
+		require.NoError(t, err)
+		prevBand := fmt.Sprintf("%d-%d", from-5, from-1)
+		nextBand := fmt.Sprintf("%d-%d", from+5, from+9)
+
+		rr := client.Post("/api/v1/standards/import", MastersStandardImportInput{
+			Name: "Masters Top 10", CourseType: "25m", Gender: "female", Category: "masters",
+			Times: []StandardTimeInput{
+				{Event: "50FR", AgeGroup: prevBand, TimeMs: 28500},
+				{Event: "50FR", AgeGroup: band, TimeMs: 29500},
+				{Event: "50FR", AgeGroup: nextBand, TimeMs: 30500},
+			},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var std StandardWithTimes
+		AssertJSONBody(t, rr, &std)
+
+		rr = client.Get("/api/v1/standards?category=masters")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list StandardList
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.Standards, 1)
+		assert.Equal(t, std.ID, list.Standards[0].ID)
+
+		rr = client.Post("/api/v1/meets", MeetInput{Name: "Masters Meet", City: "Toronto", StartDate: "2026-03-07", CourseType: "25m"})
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: "50FR", TimeMS: 29100, EventDate: "2026-03-07"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/comparisons?standard_id=" + std.ID + "&course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var result MastersComparisonResult
+		AssertJSONBody(t, rr, &result)
+		assert.Equal(t, "masters", result.Category)
+		assert.Equal(t, band, result.SwimmerAgeGroup)
+
+		var freestyle *MastersComparison
+		for i := range result.Comparisons {
+			if result.Comparisons[i].Event == "50FR" {
+				freestyle = &result.Comparisons[i]
+			}
+		}
+		require.NotNil(t, freestyle)
+		assert.Equal(t, "achieved", freestyle.Status)
+		assert.Equal(t, band, freestyle.AgeGroup)
+		require.NotNil(t, freestyle.StandardTimeMS)
+		assert.Equal(t, 29500, *freestyle.StandardTimeMS)
+		require.NotNil(t, freestyle.PrevAgeGroup)
+		assert.Equal(t, prevBand, *freestyle.PrevAgeGroup)
+		require.NotNil(t, freestyle.NextAgeGroup)
+		assert.Equal(t, nextBand, *freestyle.NextAgeGroup)
+	})
+
+	t.Run("GET /personal-bests/age-groups and /times use masters bands", func(t *testing.T) {
+		rr := client.Get("/api/v1/personal-bests/age-groups?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var bests AgeGroupBestList
+		AssertJSONBody(t, rr, &bests)
+		assert.Equal(t, band, bests.CurrentAgeGroup)
+		require.Len(t, bests.Events, 1)
+		require.Len(t, bests.Events[0].AgeGroups, 1)
+		assert.Equal(t, band, bests.Events[0].AgeGroups[0].AgeGroup)
+
+		rr = client.Get("/api/v1/times?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list TimeList
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.Times, 1)
+		assert.Equal(t, band, list.Times[0].AgeGroup)
+		assert.True(t, list.Times[0].IsAgeGroupBest)
+	})
+
+	t.Run("masters records are matched on masters bands", func(t *testing.T) {
+		rr := client.Post("/api/v1/records/import", RecordImportInput{
+			Name:  "Masters Provincial Records",
+			Scope: "provincial",
+			Records: []RecordInput{
+				{Event: "50FR", CourseType: "25m", Gender: "female", AgeGroup: band, TimeMS: 29000},
+				{Event: "50FR", CourseType: "25m", Gender: "female", AgeGroup: "13-14", TimeMS: 26000},
+			},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/records/comparison?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var comparison RecordComparison
+		AssertJSONBody(t, rr, &comparison)
+		statuses := make(map[string]string)
+		for _, r := range comparison.Records {
+			statuses[r.AgeGroup] = r.Status
+		}
+		assert.Equal(t, "approaching", statuses[band])
+		assert.Equal(t, "no_time", statuses["13-14"])
+	})
+}
@@ -0,0 +1,167 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type MatrixTarget struct {
+	StandardID     string `json:"standard_id"`
+	StandardName   string `json:"standard_name"`
+	StandardTimeMS int    `json:"standard_time_ms"`
+	DifferenceMS   *int   `json:"difference_ms"`
+}
+
+type ComparisonMatrix struct {
+	CourseType      string `json:"course_type"`
+	SwimmerAgeGroup string `json:"swimmer_age_group"`
+	Standards       []struct {
+		ID       string `json:"id"`
+		Name     string `json:"name"`
+		AgeGroup string `json:"age_group"`
+		Summary  struct {
+			Achieved int `json:"achieved"`
+			NoTime   int `json:"no_time"`
+		} `json:"summary"`
+	} `json:"standards"`
+	Events []struct {
+		Event         string `json:"event"`
+		SwimmerTimeMS *int   `json:"swimmer_time_ms"`
+		Cells         []struct {
+			StandardID   string `json:"standard_id"`
+			Status       string `json:"status"`
+			DifferenceMS *int   `json:"difference_ms"`
+		} `json:"cells"`
+		HighestAchieved *MatrixTarget `json:"highest_achieved"`
+		NextTarget      *MatrixTarget `json:"next_target"`
+	} `json:"events"`
+}
+
+func TestComparisonMatrixAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Matrix Swimmer", BirthDate: "2011-06-15", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	createStandard := func(t *testing.T, name, courseType string, times []StandardTimeInput) string {
+		t.Helper()
+		rr := client.Post("/api/v1/standards/import", StandardImportInput{
+			Name: name, CourseType: courseType, Gender: "female", Times: times,
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var std StandardWithTimes
+		AssertJSONBody(t, rr, &std)
+		return std.ID
+	}
+
+	clubID := createStandard(t, "Club", "25m", []StandardTimeInput{
+		{Event: "50FR", AgeGroup: "OPEN", TimeMs: 33000},
+		{Event: "100FR", AgeGroup: "OPEN", TimeMs: 75000},
+	})
+	provincialID := createStandard(t, "Provincial", "25m", []StandardTimeInput{
+		{Event: "50FR", AgeGroup: "OPEN", TimeMs: 31000},
+		{Event: "100FR", AgeGroup: "OPEN", TimeMs: 68000},
+	})
+	nationalID := createStandard(t, "National", "25m", []StandardTimeInput{
+		{Event: "50FR", AgeGroup: "OPEN", TimeMs: 28500},
+	})
+	longCourseID := createStandard(t, "Provincial LC", "50m", []StandardTimeInput{
+		{Event: "50FR", AgeGroup: "OPEN", TimeMs: 32000},
+	})
+
+	rr = client.Post("/api/v1/meets", MeetInput{
+		Name: "Winter Invitational", City: "London", StartDate: "2026-01-17", EndDate: "2026-01-17", CourseType: "25m",
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var meet Meet
+	AssertJSONBody(t, rr, &meet)
+	rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: "50FR", TimeMS: 30500, EventDate: "2026-01-17"})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	t.Run("GET /comparisons/matrix compares against every applicable standard", func(t *testing.T) {
+		rr := client.Get("/api/v1/comparisons/matrix?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var matrix ComparisonMatrix
+		AssertJSONBody(t, rr, &matrix)
+		ids := make([]string, len(matrix.Standards))
+		for i, s := range matrix.Standards {
+			ids[i] = s.ID
+		}
+		assert.ElementsMatch(t, []string{clubID, provincialID, nationalID}, ids)
+		assert.NotContains(t, ids, longCourseID)
+
+		// Youth standards are compared on the swimmer's own youth age group
+		require.NotEmpty(t, matrix.SwimmerAgeGroup)
+		for _, s := range matrix.Standards {
+			assert.Equal(t, matrix.SwimmerAgeGroup, s.AgeGroup, s.Name)
+		}
+	})
+
+	t.Run("GET /comparisons/matrix reports the highest standard achieved and the next target", func(t *testing.T) {
+		ids := strings.Join([]string{clubID, provincialID, nationalID}, ",")
+		rr := client.Get("/api/v1/comparisons/matrix?course_type=25m&standard_ids=" + ids)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var matrix ComparisonMatrix
+		AssertJSONBody(t, rr, &matrix)
+		require.Len(t, matrix.Standards, 3)
+		assert.Equal(t, "Club", matrix.Standards[0].Name)
+		assert.Equal(t, 1, matrix.Standards[0].Summary.Achieved)
+
+		byEvent := make(map[string]int)
+		for i, e := range matrix.Events {
+			byEvent[e.Event] = i
+		}
+
+		fifty := matrix.Events[byEvent["50FR"]]
+		require.NotNil(t, fifty.SwimmerTimeMS)
+		assert.Equal(t, 30500, *fifty.SwimmerTimeMS)
+		require.Len(t, fifty.Cells, 3)
+		assert.Equal(t, "achieved", fifty.Cells[0].Status)
+		assert.Equal(t, "achieved", fifty.Cells[1].Status)
+		assert.Equal(t, "not_achieved", fifty.Cells[2].Status)
+		require.NotNil(t, fifty.HighestAchieved)
+		assert.Equal(t, provincialID, fifty.HighestAchieved.StandardID)
+		require.NotNil(t, fifty.NextTarget)
+		assert.Equal(t, "National", fifty.NextTarget.StandardName)
+		require.NotNil(t, fifty.NextTarget.DifferenceMS)
+		assert.Equal(t, 2000, *fifty.NextTarget.DifferenceMS)
+
+		hundred := matrix.Events[byEvent["100FR"]]
+		assert.Nil(t, hundred.SwimmerTimeMS)
+		assert.Nil(t, hundred.HighestAchieved)
+		require.NotNil(t, hundred.NextTarget)
+		assert.Equal(t, clubID, hundred.NextTarget.StandardID)
+		assert.Nil(t, hundred.NextTarget.DifferenceMS)
+	})
+
+	t.Run("GET /comparisons/matrix validates the standards", func(t *testing.T) {
+		rr := client.Get("/api/v1/comparisons/matrix?course_type=25m&standard_ids=not-a-uuid")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Get("/api/v1/comparisons/matrix?course_type=25m&standard_ids=" + uuid.New().String())
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+
+		rr = client.Get("/api/v1/comparisons/matrix?course_type=25m&standard_ids=" + longCourseID)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
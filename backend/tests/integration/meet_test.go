@@ -20,14 +20,24 @@ type MeetInput struct {
 }
 
 type Meet struct {
-	ID         string `json:"id"`
-	Name       string `json:"name"`
-	City       string `json:"city"`
-	Country    string `json:"country"`
-	StartDate  string `json:"start_date"`
-	EndDate    string `json:"end_date"`
-	CourseType string `json:"course_type"`
-	TimeCount  int    `json:"time_count,omitempty"`
+	ID         string       `json:"id"`
+	Name       string       `json:"name"`
+	City       string       `json:"city"`
+	Country    string       `json:"country"`
+	StartDate  string       `json:"start_date"`
+	EndDate    string       `json:"end_date"`
+	CourseType string       `json:"course_type"`
+	TimeCount  int          `json:"time_count,omitempty"`
+	Results    *MeetResults `json:"results,omitempty"`
+}
+
+type MeetResults struct {
+	Gold        int     `json:"gold"`
+	Silver      int     `json:"silver"`
+	Bronze      int     `json:"bronze"`
+	TopEight    int     `json:"top_eight"`
+	PlacedCount int     `json:"placed_count"`
+	TotalPoints float64 `json:"total_points"`
 }
 
 type MeetList struct {
@@ -169,6 +179,44 @@ func TestMeetAPI(t *testing.T) {
 		assert.Equal(t, "Specific Meet", meet.Name)
 	})
 
+	t.Run("GET /meets/{id} includes result summary", func(t *testing.T) {
+		testDB.ClearTables(ctx, t)
+
+		rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Test Swimmer", BirthDate: "2012-05-15", Gender: "female"})
+		require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK)
+
+		rr = client.Post("/api/v1/meets", MeetInput{Name: "Results Meet", City: "Toronto", StartDate: "2026-04-01", CourseType: "25m"})
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var created Meet
+		AssertJSONBody(t, rr, &created)
+
+		times := []TimeInput{
+			// Age-group place takes precedence over overall place
+			{MeetID: created.ID, Event: "50FR", TimeMS: 30120, EventDate: "2026-04-01", OverallPlace: intPtr(5), AgeGroupPlace: intPtr(1), PointsEarned: floatPtr(20)},
+			{MeetID: created.ID, Event: "100FR", TimeMS: 65320, EventDate: "2026-04-01", OverallPlace: intPtr(3), PointsEarned: floatPtr(16.5)},
+			{MeetID: created.ID, Event: "200FR", TimeMS: 145000, EventDate: "2026-04-01", AgeGroupPlace: intPtr(7)},
+			{MeetID: created.ID, Event: "50BK", TimeMS: 36000, EventDate: "2026-04-01", AgeGroupPlace: intPtr(12)},
+			{MeetID: created.ID, Event: "50FL", TimeMS: 33000, EventDate: "2026-04-01"},
+		}
+		for _, ti := range times {
+			rr = client.Post("/api/v1/times", ti)
+			require.Equal(t, http.StatusCreated, rr.Code, "got %d: %s", rr.Code, rr.Body.String())
+		}
+
+		rr = client.Get("/api/v1/meets/" + created.ID)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+		require.NotNil(t, meet.Results)
+		assert.Equal(t, 1, meet.Results.Gold)
+		assert.Equal(t, 0, meet.Results.Silver)
+		assert.Equal(t, 1, meet.Results.Bronze)
+		assert.Equal(t, 3, meet.Results.TopEight)
+		assert.Equal(t, 4, meet.Results.PlacedCount)
+		assert.InDelta(t, 36.5, meet.Results.TotalPoints, 0.001)
+	})
+
 	t.Run("GET /meets/{id} returns 404 for non-existent meet", func(t *testing.T) {
 		testDB.ClearTables(ctx, t)
 
@@ -0,0 +1,134 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenWaterAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Open Water Swimmer", BirthDate: "2010-04-12", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	createMeet := func(t *testing.T, name, date, courseType string) string {
+		t.Helper()
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: name, City: "Kingston", StartDate: date, EndDate: date, CourseType: courseType,
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+		return meet.ID
+	}
+
+	lakeMeetID := createMeet(t, "Lake Ontario Swim", "2026-07-11", "open_water")
+	riverMeetID := createMeet(t, "River Marathon", "2026-08-15", "open_water")
+	poolMeetID := createMeet(t, "Summer Pool Meet", "2026-07-04", "50m")
+
+	t.Run("POST /times records an open-water swim longer than an hour", func(t *testing.T) {
+		rr := client.Post("/api/v1/times", TimeInput{
+			MeetID:            lakeMeetID,
+			Event:             "5000OW",
+			TimeMS:            3751400,
+			EventDate:         "2026-07-11",
+			WaterTemperatureC: floatPtr(19.5),
+			Wetsuit:           boolPtr(true),
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var record TimeRecord
+		AssertJSONBody(t, rr, &record)
+		assert.Equal(t, "1:02:31.40", record.TimeFormatted)
+		require.NotNil(t, record.WaterTemperatureC)
+		assert.InDelta(t, 19.5, *record.WaterTemperatureC, 0.001)
+		require.NotNil(t, record.Wetsuit)
+		assert.True(t, *record.Wetsuit)
+	})
+
+	t.Run("POST /times rejects open-water events in a pool meet", func(t *testing.T) {
+		rr := client.Post("/api/v1/times", TimeInput{
+			MeetID: poolMeetID, Event: "5000OW", TimeMS: 3751400, EventDate: "2026-07-04",
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("POST /times rejects water conditions for pool swims", func(t *testing.T) {
+		rr := client.Post("/api/v1/times", TimeInput{
+			MeetID: poolMeetID, Event: "1500FR", TimeMS: 1100000, EventDate: "2026-07-04",
+			WaterTemperatureC: floatPtr(26),
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("POST /times rejects implausible water temperatures", func(t *testing.T) {
+		rr := client.Post("/api/v1/times", TimeInput{
+			MeetID: riverMeetID, Event: "3000OW", TimeMS: 2400000, EventDate: "2026-08-15",
+			WaterTemperatureC: floatPtr(55),
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	rr = client.Post("/api/v1/times", TimeInput{
+		MeetID: riverMeetID, Event: "5000OW", TimeMS: 3702150, EventDate: "2026-08-15",
+		WaterTemperatureC: floatPtr(22), Wetsuit: boolPtr(false),
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	t.Run("GET /personal-bests reports open-water bests with their conditions", func(t *testing.T) {
+		rr := client.Get("/api/v1/personal-bests?course_type=open_water")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var list PersonalBestList
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.PersonalBests, 1)
+		pb := list.PersonalBests[0]
+		assert.Equal(t, "5000OW", pb.Event)
+		assert.Equal(t, "1:01:42.15", pb.TimeFormatted)
+		require.NotNil(t, pb.Wetsuit)
+		assert.False(t, *pb.Wetsuit)
+	})
+
+	t.Run("GET /progress charts open-water swims", func(t *testing.T) {
+		rr := client.Get("/api/v1/progress/5000OW?course_type=open_water")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var progress ProgressData
+		AssertJSONBody(t, rr, &progress)
+		require.Len(t, progress.DataPoints, 2)
+		assert.Equal(t, "Lake Ontario Swim", progress.DataPoints[0].MeetName)
+		assert.False(t, progress.DataPoints[0].IsPersonalBest)
+		assert.True(t, progress.DataPoints[1].IsPersonalBest)
+		require.NotNil(t, progress.DataPoints[0].WaterTemperatureC)
+		assert.InDelta(t, 19.5, *progress.DataPoints[0].WaterTemperatureC, 0.001)
+	})
+
+	t.Run("GET /events lists the open-water events", func(t *testing.T) {
+		rr := client.Get("/api/v1/events?course_type=open_water")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var list EventList
+		AssertJSONBody(t, rr, &list)
+		codes := make([]string, len(list.Events))
+		for i, e := range list.Events {
+			codes[i] = e.Code
+		}
+		assert.Equal(t, []string{"1500OW", "3000OW", "5000OW", "10000OW"}, codes)
+	})
+}
@@ -0,0 +1,215 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ParaSwimmerInput struct {
+	Name         string `json:"name"`
+	BirthDate    string `json:"birth_date"`
+	Gender       string `json:"gender"`
+	SportClassS  string `json:"sport_class_s,omitempty"`
+	SportClassSB string `json:"sport_class_sb,omitempty"`
+	SportClassSM string `json:"sport_class_sm,omitempty"`
+}
+
+type ParaStandardTimeInput struct {
+	Event      string `json:"event"`
+	AgeGroup   string `json:"age_group"`
+	SportClass string `json:"sport_class,omitempty"`
+	TimeMs     int    `json:"time_ms"`
+}
+
+type ParaStandardImportInput struct {
+	Name       string                  `json:"name"`
+	CourseType string                  `json:"course_type"`
+	Gender     string                  `json:"gender"`
+	Times      []ParaStandardTimeInput `json:"times"`
+}
+
+type EventParaPoints struct {
+	Event      string  `json:"event"`
+	SportClass *string `json:"sport_class,omitempty"`
+	TimeMS     int     `json:"time_ms"`
+	BaseTimeMS *int    `json:"base_time_ms,omitempty"`
+	Points     *int    `json:"points,omitempty"`
+}
+
+type ParaPointsResult struct {
+	CourseType string            `json:"course_type"`
+	Gender     string            `json:"gender"`
+	Events     []EventParaPoints `json:"events"`
+}
+
+func TestParaSwimmingAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	t.Run("PUT /swimmer stores sport classes per stroke family", func(t *testing.T) {
+		rr := client.Put("/api/v1/swimmer", ParaSwimmerInput{Name: "Para Swimmer", BirthDate: "2010-04-02", Gender: "female", SportClassS: "s9", SportClassSB: "SB8"})
+		require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+		var swimmer struct {
+			SportClassS  *string `json:"sport_class_s"`
+			SportClassSB *string `json:"sport_class_sb"`
+			SportClassSM *string `json:"sport_class_sm"`
+		}
+		AssertJSONBody(t, rr, &swimmer)
+		require.NotNil(t, swimmer.SportClassS)
+		assert.Equal(t, "S9", *swimmer.SportClassS)
+		require.NotNil(t, swimmer.SportClassSB)
+		assert.Equal(t, "SB8", *swimmer.SportClassSB)
+		assert.Nil(t, swimmer.SportClassSM)
+	})
+
+	t.Run("PUT /swimmer rejects sport classes from the wrong family", func(t *testing.T) {
+		testCases := []ParaSwimmerInput{
+			{Name: "Para Swimmer", BirthDate: "2010-04-02", Gender: "female", SportClassS: "S9", SportClassSB: "S8"},
+			{Name: "Para Swimmer", BirthDate: "2010-04-02", Gender: "female", SportClassS: "S15"},
+		}
+		for _, input := range testCases {
+			rr := client.Put("/api/v1/swimmer", input)
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		}
+	})
+
+	rr := client.Post("/api/v1/meets", MeetInput{Name: "Para Open", City: "Toronto", StartDate: "2026-02-14", CourseType: "25m"})
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var meet Meet
+	AssertJSONBody(t, rr, &meet)
+
+	rr = client.Post("/api/v1/times/batch", map[string]interface{}{
+		"meet_id": meet.ID,
+		"times": []map[string]interface{}{
+			{"event": "50FR", "time_ms": 29000, "event_date": "2026-02-14"},
+			{"event": "100BR", "time_ms": 88000, "event_date": "2026-02-14"},
+			{"event": "200IM", "time_ms": 170000, "event_date": "2026-02-14"},
+		},
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	t.Run("GET /comparisons matches standard times on the swimmer's sport class", func(t *testing.T) {
+		rr := client.Post("/api/v1/standards/import", ParaStandardImportInput{
+			Name: "Para Nationals", CourseType: "25m", Gender: "female",
+			Times: []ParaStandardTimeInput{
+				{Event: "50FR", AgeGroup: "OPEN", TimeMs: 28000},
+				{Event: "50FR", AgeGroup: "OPEN", SportClass: "S9", TimeMs: 29500},
+				{Event: "50FR", AgeGroup: "OPEN", SportClass: "S5", TimeMs: 45000},
+				{Event: "100BR", AgeGroup: "OPEN", SportClass: "SB8", TimeMs: 87000},
+			},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var std StandardWithTimes
+		AssertJSONBody(t, rr, &std)
+		assert.Len(t, std.Times, 4)
+
+		rr = client.Get("/api/v1/comparisons?standard_id=" + std.ID + "&course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var result struct {
+			Comparisons []struct {
+				Event          string  `json:"event"`
+				Status         string  `json:"status"`
+				SportClass     *string `json:"sport_class"`
+				StandardTimeMS *int    `json:"standard_time_ms"`
+			} `json:"comparisons"`
+		}
+		AssertJSONBody(t, rr, &result)
+
+		found := 0
+		for _, c := range result.Comparisons {
+			switch c.Event {
+			case "50FR":
+				found++
+				require.NotNil(t, c.SportClass)
+				assert.Equal(t, "S9", *c.SportClass)
+				require.NotNil(t, c.StandardTimeMS)
+				assert.Equal(t, 29500, *c.StandardTimeMS)
+				assert.Equal(t, "achieved", c.Status)
+			case "100BR":
+				found++
+				require.NotNil(t, c.StandardTimeMS)
+				assert.Equal(t, 87000, *c.StandardTimeMS)
+				assert.NotEqual(t, "achieved", c.Status)
+			}
+		}
+		assert.Equal(t, 2, found)
+	})
+
+	t.Run("POST /standards/import rejects a sport class that does not fit the stroke", func(t *testing.T) {
+		rr := client.Post("/api/v1/standards/import", ParaStandardImportInput{
+			Name: "Para Mismatch", CourseType: "25m", Gender: "female",
+			Times: []ParaStandardTimeInput{{Event: "50BR", AgeGroup: "OPEN", SportClass: "S9", TimeMs: 40000}},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("GET /para-points scores personal bests against base times", func(t *testing.T) {
+		rr := client.Post("/api/v1/para-points/base-times", map[string]interface{}{
+			"course_type": "25m",
+			"gender":      "female",
+			"times": map[string]map[string]string{
+				"S9":  {"50FR": "28.00"},
+				"SB8": {"100BR": "1:20.00"},
+			},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/para-points?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var result ParaPointsResult
+		AssertJSONBody(t, rr, &result)
+		assert.Equal(t, "female", result.Gender)
+
+		byEvent := map[string]EventParaPoints{}
+		for _, e := range result.Events {
+			byEvent[e.Event] = e
+		}
+		require.NotNil(t, byEvent["50FR"].Points)
+		assert.Equal(t, 900, *byEvent["50FR"].Points)
+		require.NotNil(t, byEvent["100BR"].Points)
+		assert.Equal(t, 751, *byEvent["100BR"].Points)
+
+		// No SM class, so the medley is listed without points
+		im := byEvent["200IM"]
+		assert.Nil(t, im.SportClass)
+		assert.Nil(t, im.Points)
+	})
+
+	t.Run("POST /para-points/base-times validates input", func(t *testing.T) {
+		testCases := []map[string]interface{}{
+			{"course_type": "25m", "gender": "female", "times": map[string]map[string]string{"S9": {"50BR": "35.00"}}},
+			{"course_type": "25m", "gender": "female", "times": map[string]map[string]string{"X9": {"50FR": "28.00"}}},
+			{"course_type": "33m", "gender": "female", "times": map[string]map[string]string{"S9": {"50FR": "28.00"}}},
+		}
+		for _, input := range testCases {
+			rr := client.Post("/api/v1/para-points/base-times", input)
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		}
+	})
+
+	t.Run("view-only access cannot import base times", func(t *testing.T) {
+		client.SetMockUser("view_only")
+		defer client.SetMockUser("full")
+
+		rr := client.Post("/api/v1/para-points/base-times", map[string]interface{}{"course_type": "25m", "gender": "female"})
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
@@ -0,0 +1,133 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type PersonalBestStep struct {
+	TimeID                string   `json:"time_id"`
+	TimeMS                int      `json:"time_ms"`
+	Date                  string   `json:"date"`
+	ImprovementMS         *int     `json:"improvement_ms"`
+	ImprovementPercent    *float64 `json:"improvement_percent"`
+	DaysSincePreviousBest *int     `json:"days_since_previous_best"`
+}
+
+type PersonalBestHistory struct {
+	CourseType string `json:"course_type"`
+	Events     []struct {
+		Event       string             `json:"event"`
+		Progression []PersonalBestStep `json:"progression"`
+	} `json:"events"`
+}
+
+func TestPersonalBestHistoryAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "History Swimmer", BirthDate: "2012-05-01", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	// 100FR: 80.00 (PB), 78.00 (PB), 79.00, 78.00 (tie), 75.00 (PB)
+	dates := []string{"2025-01-10", "2025-03-01", "2025-05-01", "2025-06-01", "2025-11-15"}
+	free := []int{80000, 78000, 79000, 78000, 75000}
+	for i, date := range dates {
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: "History Meet " + date, City: "Ottawa", StartDate: date, EndDate: date, CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: "100FR", TimeMS: free[i], EventDate: date})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		if i == 0 {
+			rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: "50BK", TimeMS: 42000, EventDate: date})
+			require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		}
+	}
+
+	t.Run("GET /personal-bests/history returns the progression per event", func(t *testing.T) {
+		rr := client.Get("/api/v1/personal-bests/history?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var history PersonalBestHistory
+		AssertJSONBody(t, rr, &history)
+
+		require.Len(t, history.Events, 2)
+		assert.Equal(t, "100FR", history.Events[0].Event)
+		assert.Equal(t, "50BK", history.Events[1].Event)
+
+		steps := history.Events[0].Progression
+		require.Len(t, steps, 3)
+		assert.Equal(t, 80000, steps[0].TimeMS)
+		assert.Nil(t, steps[0].ImprovementMS)
+
+		assert.Equal(t, 78000, steps[1].TimeMS)
+		require.NotNil(t, steps[1].ImprovementMS)
+		assert.Equal(t, 2000, *steps[1].ImprovementMS)
+		require.NotNil(t, steps[1].ImprovementPercent)
+		assert.InDelta(t, 2.5, *steps[1].ImprovementPercent, 0.001)
+		require.NotNil(t, steps[1].DaysSincePreviousBest)
+		assert.Equal(t, 50, *steps[1].DaysSincePreviousBest)
+
+		assert.Equal(t, 75000, steps[2].TimeMS)
+		assert.Equal(t, "2025-11-15", steps[2].Date)
+		require.NotNil(t, steps[2].ImprovementMS)
+		assert.Equal(t, 3000, *steps[2].ImprovementMS)
+	})
+
+	t.Run("GET /personal-bests/history filters by event", func(t *testing.T) {
+		rr := client.Get("/api/v1/personal-bests/history?course_type=25m&event=50BK")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var history PersonalBestHistory
+		AssertJSONBody(t, rr, &history)
+		require.Len(t, history.Events, 1)
+		assert.Len(t, history.Events[0].Progression, 1)
+
+		rr = client.Get("/api/v1/personal-bests/history?course_type=25m&event=100XX")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Get("/api/v1/personal-bests/history")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("GET /personal-bests?as_of returns the bests on that date", func(t *testing.T) {
+		rr := client.Get("/api/v1/personal-bests?course_type=25m&as_of=2025-07-01")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list PersonalBestList
+		AssertJSONBody(t, rr, &list)
+		require.NotNil(t, list.AsOf)
+		assert.Equal(t, "2025-07-01", *list.AsOf)
+		require.Len(t, list.PersonalBests, 2)
+		for _, pb := range list.PersonalBests {
+			if pb.Event == "100FR" {
+				assert.Equal(t, 78000, pb.TimeMS)
+			}
+		}
+
+		rr = client.Get("/api/v1/personal-bests?course_type=25m&as_of=2024-12-31")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var empty PersonalBestList
+		AssertJSONBody(t, rr, &empty)
+		assert.Empty(t, empty.PersonalBests)
+
+		rr = client.Get("/api/v1/personal-bests?course_type=25m&as_of=July")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
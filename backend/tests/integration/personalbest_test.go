@@ -16,10 +16,18 @@ type PersonalBest struct {
 	TimeID        string `json:"time_id"`
 	MeetName      string `json:"meet"`
 	Date          string `json:"date"`
+	TimingMethod  string `json:"timing_method"`
+	Points        *int   `json:"points,omitempty"`
+
+	WaterTemperatureC *float64 `json:"water_temperature_c,omitempty"`
+	Wetsuit           *bool    `json:"wetsuit,omitempty"`
+
+	BestElectronic *PersonalBest `json:"best_electronic,omitempty"`
 }
 
 type PersonalBestList struct {
 	CourseType    string         `json:"course_type"`
+	AsOf          *string        `json:"as_of"`
 	PersonalBests []PersonalBest `json:"personal_bests"`
 }
 
@@ -216,6 +224,45 @@ func TestPersonalBestsAPI(t *testing.T) {
 		assert.True(t, found, "200FR PB not found in 50m results")
 	})
 
+	t.Run("GET /personal-bests reports best electronic time when requested", func(t *testing.T) {
+		handMeet := createMeet(t, "Hand Timed Meet", "2026-03-07", "25m")
+		electronicMeet := createMeet(t, "Electronic Meet", "2026-03-14", "25m")
+
+		rr := client.Post("/api/v1/times", TimeInput{MeetID: handMeet, Event: "200BK", TimeMS: 160000, EventDate: "2026-03-07", TimingMethod: "manual"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: electronicMeet, Event: "200BK", TimeMS: 161000, EventDate: "2026-03-14"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		findEvent := func(list PersonalBestList, event string) *PersonalBest {
+			for i := range list.PersonalBests {
+				if list.PersonalBests[i].Event == event {
+					return &list.PersonalBests[i]
+				}
+			}
+			return nil
+		}
+
+		rr = client.Get("/api/v1/personal-bests?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var pbs PersonalBestList
+		AssertJSONBody(t, rr, &pbs)
+		pb := findEvent(pbs, "200BK")
+		require.NotNil(t, pb)
+		assert.Equal(t, 160000, pb.TimeMS)
+		assert.Equal(t, "manual", pb.TimingMethod)
+		assert.Nil(t, pb.BestElectronic)
+
+		rr = client.Get("/api/v1/personal-bests?course_type=25m&include_electronic=true")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var withElectronic PersonalBestList
+		AssertJSONBody(t, rr, &withElectronic)
+		pb = findEvent(withElectronic, "200BK")
+		require.NotNil(t, pb)
+		require.NotNil(t, pb.BestElectronic)
+		assert.Equal(t, 161000, pb.BestElectronic.TimeMS)
+		assert.Equal(t, "electronic", pb.BestElectronic.TimingMethod)
+	})
+
 	t.Run("GET /personal-bests requires authentication", func(t *testing.T) {
 		client.ClearMockUser()
 		rr := client.Get("/api/v1/personal-bests?course_type=25m")
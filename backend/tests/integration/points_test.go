@@ -0,0 +1,242 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type WorldAquaticsBaseTimeInput struct {
+	Season     string            `json:"season,omitempty"`
+	Source     string            `json:"source,omitempty"`
+	CourseType string            `json:"course_type"`
+	Gender     string            `json:"gender"`
+	Times      map[string]string `json:"times"`
+}
+
+type WorldAquaticsBaseTimeList struct {
+	BaseTimes []struct {
+		Event      string `json:"event"`
+		Gender     string `json:"gender"`
+		CourseType string `json:"course_type"`
+		TimeMS     int    `json:"time_ms"`
+	} `json:"base_times"`
+}
+
+type EventPointsRanking struct {
+	Gender string `json:"gender"`
+	Events []struct {
+		Rank       int    `json:"rank"`
+		Event      string `json:"event"`
+		CourseType string `json:"course_type"`
+		TimeMS     int    `json:"time_ms"`
+		BaseTimeMS *int   `json:"base_time_ms"`
+		Points     *int   `json:"points"`
+	} `json:"events"`
+}
+
+func TestWorldAquaticsPointsAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Points Swimmer", BirthDate: "2010-03-02", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	createMeet := func(t *testing.T, name, date string) string {
+		t.Helper()
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: name, City: "Ottawa", StartDate: date, EndDate: date, CourseType: "50m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+		return meet.ID
+	}
+	createTime := func(t *testing.T, meetID, event string, timeMS int, date string) {
+		t.Helper()
+		rr := client.Post("/api/v1/times", TimeInput{MeetID: meetID, Event: event, TimeMS: timeMS, EventDate: date})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	springID := createMeet(t, "Spring Open", "2026-04-10")
+	summerID := createMeet(t, "Summer Championships", "2026-07-20")
+	createTime(t, springID, "50FR", 30000, "2026-04-10")
+	createTime(t, springID, "100FR", 65000, "2026-04-10")
+	createTime(t, springID, "200BK", 150000, "2026-04-10")
+	createTime(t, summerID, "50FR", 29500, "2026-07-20")
+
+	t.Run("POST /points/base-times imports a base time file", func(t *testing.T) {
+		rr := client.Post("/api/v1/points/base-times", WorldAquaticsBaseTimeInput{
+			Season: "2026", Source: "World Aquatics", CourseType: "50m", Gender: "female",
+			Times: map[string]string{"50FR": "23.61", "100FR": "51.71"},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var list WorldAquaticsBaseTimeList
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.BaseTimes, 2)
+		assert.Equal(t, "100FR", list.BaseTimes[0].Event)
+		assert.Equal(t, 51710, list.BaseTimes[0].TimeMS)
+	})
+
+	t.Run("POST /points/base-times validates the table", func(t *testing.T) {
+		rr := client.Post("/api/v1/points/base-times", WorldAquaticsBaseTimeInput{
+			CourseType: "open_water", Gender: "female", Times: map[string]string{"5000OW": "55:00.00"},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Post("/api/v1/points/base-times", WorldAquaticsBaseTimeInput{
+			CourseType: "50m", Gender: "x", Times: map[string]string{"50FR": "23.61"},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Post("/api/v1/points/base-times", WorldAquaticsBaseTimeInput{
+			CourseType: "50m", Gender: "female", Times: map[string]string{"75FR": "40.00"},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("POST /points/base-times requires write access", func(t *testing.T) {
+		client.SetMockUser("view_only")
+		defer client.SetMockUser("full")
+
+		rr := client.Post("/api/v1/points/base-times", WorldAquaticsBaseTimeInput{
+			CourseType: "50m", Gender: "female", Times: map[string]string{"50FR": "23.61"},
+		})
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("GET /times scores every swim with a base time", func(t *testing.T) {
+		rr := client.Get("/api/v1/times?course_type=50m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var list TimeList
+		AssertJSONBody(t, rr, &list)
+		points := make(map[string]*int)
+		for _, tr := range list.Times {
+			points[tr.Event+"@"+tr.MeetID] = tr.Points
+		}
+		require.NotNil(t, points["50FR@"+springID])
+		assert.Equal(t, 487, *points["50FR@"+springID])
+		require.NotNil(t, points["50FR@"+summerID])
+		assert.Equal(t, 512, *points["50FR@"+summerID])
+		assert.Nil(t, points["200BK@"+springID])
+	})
+
+	t.Run("GET /personal-bests includes points", func(t *testing.T) {
+		rr := client.Get("/api/v1/personal-bests?course_type=50m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var list PersonalBestList
+		AssertJSONBody(t, rr, &list)
+		byEvent := make(map[string]PersonalBest)
+		for _, pb := range list.PersonalBests {
+			byEvent[pb.Event] = pb
+		}
+		require.NotNil(t, byEvent["50FR"].Points)
+		assert.Equal(t, 512, *byEvent["50FR"].Points)
+		require.NotNil(t, byEvent["100FR"].Points)
+		assert.Equal(t, 503, *byEvent["100FR"].Points)
+	})
+
+	t.Run("GET /progress includes points per swim", func(t *testing.T) {
+		rr := client.Get("/api/v1/progress/50FR?course_type=50m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var progress ProgressData
+		AssertJSONBody(t, rr, &progress)
+		require.Len(t, progress.DataPoints, 2)
+		require.NotNil(t, progress.DataPoints[0].Points)
+		assert.Equal(t, 487, *progress.DataPoints[0].Points)
+		require.NotNil(t, progress.DataPoints[1].Points)
+		assert.Equal(t, 512, *progress.DataPoints[1].Points)
+	})
+
+	t.Run("GET /points ranks events by points", func(t *testing.T) {
+		rr := client.Get("/api/v1/points")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var ranking EventPointsRanking
+		AssertJSONBody(t, rr, &ranking)
+		assert.Equal(t, "female", ranking.Gender)
+		require.Len(t, ranking.Events, 3)
+		assert.Equal(t, "50FR", ranking.Events[0].Event)
+		assert.Equal(t, 1, ranking.Events[0].Rank)
+		assert.Equal(t, 23610, *ranking.Events[0].BaseTimeMS)
+		assert.Equal(t, "100FR", ranking.Events[1].Event)
+		assert.Equal(t, 2, ranking.Events[1].Rank)
+		assert.Equal(t, "200BK", ranking.Events[2].Event)
+		assert.Zero(t, ranking.Events[2].Rank)
+		assert.Nil(t, ranking.Events[2].Points)
+
+		rr = client.Get("/api/v1/points?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		AssertJSONBody(t, rr, &ranking)
+		assert.Empty(t, ranking.Events)
+
+		rr = client.Get("/api/v1/points?course_type=10m")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("POST /points/base-times replaces the table for the course and gender", func(t *testing.T) {
+		rr := client.Post("/api/v1/points/base-times", WorldAquaticsBaseTimeInput{
+			CourseType: "50m", Gender: "female", Times: map[string]string{"100FR": "51.71"},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/points/base-times?course_type=50m&gender=female")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list WorldAquaticsBaseTimeList
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.BaseTimes, 1)
+		assert.Equal(t, "100FR", list.BaseTimes[0].Event)
+	})
+
+	t.Run("POST and PUT /times return points", func(t *testing.T) {
+		rr := client.Post("/api/v1/times", TimeInput{MeetID: summerID, Event: "100FR", TimeMS: 65000, EventDate: "2026-07-20"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var created TimeRecord
+		AssertJSONBody(t, rr, &created)
+		require.NotNil(t, created.Points)
+		assert.Equal(t, 503, *created.Points)
+
+		rr = client.Put("/api/v1/times/"+created.ID, TimeInput{MeetID: summerID, Event: "100FR", TimeMS: 60000, EventDate: "2026-07-20"})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var updated TimeRecord
+		AssertJSONBody(t, rr, &updated)
+		require.NotNil(t, updated.Points)
+		assert.Greater(t, *updated.Points, 503)
+
+		fallID := createMeet(t, "Fall Open", "2026-10-10")
+		rr = client.Post("/api/v1/times/batch", map[string]interface{}{
+			"meet_id": fallID,
+			"times": []map[string]interface{}{
+				{"event": "100FR", "time_ms": 65000, "event_date": "2026-10-10"},
+				{"event": "50FR", "time_ms": 30000, "event_date": "2026-10-10"},
+			},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var batch struct {
+			Times []TimeRecord `json:"times"`
+		}
+		AssertJSONBody(t, rr, &batch)
+		require.Len(t, batch.Times, 2)
+		require.NotNil(t, batch.Times[0].Points)
+		assert.Equal(t, 503, *batch.Times[0].Points)
+		assert.Nil(t, batch.Times[1].Points)
+	})
+}
@@ -0,0 +1,236 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/swimstats/backend/internal/domain/pointstable"
+)
+
+type PointsTableList struct {
+	Tables []struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Format      string `json:"format"`
+	} `json:"tables"`
+}
+
+type SwimPoints struct {
+	TimeID     string `json:"time_id"`
+	Event      string `json:"event"`
+	CourseType string `json:"course_type"`
+	TimeMS     int    `json:"time_ms"`
+	MeetName   string `json:"meet"`
+	Date       string `json:"date"`
+	Season     string `json:"season"`
+	Age        int    `json:"age"`
+	Points     *int   `json:"points"`
+}
+
+type SwimPointsList struct {
+	Table string       `json:"table"`
+	Times []SwimPoints `json:"times"`
+}
+
+type PointsProfile struct {
+	Table   string `json:"table"`
+	Seasons []struct {
+		Season      string       `json:"season"`
+		TotalPoints int          `json:"total_points"`
+		Events      []SwimPoints `json:"events"`
+	} `json:"seasons"`
+}
+
+const rudolphLongCourse = `{
+  "format": "rudolph",
+  "name": "rudolph",
+  "description": "Rudolph table (test)",
+  "course_type": "50m",
+  "gender": "female",
+  "times": {
+    "50FR": {
+      "13": ["28.00", "29.00", "30.00", "31.00", "32.00"],
+      "14": ["27.50", "28.50", "29.50", "30.50", "31.50"]
+    },
+    "100FR": {
+      "13": ["1:02.00", "1:04.00", "1:06.00", "1:08.00", "1:10.00"]
+    }
+  }
+}`
+
+const rudolphShortCourse = `{
+  "format": "rudolph",
+  "name": "rudolph",
+  "course_type": "25m",
+  "gender": "female",
+  "times": {
+    "50FR": {
+      "12": ["27.00", "28.00", "29.00"]
+    }
+  }
+}`
+
+// loadPointsTables writes table files to a temporary directory and loads them.
+func loadPointsTables(t *testing.T, files map[string]string) ([]pointstable.Table, error) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return pointstable.LoadDir(dir)
+}
+
+func TestPointsTableAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	tables, err := loadPointsTables(t, map[string]string{
+		"rudolph-female-lc.json": rudolphLongCourse,
+		"rudolph-female-sc.json": rudolphShortCourse,
+	})
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+	pointstable.SetTables(tables)
+	t.Cleanup(func() { pointstable.SetTables(nil) })
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Rudolph Swimmer", BirthDate: "2012-05-01", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	createMeet := func(t *testing.T, name, date, courseType string) string {
+		t.Helper()
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: name, City: "Toronto", StartDate: date, EndDate: date, CourseType: courseType,
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+		return meet.ID
+	}
+	createTime := func(t *testing.T, meetID, event string, timeMS int, date string) {
+		t.Helper()
+		rr := client.Post("/api/v1/times", TimeInput{MeetID: meetID, Event: event, TimeMS: timeMS, EventDate: date})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	fallID := createMeet(t, "Fall Classic", "2025-11-15", "50m")
+	summerID := createMeet(t, "Summer Provincials", "2026-07-20", "50m")
+	octoberID := createMeet(t, "October Invitational", "2026-10-10", "25m")
+	createTime(t, fallID, "50FR", 29800, "2025-11-15")
+	createTime(t, fallID, "100FR", 63500, "2025-11-15")
+	createTime(t, fallID, "200BK", 165000, "2025-11-15")
+	createTime(t, summerID, "50FR", 29400, "2026-07-20")
+	createTime(t, octoberID, "50FR", 28400, "2026-10-10")
+
+	t.Run("GET /points-tables lists the loaded tables", func(t *testing.T) {
+		rr := client.Get("/api/v1/points-tables")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var list PointsTableList
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.Tables, 1)
+		assert.Equal(t, "rudolph", list.Tables[0].Name)
+		assert.Equal(t, "rudolph", list.Tables[0].Format)
+		assert.Equal(t, "Rudolph table (test)", list.Tables[0].Description)
+	})
+
+	t.Run("GET /points-tables/{name}/times scores each swim at the swimmer's age", func(t *testing.T) {
+		rr := client.Get("/api/v1/points-tables/rudolph/times")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var list SwimPointsList
+		AssertJSONBody(t, rr, &list)
+		assert.Equal(t, "rudolph", list.Table)
+		require.Len(t, list.Times, 5)
+
+		points := make(map[string]*int)
+		ages := make(map[string]int)
+		for _, swim := range list.Times {
+			points[swim.Event+"@"+swim.Date] = swim.Points
+			ages[swim.Event+"@"+swim.Date] = swim.Age
+		}
+		assert.Equal(t, 13, ages["50FR@2025-11-15"])
+		require.NotNil(t, points["50FR@2025-11-15"])
+		assert.Equal(t, 3, *points["50FR@2025-11-15"])
+		require.NotNil(t, points["100FR@2025-11-15"])
+		assert.Equal(t, 4, *points["100FR@2025-11-15"])
+		assert.Nil(t, points["200BK@2025-11-15"])
+
+		// Age 14 uses the 14 row
+		assert.Equal(t, 14, ages["50FR@2026-07-20"])
+		require.NotNil(t, points["50FR@2026-07-20"])
+		assert.Equal(t, 3, *points["50FR@2026-07-20"])
+
+		// The short course file was merged into the same table; its oldest row covers age 14
+		require.NotNil(t, points["50FR@2026-10-10"])
+		assert.Equal(t, 1, *points["50FR@2026-10-10"])
+	})
+
+	t.Run("GET /points-tables/{name}/profile builds a best-points profile per season", func(t *testing.T) {
+		rr := client.Get("/api/v1/points-tables/rudolph/profile")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var profile PointsProfile
+		AssertJSONBody(t, rr, &profile)
+		require.Len(t, profile.Seasons, 2)
+
+		first := profile.Seasons[0]
+		assert.Equal(t, "2025-26", first.Season)
+		assert.Equal(t, 7, first.TotalPoints)
+		require.Len(t, first.Events, 2)
+		assert.Equal(t, "100FR", first.Events[0].Event)
+		assert.Equal(t, 4, *first.Events[0].Points)
+		// Equal points keep the faster swim
+		assert.Equal(t, "50FR", first.Events[1].Event)
+		assert.Equal(t, 29400, first.Events[1].TimeMS)
+
+		assert.Equal(t, "2026-27", profile.Seasons[1].Season)
+		assert.Equal(t, 1, profile.Seasons[1].TotalPoints)
+
+		rr = client.Get("/api/v1/points-tables/rudolph/profile?season=2026-27&course_type=50m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		AssertJSONBody(t, rr, &profile)
+		assert.Empty(t, profile.Seasons)
+	})
+
+	t.Run("GET /points-tables/{name} validates the request", func(t *testing.T) {
+		rr := client.Get("/api/v1/points-tables/unknown/times")
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+
+		rr = client.Get("/api/v1/points-tables/rudolph/profile?course_type=10m")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("LoadDir rejects malformed tables", func(t *testing.T) {
+		_, err := loadPointsTables(t, map[string]string{"bad.json": `{
+		  "format": "rudolph", "name": "bad", "course_type": "50m", "gender": "female",
+		  "times": {"50FR": {"13": ["30.00", "29.00"]}}
+		}`})
+		assert.Error(t, err)
+
+		_, err = loadPointsTables(t, map[string]string{"unknown.json": `{"format": "fina-1999", "name": "old"}`})
+		assert.Error(t, err)
+
+		_, err = loadPointsTables(t, map[string]string{
+			"a.json": rudolphLongCourse,
+			"b.json": rudolphLongCourse,
+		})
+		assert.Error(t, err)
+	})
+}
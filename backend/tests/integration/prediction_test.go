@@ -0,0 +1,144 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type Prediction struct {
+	Model           string `json:"model"`
+	SampleSize      int    `json:"sample_size"`
+	BestTimeMS      int    `json:"best_time_ms"`
+	Date            string `json:"date"`
+	PredictedTimeMS int    `json:"predicted_time_ms"`
+	LowerMS         int    `json:"lower_ms"`
+	UpperMS         int    `json:"upper_ms"`
+	Standard        *struct {
+		StandardTimeMS int     `json:"standard_time_ms"`
+		Achieved       bool    `json:"achieved"`
+		LikelyDate     *string `json:"likely_date"`
+		EarliestDate   *string `json:"earliest_date"`
+	} `json:"standard"`
+}
+
+type ProgressTrend struct {
+	Trend *struct {
+		Model      string `json:"model"`
+		SampleSize int    `json:"sample_size"`
+		Points     []struct {
+			Date    string `json:"date"`
+			TimeMS  int    `json:"time_ms"`
+			LowerMS int    `json:"lower_ms"`
+			UpperMS int    `json:"upper_ms"`
+		} `json:"points"`
+	} `json:"trend"`
+}
+
+func TestPredictionAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Trend Swimmer", BirthDate: "2012-05-01", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	rr = client.Post("/api/v1/standards/import", StandardImportInput{
+		Name: "Provincial", CourseType: "25m", Gender: "female",
+		Times: []StandardTimeInput{{Event: "50FR", AgeGroup: "OPEN", TimeMs: 32000}},
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var std StandardWithTimes
+	AssertJSONBody(t, rr, &std)
+
+	// Steady improvement that is levelling off
+	for i, timeMS := range []int{40000, 38000, 36500, 35500, 34800} {
+		date := time.Date(2021+i, 3, 1, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+		rr := client.Post("/api/v1/meets", MeetInput{Name: "Spring Open", City: "Calgary", StartDate: date, EndDate: date, CourseType: "25m"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: "50FR", TimeMS: timeMS, EventDate: date})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	t.Run("GET /progress/{event} includes a trend line", func(t *testing.T) {
+		rr := client.Get("/api/v1/progress/50FR?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var data ProgressTrend
+		AssertJSONBody(t, rr, &data)
+		require.NotNil(t, data.Trend)
+		assert.Equal(t, 5, data.Trend.SampleSize)
+		require.Len(t, data.Trend.Points, 5)
+		for _, p := range data.Trend.Points {
+			assert.LessOrEqual(t, p.LowerMS, p.TimeMS)
+			assert.GreaterOrEqual(t, p.UpperMS, p.TimeMS)
+		}
+		assert.Greater(t, data.Trend.Points[0].TimeMS, data.Trend.Points[4].TimeMS)
+	})
+
+	t.Run("GET /progress/{event} omits the trend with too few swims", func(t *testing.T) {
+		rr := client.Get("/api/v1/progress/50FR?course_type=25m&start_date=2024-01-01")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var data ProgressTrend
+		AssertJSONBody(t, rr, &data)
+		assert.Nil(t, data.Trend)
+	})
+
+	t.Run("GET /progress/{event}/prediction predicts a future time", func(t *testing.T) {
+		for _, model := range []string{"log", "exponential"} {
+			rr := client.Get("/api/v1/progress/50FR/prediction?course_type=25m&date=2026-03-01&model=" + model)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			var p Prediction
+			AssertJSONBody(t, rr, &p)
+			assert.Equal(t, model, p.Model)
+			assert.Equal(t, 5, p.SampleSize)
+			assert.Equal(t, 34800, p.BestTimeMS)
+			assert.Equal(t, "2026-03-01", p.Date)
+			assert.Less(t, p.PredictedTimeMS, 34800)
+			assert.Greater(t, p.PredictedTimeMS, 30000)
+			assert.Less(t, p.LowerMS, p.PredictedTimeMS)
+			assert.Greater(t, p.UpperMS, p.PredictedTimeMS)
+		}
+	})
+
+	t.Run("GET /progress/{event}/prediction estimates when a standard is reached", func(t *testing.T) {
+		rr := client.Get("/api/v1/progress/50FR/prediction?course_type=25m&standard_id=" + std.ID)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var p Prediction
+		AssertJSONBody(t, rr, &p)
+		require.NotNil(t, p.Standard)
+		assert.Equal(t, 32000, p.Standard.StandardTimeMS)
+		assert.False(t, p.Standard.Achieved)
+		require.NotNil(t, p.Standard.LikelyDate)
+		require.NotNil(t, p.Standard.EarliestDate)
+		assert.LessOrEqual(t, *p.Standard.EarliestDate, *p.Standard.LikelyDate)
+	})
+
+	t.Run("GET /progress/{event}/prediction needs enough swims", func(t *testing.T) {
+		rr := client.Get("/api/v1/progress/100FR/prediction?course_type=25m")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Get("/api/v1/progress/50FR/prediction?course_type=25m&model=linear")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -17,6 +17,10 @@ type ProgressDataPoint struct {
 	MeetName       string `json:"meet_name"`
 	Event          string `json:"event"`
 	IsPersonalBest bool   `json:"is_pb"`
+	Points         *int   `json:"points,omitempty"`
+
+	WaterTemperatureC *float64 `json:"water_temperature_c,omitempty"`
+	Wetsuit           *bool    `json:"wetsuit,omitempty"`
 }
 
 type ProgressData struct {
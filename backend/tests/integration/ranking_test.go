@@ -0,0 +1,281 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type RankingEntryInput struct {
+	Rank        int    `json:"rank,omitempty"`
+	SwimmerName string `json:"swimmer_name,omitempty"`
+	Club        string `json:"club,omitempty"`
+	Time        string `json:"time,omitempty"`
+	TimeMS      int    `json:"time_ms,omitempty"`
+}
+
+type RankingImportInput struct {
+	Source     string              `json:"source"`
+	Season     string              `json:"season"`
+	Event      string              `json:"event"`
+	CourseType string              `json:"course_type"`
+	Gender     string              `json:"gender"`
+	AgeGroup   string              `json:"age_group"`
+	Entries    []RankingEntryInput `json:"entries"`
+}
+
+type RankingSnapshot struct {
+	ID         string `json:"id"`
+	Source     string `json:"source"`
+	Season     string `json:"season"`
+	Gender     string `json:"gender"`
+	AgeGroup   string `json:"age_group"`
+	EntryCount int    `json:"entry_count"`
+	Entries    []struct {
+		Rank   int `json:"rank"`
+		TimeMS int `json:"time_ms"`
+	} `json:"entries"`
+}
+
+type RankingImportResult struct {
+	Snapshot RankingSnapshot `json:"snapshot"`
+	Replaced bool            `json:"replaced"`
+	Imported int             `json:"imported"`
+	Skipped  int             `json:"skipped"`
+}
+
+type RankingComparison struct {
+	Rankings []struct {
+		SnapshotID    string   `json:"snapshot_id"`
+		Season        string   `json:"season"`
+		AgeGroup      string   `json:"age_group"`
+		ListSize      int      `json:"list_size"`
+		SwimmerTimeMS *int     `json:"swimmer_time_ms"`
+		Rank          *int     `json:"rank"`
+		InList        bool     `json:"in_list"`
+		Percentile    *float64 `json:"percentile"`
+		Targets       []struct {
+			Place    int  `json:"place"`
+			TimeMS   int  `json:"time_ms"`
+			Achieved bool `json:"achieved"`
+			GapMS    *int `json:"gap_ms"`
+		} `json:"targets"`
+	} `json:"rankings"`
+}
+
+func TestRankingsAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	now := time.Now()
+	seasonStart := time.Date(now.Year(), time.September, 1, 0, 0, 0, 0, time.UTC)
+	if now.Before(seasonStart) {
+		seasonStart = seasonStart.AddDate(-1, 0, 0)
+	}
+	season := fmt.Sprintf("%d-%02d", seasonStart.Year(), (seasonStart.Year()+1)%100)
+	lastSeason := fmt.Sprintf("%d-%02d", seasonStart.Year()-1, seasonStart.Year()%100)
+
+	// 13-14 this season and 11-12 last season
+	birthDate := fmt.Sprintf("%d-05-01", seasonStart.Year()-13)
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Ranked Swimmer", BirthDate: birthDate, Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	// 1:02.00 last season, 1:00.00 this season
+	for _, swim := range []struct {
+		date   string
+		timeMS int
+	}{
+		{seasonStart.AddDate(0, -3, 0).Format("2006-01-02"), 62000},
+		{seasonStart.AddDate(0, 0, 14).Format("2006-01-02"), 60000},
+	} {
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: "Ranking Meet " + swim.date, City: "Toronto", StartDate: swim.date, EndDate: swim.date, CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: "100FR", TimeMS: swim.timeMS, EventDate: swim.date})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	// A top 30 from 55.00 in steps of 0.30
+	top30 := make([]RankingEntryInput, 30)
+	for i := range top30 {
+		top30[i] = RankingEntryInput{SwimmerName: fmt.Sprintf("Swimmer %d", i+1), TimeMS: 55000 + i*300}
+	}
+
+	var current RankingImportResult
+
+	t.Run("POST /rankings/import imports a JSON ranking snapshot", func(t *testing.T) {
+		rr := client.Post("/api/v1/rankings/import", RankingImportInput{
+			Source: "Provincial Top 50", Season: season, Event: "100FR", CourseType: "25m",
+			Gender: "female", AgeGroup: "13-14", Entries: append(top30, RankingEntryInput{Time: "fast"}),
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		AssertJSONBody(t, rr, &current)
+
+		assert.False(t, current.Replaced)
+		assert.Equal(t, 30, current.Imported)
+		assert.Equal(t, 1, current.Skipped)
+		require.Len(t, current.Snapshot.Entries, 30)
+		assert.Equal(t, 1, current.Snapshot.Entries[0].Rank)
+		assert.Equal(t, 30, current.Snapshot.Entries[29].Rank)
+
+		rr = client.Post("/api/v1/rankings/import", RankingImportInput{
+			Source: "Provincial Top 50", Season: season, Event: "100FR", CourseType: "25m",
+			Gender: "male", AgeGroup: "13-14", Entries: top30,
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		rr = client.Post("/api/v1/rankings/import", RankingImportInput{
+			Source: "Provincial Top 50", Season: season, Event: "100FR", CourseType: "25m",
+			Gender: "female", AgeGroup: "15-17", Entries: top30,
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	})
+
+	t.Run("POST /rankings/import replaces a snapshot with the same key", func(t *testing.T) {
+		rr := client.Post("/api/v1/rankings/import", RankingImportInput{
+			Source: "Provincial Top 50", Season: season, Event: "100FR", CourseType: "25m",
+			Gender: "female", AgeGroup: "13-14", Entries: top30,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var replaced RankingImportResult
+		AssertJSONBody(t, rr, &replaced)
+		assert.True(t, replaced.Replaced)
+		assert.Equal(t, current.Snapshot.ID, replaced.Snapshot.ID)
+		assert.Equal(t, 30, replaced.Snapshot.EntryCount)
+	})
+
+	t.Run("POST /rankings/import rejects an invalid snapshot", func(t *testing.T) {
+		rr := client.Post("/api/v1/rankings/import", RankingImportInput{
+			Source: "Provincial Top 50", Season: "2025", Event: "100FR", CourseType: "25m",
+			Gender: "female", AgeGroup: "13-14", Entries: top30,
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("POST /rankings/import/csv imports a CSV ranking snapshot", func(t *testing.T) {
+		query := url.Values{
+			"source": {"Provincial Top 50"}, "season": {lastSeason}, "event": {"100FR"},
+			"course_type": {"25m"}, "gender": {"female"}, "age_group": {"11-12"},
+		}
+		csv := "rank,name,club,time\n" +
+			"1.,A. Swimmer,OAC,1:01.00\n" +
+			"2.,B. Swimmer,NCSC,1:02.00\n" +
+			"3.,C. Swimmer,OAC,1:03.00\n"
+		rr := client.PostRaw("/api/v1/rankings/import/csv?"+query.Encode(), "text/csv", csv)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var result RankingImportResult
+		AssertJSONBody(t, rr, &result)
+		assert.Equal(t, 3, result.Imported)
+		require.Len(t, result.Snapshot.Entries, 3)
+		assert.Equal(t, 61000, result.Snapshot.Entries[0].TimeMS)
+
+		rr = client.PostRaw("/api/v1/rankings/import/csv?"+query.Encode(), "text/csv", "rank,name\n1,A. Swimmer\n")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("GET /rankings lists snapshots with filters", func(t *testing.T) {
+		rr := client.Get("/api/v1/rankings")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list struct {
+			Snapshots []RankingSnapshot `json:"snapshots"`
+		}
+		AssertJSONBody(t, rr, &list)
+		assert.Len(t, list.Snapshots, 4)
+
+		rr = client.Get("/api/v1/rankings?gender=female&age_group=13-14")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.Snapshots, 1)
+		assert.Equal(t, 30, list.Snapshots[0].EntryCount)
+	})
+
+	t.Run("GET /comparisons/rankings ranks the PB in applicable lists", func(t *testing.T) {
+		rr := client.Get("/api/v1/comparisons/rankings?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var comparison RankingComparison
+		AssertJSONBody(t, rr, &comparison)
+
+		// The male and 15-17 lists do not apply
+		require.Len(t, comparison.Rankings, 2)
+
+		this := comparison.Rankings[0]
+		assert.Equal(t, season, this.Season)
+		assert.Equal(t, 30, this.ListSize)
+		require.NotNil(t, this.SwimmerTimeMS)
+		assert.Equal(t, 60000, *this.SwimmerTimeMS)
+		require.NotNil(t, this.Rank)
+		assert.Equal(t, 18, *this.Rank)
+		assert.True(t, this.InList)
+		require.NotNil(t, this.Percentile)
+		assert.InDelta(t, 43.33, *this.Percentile, 0.01)
+		require.Len(t, this.Targets, 3)
+		assert.Equal(t, 8, this.Targets[0].Place)
+		assert.Equal(t, 57100, this.Targets[0].TimeMS)
+		require.NotNil(t, this.Targets[0].GapMS)
+		assert.Equal(t, 2900, *this.Targets[0].GapMS)
+		assert.False(t, this.Targets[0].Achieved)
+		require.NotNil(t, this.Targets[1].GapMS)
+		assert.Equal(t, 500, *this.Targets[1].GapMS)
+		assert.True(t, this.Targets[2].Achieved)
+
+		// Last season's list uses the PB as it stood at the end of that season
+		last := comparison.Rankings[1]
+		assert.Equal(t, lastSeason, last.Season)
+		assert.Equal(t, "11-12", last.AgeGroup)
+		require.NotNil(t, last.SwimmerTimeMS)
+		assert.Equal(t, 62000, *last.SwimmerTimeMS)
+		require.NotNil(t, last.Rank)
+		assert.Equal(t, 2, *last.Rank)
+		require.NotNil(t, last.Percentile)
+		assert.InDelta(t, 66.67, *last.Percentile, 0.01)
+		assert.Empty(t, last.Targets)
+	})
+
+	t.Run("GET /comparisons/rankings filters by season", func(t *testing.T) {
+		rr := client.Get("/api/v1/comparisons/rankings?season=" + lastSeason)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var comparison RankingComparison
+		AssertJSONBody(t, rr, &comparison)
+		require.Len(t, comparison.Rankings, 1)
+		assert.Equal(t, lastSeason, comparison.Rankings[0].Season)
+
+		rr = client.Get("/api/v1/comparisons/rankings?season=2025-27")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("view-only users cannot import rankings", func(t *testing.T) {
+		client.SetMockUser("view_only")
+		defer client.SetMockUser("full")
+
+		rr := client.Post("/api/v1/rankings/import", RankingImportInput{Source: "Other"})
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("DELETE /rankings/{id} deletes a snapshot", func(t *testing.T) {
+		rr := client.Delete("/api/v1/rankings/" + current.Snapshot.ID)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/rankings/" + current.Snapshot.ID)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
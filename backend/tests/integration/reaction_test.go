@@ -0,0 +1,166 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ReactionTimeStats struct {
+	Count        int  `json:"count"`
+	AverageMS    *int `json:"average_ms,omitempty"`
+	BestMS       *int `json:"best_ms,omitempty"`
+	FlaggedCount int  `json:"flagged_count"`
+}
+
+type SeasonReactionTimes struct {
+	Season string `json:"season"`
+	ReactionTimeStats
+	ChangeMS *int `json:"change_ms,omitempty"`
+}
+
+type StrokeReactionTimes struct {
+	Stroke string `json:"stroke"`
+	ReactionTimeStats
+	Seasons []SeasonReactionTimes `json:"seasons"`
+}
+
+type ReactionTimePoint struct {
+	TimeID          string `json:"time_id"`
+	Event           string `json:"event"`
+	ReactionTimeMS  int    `json:"reaction_time_ms"`
+	Season          string `json:"season"`
+	FalseStartRange bool   `json:"false_start_range"`
+}
+
+type ReactionTimeAnalysis struct {
+	FalseStartThresholdMS int                   `json:"false_start_threshold_ms"`
+	Overall               ReactionTimeStats     `json:"overall"`
+	ByStroke              []StrokeReactionTimes `json:"by_stroke"`
+	BySeason              []SeasonReactionTimes `json:"by_season"`
+	Trend                 []ReactionTimePoint   `json:"trend"`
+	Flagged               []ReactionTimePoint   `json:"flagged"`
+}
+
+func TestReactionTimeAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Reaction Swimmer", BirthDate: "2012-05-15", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK)
+
+	createMeet := func(t *testing.T, name, date string) string {
+		t.Helper()
+		rr := client.Post("/api/v1/meets", MeetInput{Name: name, City: "Toronto", StartDate: date, CourseType: "25m"})
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+		return meet.ID
+	}
+
+	t.Run("GET /reaction-times returns empty analysis without data", func(t *testing.T) {
+		rr := client.Get("/api/v1/reaction-times")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var analysis ReactionTimeAnalysis
+		AssertJSONBody(t, rr, &analysis)
+		assert.Equal(t, 100, analysis.FalseStartThresholdMS)
+		assert.Equal(t, 0, analysis.Overall.Count)
+		assert.Nil(t, analysis.Overall.AverageMS)
+		assert.Empty(t, analysis.Trend)
+	})
+
+	t.Run("GET /reaction-times summarizes by stroke and season", func(t *testing.T) {
+		fallMeet := createMeet(t, "Fall Invitational", "2025-10-10")
+		nextFallMeet := createMeet(t, "Next Fall Invitational", "2026-10-10")
+
+		rr := client.Post("/api/v1/times/batch", map[string]interface{}{
+			"meet_id": fallMeet,
+			"times": []map[string]interface{}{
+				{"event": "50FR", "time_ms": 30120, "event_date": "2025-10-10", "reaction_time_ms": 700},
+				{"event": "100FR", "time_ms": 65320, "event_date": "2025-10-10", "reaction_time_ms": 680},
+				{"event": "50BK", "time_ms": 34500, "event_date": "2025-10-10", "reaction_time_ms": 550},
+			},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		rr = client.Post("/api/v1/times/batch", map[string]interface{}{
+			"meet_id": nextFallMeet,
+			"times": []map[string]interface{}{
+				{"event": "50FR", "time_ms": 29800, "event_date": "2026-10-10", "reaction_time_ms": 640},
+				{"event": "100FR", "time_ms": 64900, "event_date": "2026-10-10", "reaction_time_ms": 90},
+				{"event": "200FR", "time_ms": 145000, "event_date": "2026-10-10"},
+			},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/reaction-times?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var analysis ReactionTimeAnalysis
+		AssertJSONBody(t, rr, &analysis)
+
+		// Times without a reaction time are ignored; the 90ms start is flagged
+		assert.Equal(t, 5, analysis.Overall.Count)
+		assert.Equal(t, 1, analysis.Overall.FlaggedCount)
+		require.NotNil(t, analysis.Overall.BestMS)
+		assert.Equal(t, 550, *analysis.Overall.BestMS)
+		require.NotNil(t, analysis.Overall.AverageMS)
+		assert.Equal(t, 643, *analysis.Overall.AverageMS)
+
+		require.Len(t, analysis.Flagged, 1)
+		assert.Equal(t, "100FR", analysis.Flagged[0].Event)
+		assert.True(t, analysis.Flagged[0].FalseStartRange)
+
+		require.Len(t, analysis.Trend, 5)
+		assert.Equal(t, "2025-26", analysis.Trend[0].Season)
+		assert.Equal(t, "2026-27", analysis.Trend[4].Season)
+
+		require.Len(t, analysis.BySeason, 2)
+		assert.Equal(t, "2025-26", analysis.BySeason[0].Season)
+		assert.Nil(t, analysis.BySeason[0].ChangeMS)
+		require.NotNil(t, analysis.BySeason[1].ChangeMS)
+		assert.Equal(t, -3, *analysis.BySeason[1].ChangeMS)
+
+		require.Len(t, analysis.ByStroke, 2)
+		freestyle := analysis.ByStroke[0]
+		assert.Equal(t, "Freestyle", freestyle.Stroke)
+		assert.Equal(t, 4, freestyle.Count)
+		require.NotNil(t, freestyle.BestMS)
+		assert.Equal(t, 640, *freestyle.BestMS)
+		require.Len(t, freestyle.Seasons, 2)
+		require.NotNil(t, freestyle.Seasons[1].ChangeMS)
+		assert.Equal(t, -50, *freestyle.Seasons[1].ChangeMS)
+		assert.Equal(t, "Backstroke", analysis.ByStroke[1].Stroke)
+	})
+
+	t.Run("GET /reaction-times rejects invalid course type", func(t *testing.T) {
+		rr := client.Get("/api/v1/reaction-times?course_type=33m")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("POST /times/batch rejects invalid reaction time", func(t *testing.T) {
+		meetID := createMeet(t, "Winter Meet", "2026-12-05")
+		rr := client.Post("/api/v1/times/batch", map[string]interface{}{
+			"meet_id": meetID,
+			"times": []map[string]interface{}{
+				{"event": "50FR", "time_ms": 30120, "event_date": "2026-12-05", "reaction_time_ms": 4000},
+			},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
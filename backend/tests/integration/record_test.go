@@ -0,0 +1,245 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type RecordInput struct {
+	Event      string `json:"event"`
+	CourseType string `json:"course_type"`
+	Gender     string `json:"gender"`
+	AgeGroup   string `json:"age_group"`
+	Time       string `json:"time,omitempty"`
+	TimeMS     int    `json:"time_ms,omitempty"`
+	Holder     string `json:"holder,omitempty"`
+	Date       string `json:"date,omitempty"`
+}
+
+type RecordImportInput struct {
+	Name    string        `json:"name"`
+	Scope   string        `json:"scope"`
+	Records []RecordInput `json:"records"`
+}
+
+type RecordTable struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Scope       string `json:"scope"`
+	RecordCount int    `json:"record_count"`
+	Records     []struct {
+		Event    string `json:"event"`
+		AgeGroup string `json:"age_group"`
+		TimeMS   int    `json:"time_ms"`
+		Holder   string `json:"holder"`
+	} `json:"records"`
+}
+
+type RecordImportResult struct {
+	Table    RecordTable `json:"table"`
+	Replaced bool        `json:"replaced"`
+	Imported int         `json:"imported"`
+	Skipped  int         `json:"skipped"`
+	Errors   []string    `json:"errors"`
+}
+
+type RecordComparison struct {
+	Records []struct {
+		Event        string `json:"event"`
+		Gender       string `json:"gender"`
+		AgeGroup     string `json:"age_group"`
+		TableName    string `json:"table_name"`
+		BestTimeMS   *int   `json:"best_time_ms"`
+		DifferenceMS *int   `json:"difference_ms"`
+		Status       string `json:"status"`
+	} `json:"records"`
+	Summary struct {
+		Broken      int `json:"broken"`
+		Equalled    int `json:"equalled"`
+		Approaching int `json:"approaching"`
+	} `json:"summary"`
+}
+
+func TestRecordsAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	// Born 2012: 13-14 in 2025
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Record Swimmer", BirthDate: "2012-05-01", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	var club RecordImportResult
+
+	t.Run("POST /records/import imports a JSON record table", func(t *testing.T) {
+		rr := client.Post("/api/v1/records/import", RecordImportInput{
+			Name:  "Ottawa Club Records",
+			Scope: "club",
+			Records: []RecordInput{
+				{Event: "100FR", CourseType: "25m", Gender: "female", AgeGroup: "13-14", Time: "1:00.00", Holder: "A. Swimmer", Date: "2020-01-01"},
+				{Event: "100FR", CourseType: "25m", Gender: "female", AgeGroup: "OPEN", TimeMS: 55000},
+				{Event: "50BK", CourseType: "25m", Gender: "mixed", AgeGroup: "13-14", Time: "35.00"},
+				{Event: "100FR", CourseType: "25m", Gender: "male", AgeGroup: "13-14", Time: "58.00"},
+				{Event: "999XX", CourseType: "25m", Gender: "female", AgeGroup: "13-14", Time: "30.00"},
+			},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		AssertJSONBody(t, rr, &club)
+
+		assert.False(t, club.Replaced)
+		assert.Equal(t, 4, club.Imported)
+		assert.Equal(t, 1, club.Skipped)
+		assert.Len(t, club.Errors, 1)
+		assert.Equal(t, 4, club.Table.RecordCount)
+		assert.Equal(t, "club", club.Table.Scope)
+	})
+
+	t.Run("POST /records/import rejects an invalid table", func(t *testing.T) {
+		rr := client.Post("/api/v1/records/import", RecordImportInput{Name: "Bad", Scope: "world", Records: []RecordInput{
+			{Event: "100FR", CourseType: "25m", Gender: "female", AgeGroup: "13-14", Time: "1:00.00"},
+		}})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		// A table without valid records is rejected and leaves the existing table in place
+		rr = client.Post("/api/v1/records/import", RecordImportInput{Name: "Ottawa Club Records", Scope: "club", Records: []RecordInput{
+			{Event: "999XX", CourseType: "25m", Gender: "female", AgeGroup: "13-14", Time: "30.00"},
+		}})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		rr = client.Get("/api/v1/records/" + club.Table.ID)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var table RecordTable
+		AssertJSONBody(t, rr, &table)
+		assert.Equal(t, 4, table.RecordCount)
+	})
+
+	t.Run("POST /records/import/csv imports and replaces a CSV record table", func(t *testing.T) {
+		csv := "event,course_type,gender,age_group,time,holder,date\n" +
+			"100FR,25m,female,13-14,57.50,P. Swimmer,2019-03-01\n"
+		rr := client.PostRaw("/api/v1/records/import/csv?name=Ontario+Records&scope=provincial", "text/csv", csv)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var result RecordImportResult
+		AssertJSONBody(t, rr, &result)
+		assert.Equal(t, 1, result.Imported)
+		require.Len(t, result.Table.Records, 1)
+		assert.Equal(t, 57500, result.Table.Records[0].TimeMS)
+		assert.Equal(t, "P. Swimmer", result.Table.Records[0].Holder)
+
+		csv = "age_group,event,gender,course_type,time\n" +
+			"13-14,100FR,female,25m,57.20\n"
+		rr = client.PostRaw("/api/v1/records/import/csv?name=Ontario+Records&scope=provincial", "text/csv", csv)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var replaced RecordImportResult
+		AssertJSONBody(t, rr, &replaced)
+		assert.True(t, replaced.Replaced)
+		assert.Equal(t, result.Table.ID, replaced.Table.ID)
+		require.Len(t, replaced.Table.Records, 1)
+		assert.Equal(t, 57200, replaced.Table.Records[0].TimeMS)
+
+		rr = client.PostRaw("/api/v1/records/import/csv?name=Ontario+Records&scope=provincial", "text/csv", "event,time\n100FR,57.20\n")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("GET /records lists record tables", func(t *testing.T) {
+		rr := client.Get("/api/v1/records")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list struct {
+			Tables []RecordTable `json:"tables"`
+		}
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.Tables, 2)
+		assert.Equal(t, "Ottawa Club Records", list.Tables[0].Name)
+		assert.Equal(t, 4, list.Tables[0].RecordCount)
+		assert.Equal(t, "provincial", list.Tables[1].Scope)
+	})
+
+	t.Run("POST /times flags records approached and broken", func(t *testing.T) {
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: "Record Invitational", City: "Ottawa", StartDate: "2025-11-01", EndDate: "2025-11-01", CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: "100FR", TimeMS: 61000, EventDate: "2025-11-01"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var near TimeRecord
+		AssertJSONBody(t, rr, &near)
+		require.Len(t, near.Records, 1)
+		assert.Equal(t, "Ottawa Club Records", near.Records[0].TableName)
+		assert.Equal(t, "13-14", near.Records[0].AgeGroup)
+		assert.Equal(t, 1000, near.Records[0].DifferenceMS)
+		assert.False(t, near.Records[0].Broken)
+
+		rr = client.Post("/api/v1/meets", MeetInput{
+			Name: "Record Finals", City: "Ottawa", StartDate: "2025-12-06", EndDate: "2025-12-06", CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var finals Meet
+		AssertJSONBody(t, rr, &finals)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: finals.ID, Event: "100FR", TimeMS: 59500, EventDate: "2025-12-06"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var broken TimeRecord
+		AssertJSONBody(t, rr, &broken)
+		require.Len(t, broken.Records, 1)
+		assert.True(t, broken.Records[0].Broken)
+		assert.Equal(t, -500, broken.Records[0].DifferenceMS)
+	})
+
+	t.Run("GET /records/comparison compares bests with records", func(t *testing.T) {
+		rr := client.Get("/api/v1/records/comparison?table_id=" + club.Table.ID)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var comparison RecordComparison
+		AssertJSONBody(t, rr, &comparison)
+
+		// The male record does not apply
+		require.Len(t, comparison.Records, 3)
+		statuses := make(map[string]string)
+		for _, r := range comparison.Records {
+			statuses[r.Event+" "+r.AgeGroup] = r.Status
+		}
+		assert.Equal(t, "broken", statuses["100FR 13-14"])
+		assert.Equal(t, "behind", statuses["100FR OPEN"])
+		assert.Equal(t, "no_time", statuses["50BK 13-14"])
+		assert.Equal(t, 1, comparison.Summary.Broken)
+
+		rr = client.Get("/api/v1/records/comparison?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var all RecordComparison
+		AssertJSONBody(t, rr, &all)
+		assert.Len(t, all.Records, 4)
+
+		rr = client.Get("/api/v1/records/comparison?course_type=short")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("view-only users cannot import records", func(t *testing.T) {
+		client.SetMockUser("view_only")
+		defer client.SetMockUser("full")
+
+		rr := client.Post("/api/v1/records/import", RecordImportInput{Name: "Other", Scope: "club"})
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("DELETE /records/{id} deletes a record table", func(t *testing.T) {
+		rr := client.Delete("/api/v1/records/" + club.Table.ID)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/records/" + club.Table.ID)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,261 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ReferenceSwimmer struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	BirthDate string `json:"birth_date"`
+	TimeCount int    `json:"time_count"`
+	Times     []struct {
+		Event  string `json:"event"`
+		TimeMS int    `json:"time_ms"`
+		Age    int    `json:"age"`
+	} `json:"times"`
+}
+
+type ReferenceImportResult struct {
+	Swimmer  ReferenceSwimmer `json:"swimmer"`
+	Replaced bool             `json:"replaced"`
+	Imported int              `json:"imported"`
+	Skipped  int              `json:"skipped"`
+	Errors   []string         `json:"errors"`
+}
+
+type HeadToHeadSwim struct {
+	TimeMS int `json:"time_ms"`
+	Age    int `json:"age"`
+}
+
+type HeadToHeadSection struct {
+	Age    *int `json:"age"`
+	Events []struct {
+		Event        string          `json:"event"`
+		Swimmer      *HeadToHeadSwim `json:"swimmer"`
+		Reference    *HeadToHeadSwim `json:"reference"`
+		DifferenceMS *int            `json:"difference_ms"`
+		Leader       string          `json:"leader"`
+	} `json:"events"`
+	Summary struct {
+		SwimmerAhead   int `json:"swimmer_ahead"`
+		ReferenceAhead int `json:"reference_ahead"`
+		Tied           int `json:"tied"`
+	} `json:"summary"`
+}
+
+type HeadToHead struct {
+	ReferenceName string            `json:"reference_name"`
+	SwimmerAge    int               `json:"swimmer_age"`
+	PersonalBests HeadToHeadSection `json:"personal_bests"`
+	EqualAge      HeadToHeadSection `json:"equal_age"`
+}
+
+func TestReferenceSwimmersAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	// The swimmer is 12 at competition this year and the reference was 12
+	// eight years ago
+	year := time.Now().Year()
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Younger Swimmer", BirthDate: fmt.Sprintf("%d-06-01", year-12), Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	for _, meet := range []struct {
+		date  string
+		times map[string]int
+	}{
+		{fmt.Sprintf("%d-01-01", year-1), map[string]int{"100FR": 68000, "50BK": 38000}},
+		{fmt.Sprintf("%d-01-01", year), map[string]int{"100FR": 65000}},
+	} {
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: "Meet " + meet.date, City: "Ottawa", StartDate: meet.date, EndDate: meet.date, CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var m Meet
+		AssertJSONBody(t, rr, &m)
+		for event, ms := range meet.times {
+			rr = client.Post("/api/v1/times", TimeInput{MeetID: m.ID, Event: event, TimeMS: ms, EventDate: meet.date})
+			require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		}
+	}
+
+	exportFile := map[string]any{
+		"format_version": "1.0",
+		"swimmer":        map[string]any{"name": "Older Sibling", "birth_date": fmt.Sprintf("%d-03-15", year-20), "gender": "female"},
+		"meets": []map[string]any{
+			{"name": "Age 11 Meet", "course_type": "25m", "start_date": fmt.Sprintf("%d-02-01", year-9), "times": []map[string]any{
+				{"event": "50BK", "time": "37.50"},
+			}},
+			{"name": "Age 12 Meet", "course_type": "25m", "start_date": fmt.Sprintf("%d-02-01", year-8), "times": []map[string]any{
+				{"event": "100FR", "time": "1:06.00"},
+				{"event": "50FR", "time": "30.00", "event_date": fmt.Sprintf("%d-02-02", year-8)},
+				{"event": "999XX", "time": "30.00"},
+			}},
+			{"name": "Age 13 Meet", "course_type": "25m", "start_date": fmt.Sprintf("%d-02-01", year-7), "times": []map[string]any{
+				{"event": "100FR", "time": "1:00.00"},
+			}},
+			{"name": "Bad Meet", "course_type": "short", "start_date": fmt.Sprintf("%d-02-01", year-7), "times": []map[string]any{
+				{"event": "100FR", "time": "59.00"},
+			}},
+		},
+	}
+
+	var sibling ReferenceImportResult
+
+	t.Run("POST /reference-swimmers/import loads an export file", func(t *testing.T) {
+		rr := client.Post("/api/v1/reference-swimmers/import?description=Archive", exportFile)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		AssertJSONBody(t, rr, &sibling)
+
+		assert.False(t, sibling.Replaced)
+		assert.Equal(t, "Older Sibling", sibling.Swimmer.Name)
+		assert.Equal(t, 4, sibling.Imported)
+		assert.Equal(t, 2, sibling.Skipped)
+		assert.Len(t, sibling.Errors, 2)
+	})
+
+	t.Run("POST /reference-swimmers/import replaces a reference swimmer with the same name", func(t *testing.T) {
+		rr := client.Post("/api/v1/reference-swimmers/import", exportFile)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var replaced ReferenceImportResult
+		AssertJSONBody(t, rr, &replaced)
+		assert.True(t, replaced.Replaced)
+		assert.Equal(t, sibling.Swimmer.ID, replaced.Swimmer.ID)
+
+		rr = client.Get("/api/v1/reference-swimmers")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list struct {
+			Swimmers []ReferenceSwimmer `json:"swimmers"`
+		}
+		AssertJSONBody(t, rr, &list)
+		require.Len(t, list.Swimmers, 1)
+		assert.Equal(t, 4, list.Swimmers[0].TimeCount)
+	})
+
+	t.Run("POST /reference-swimmers/import rejects a file without a swimmer", func(t *testing.T) {
+		rr := client.Post("/api/v1/reference-swimmers/import", map[string]any{"meets": []any{}})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("POST /reference-swimmers/import rejects a file without valid times and keeps the existing times", func(t *testing.T) {
+		rr := client.Post("/api/v1/reference-swimmers/import", map[string]any{
+			"swimmer": exportFile["swimmer"],
+			"meets": []map[string]any{
+				{"name": "Bad Meet", "course_type": "25m", "start_date": fmt.Sprintf("%d-02-01", year-7), "times": []map[string]any{
+					{"event": "999XX", "time": "30.00"},
+				}},
+			},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Get("/api/v1/reference-swimmers/" + sibling.Swimmer.ID)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var ref ReferenceSwimmer
+		AssertJSONBody(t, rr, &ref)
+		assert.Len(t, ref.Times, 4)
+	})
+
+	t.Run("GET /reference-swimmers/{id} returns times with ages", func(t *testing.T) {
+		rr := client.Get("/api/v1/reference-swimmers/" + sibling.Swimmer.ID)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var ref ReferenceSwimmer
+		AssertJSONBody(t, rr, &ref)
+		require.Len(t, ref.Times, 4)
+		assert.Equal(t, "50BK", ref.Times[0].Event)
+		assert.Equal(t, 11, ref.Times[0].Age)
+		assert.Equal(t, 13, ref.Times[3].Age)
+	})
+
+	t.Run("GET /reference-swimmers/{id}/comparison compares PBs and bests at equal age", func(t *testing.T) {
+		rr := client.Get("/api/v1/reference-swimmers/" + sibling.Swimmer.ID + "/comparison")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var h2h HeadToHead
+		AssertJSONBody(t, rr, &h2h)
+
+		assert.Equal(t, "Older Sibling", h2h.ReferenceName)
+		assert.Equal(t, 12, h2h.SwimmerAge)
+
+		pbs := make(map[string]int)
+		for _, e := range h2h.PersonalBests.Events {
+			pbs[e.Event]++
+			if e.Event == "100FR" {
+				require.NotNil(t, e.DifferenceMS)
+				assert.Equal(t, 5000, *e.DifferenceMS)
+				assert.Equal(t, "reference", e.Leader)
+			}
+			if e.Event == "50FR" {
+				assert.Nil(t, e.Swimmer)
+				assert.Empty(t, e.Leader)
+			}
+		}
+		assert.Len(t, pbs, 3)
+		assert.Equal(t, 2, h2h.PersonalBests.Summary.ReferenceAhead)
+
+		require.NotNil(t, h2h.EqualAge.Age)
+		assert.Equal(t, 12, *h2h.EqualAge.Age)
+		require.Len(t, h2h.EqualAge.Events, 2)
+		for _, e := range h2h.EqualAge.Events {
+			if e.Event == "100FR" {
+				require.NotNil(t, e.Reference)
+				assert.Equal(t, 66000, e.Reference.TimeMS)
+				assert.Equal(t, 12, e.Reference.Age)
+				require.NotNil(t, e.DifferenceMS)
+				assert.Equal(t, -1000, *e.DifferenceMS)
+				assert.Equal(t, "swimmer", e.Leader)
+			}
+		}
+		assert.Equal(t, 1, h2h.EqualAge.Summary.SwimmerAhead)
+	})
+
+	t.Run("GET /reference-swimmers/{id}/comparison compares at a chosen age", func(t *testing.T) {
+		rr := client.Get("/api/v1/reference-swimmers/" + sibling.Swimmer.ID + "/comparison?age=11&course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var h2h HeadToHead
+		AssertJSONBody(t, rr, &h2h)
+		require.NotNil(t, h2h.EqualAge.Age)
+		assert.Equal(t, 11, *h2h.EqualAge.Age)
+		assert.Len(t, h2h.EqualAge.Events, 2)
+		assert.Equal(t, 1, h2h.EqualAge.Summary.ReferenceAhead)
+
+		rr = client.Get("/api/v1/reference-swimmers/" + sibling.Swimmer.ID + "/comparison?age=abc")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		rr = client.Get("/api/v1/reference-swimmers/" + sibling.Swimmer.ID + "/comparison?course_type=short")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("view-only users cannot import reference swimmers", func(t *testing.T) {
+		client.SetMockUser("view_only")
+		defer client.SetMockUser("full")
+
+		rr := client.Post("/api/v1/reference-swimmers/import", exportFile)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("DELETE /reference-swimmers/{id} deletes a reference swimmer", func(t *testing.T) {
+		rr := client.Delete("/api/v1/reference-swimmers/" + sibling.Swimmer.ID)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = client.Get("/api/v1/reference-swimmers/" + sibling.Swimmer.ID + "/comparison")
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/bpg/swimstats/backend/internal/api"
@@ -55,6 +56,22 @@ func (c *APIClient) Put(path string, body interface{}) *httptest.ResponseRecorde
 	return c.doRequest("PUT", path, body)
 }
 
+// PostRaw performs a POST request with a raw body of the given content type.
+func (c *APIClient) PostRaw(path, contentType, body string) *httptest.ResponseRecorder {
+	c.t.Helper()
+
+	req, err := http.NewRequest("POST", path, strings.NewReader(body))
+	if err != nil {
+		c.t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	c.setMockUserHeader(req)
+
+	rr := httptest.NewRecorder()
+	c.handler.ServeHTTP(rr, req)
+	return rr
+}
+
 // Delete performs a DELETE request.
 func (c *APIClient) Delete(path string) *httptest.ResponseRecorder {
 	return c.doRequest("DELETE", path, nil)
@@ -84,7 +101,16 @@ func (c *APIClient) doRequest(method, path string, body interface{}) *httptest.R
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	// Send mock user as JSON so the auth provider can parse it (unless cleared)
+	c.setMockUserHeader(req)
+
+	rr := httptest.NewRecorder()
+	c.handler.ServeHTTP(rr, req)
+	return rr
+}
+
+// setMockUserHeader sends the mock user as JSON so the auth provider can
+// parse it (unless cleared).
+func (c *APIClient) setMockUserHeader(req *http.Request) {
 	if c.accessLevel != "" {
 		mockUserJSON, _ := json.Marshal(map[string]string{
 			"email":  "test@swimstats.local",
@@ -93,10 +119,6 @@ func (c *APIClient) doRequest(method, path string, body interface{}) *httptest.R
 		})
 		req.Header.Set("X-Mock-User", string(mockUserJSON))
 	}
-
-	rr := httptest.NewRecorder()
-	c.handler.ServeHTTP(rr, req)
-	return rr
 }
 
 // AssertJSONBody unmarshals the response body into target.
@@ -159,7 +181,10 @@ func setupTestHandler(t *testing.T, testDB *TestDB) http.Handler {
 	}
 
 	// Create the router with all dependencies
-	router := api.NewRouter(logger, authProvider, testDB.Pool)
+	router, err := api.NewRouter(logger, authProvider, testDB.Pool)
+	if err != nil {
+		t.Fatalf("Failed to create router: %v", err)
+	}
 
 	return router.Handler()
 }
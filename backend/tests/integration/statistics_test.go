@@ -0,0 +1,155 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type EventStatistics struct {
+	Event                string   `json:"event"`
+	CourseType           string   `json:"course_type"`
+	SwimCount            int      `json:"swim_count"`
+	MeetCount            int      `json:"meet_count"`
+	FirstTimeMS          int      `json:"first_time_ms"`
+	BestTimeMS           int      `json:"best_time_ms"`
+	TotalDropMS          int      `json:"total_drop_ms"`
+	TotalDropPercent     float64  `json:"total_drop_percent"`
+	SeasonDropMS         *int     `json:"season_drop_ms"`
+	AverageDropPerMeetMS *int     `json:"average_drop_per_meet_ms"`
+	RecentSwimCount      int      `json:"recent_swim_count"`
+	RecentSpreadMS       *int     `json:"recent_spread_ms"`
+	SeasonSwimCount      int      `json:"season_swim_count"`
+	SeasonBestTimeMS     *int     `json:"season_best_time_ms"`
+	RecentSpreadPercent  *float64 `json:"recent_spread_percent"`
+}
+
+type ImprovedEvent struct {
+	Rank        int     `json:"rank"`
+	Event       string  `json:"event"`
+	CourseType  string  `json:"course_type"`
+	DropMS      int     `json:"drop_ms"`
+	DropPercent float64 `json:"drop_percent"`
+}
+
+type ImprovementStatistics struct {
+	Season                 string            `json:"season"`
+	TotalSwims             int               `json:"total_swims"`
+	Events                 []EventStatistics `json:"events"`
+	MostImproved           []ImprovedEvent   `json:"most_improved"`
+	MostImprovedThisSeason []ImprovedEvent   `json:"most_improved_this_season"`
+}
+
+func TestImprovementStatisticsAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Stats Swimmer", BirthDate: "2012-05-01", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	// Two meets before the current season and one in it
+	now := time.Now()
+	seasonStart := time.Date(now.Year(), time.September, 1, 0, 0, 0, 0, time.UTC)
+	if now.Before(seasonStart) {
+		seasonStart = seasonStart.AddDate(-1, 0, 0)
+	}
+	dates := []string{
+		seasonStart.AddDate(-1, 2, 0).Format("2006-01-02"),
+		seasonStart.AddDate(0, -3, 0).Format("2006-01-02"),
+		seasonStart.AddDate(0, 0, 14).Format("2006-01-02"),
+	}
+	swims := []struct {
+		event string
+		times []int
+	}{
+		{"100FR", []int{80000, 76000, 74000}},
+		{"50BK", []int{40000, 41000, 39500}},
+	}
+	for i, date := range dates {
+		rr := client.Post("/api/v1/meets", MeetInput{
+			Name: "Stats Meet " + date, City: "Ottawa", StartDate: date, EndDate: date, CourseType: "25m",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+
+		for _, s := range swims {
+			rr = client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: s.event, TimeMS: s.times[i], EventDate: date})
+			require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		}
+	}
+
+	t.Run("GET /analytics/improvement returns drops per event", func(t *testing.T) {
+		rr := client.Get("/api/v1/analytics/improvement")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var stats ImprovementStatistics
+		AssertJSONBody(t, rr, &stats)
+
+		assert.Equal(t, 6, stats.TotalSwims)
+		require.Len(t, stats.Events, 2)
+
+		free := stats.Events[0]
+		assert.Equal(t, "100FR", free.Event)
+		assert.Equal(t, 3, free.SwimCount)
+		assert.Equal(t, 3, free.MeetCount)
+		assert.Equal(t, 74000, free.BestTimeMS)
+		assert.Equal(t, 6000, free.TotalDropMS)
+		assert.InDelta(t, 7.5, free.TotalDropPercent, 0.001)
+		require.NotNil(t, free.AverageDropPerMeetMS)
+		assert.Equal(t, 3000, *free.AverageDropPerMeetMS)
+		require.NotNil(t, free.SeasonDropMS)
+		assert.Equal(t, 2000, *free.SeasonDropMS)
+		assert.Equal(t, 1, free.SeasonSwimCount)
+		assert.Equal(t, 3, free.RecentSwimCount)
+		require.NotNil(t, free.RecentSpreadMS)
+		assert.Equal(t, 3055, *free.RecentSpreadMS)
+
+		back := stats.Events[1]
+		assert.Equal(t, "50BK", back.Event)
+		assert.Equal(t, 500, back.TotalDropMS)
+		require.NotNil(t, back.SeasonDropMS)
+		assert.Equal(t, 500, *back.SeasonDropMS)
+	})
+
+	t.Run("GET /analytics/improvement ranks the most improved events", func(t *testing.T) {
+		rr := client.Get("/api/v1/analytics/improvement?course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var stats ImprovementStatistics
+		AssertJSONBody(t, rr, &stats)
+
+		require.Len(t, stats.MostImproved, 2)
+		assert.Equal(t, 1, stats.MostImproved[0].Rank)
+		assert.Equal(t, "100FR", stats.MostImproved[0].Event)
+		require.Len(t, stats.MostImprovedThisSeason, 2)
+		assert.Equal(t, "100FR", stats.MostImprovedThisSeason[0].Event)
+	})
+
+	t.Run("GET /analytics/improvement filters by course", func(t *testing.T) {
+		rr := client.Get("/api/v1/analytics/improvement?course_type=50m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var stats ImprovementStatistics
+		AssertJSONBody(t, rr, &stats)
+		assert.Empty(t, stats.Events)
+		assert.Empty(t, stats.MostImproved)
+	})
+
+	t.Run("GET /analytics/improvement rejects an invalid course type", func(t *testing.T) {
+		rr := client.Get("/api/v1/analytics/improvement?course_type=short")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
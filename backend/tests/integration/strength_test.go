@@ -0,0 +1,148 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type EventStrength struct {
+	Rank  int      `json:"rank"`
+	Event string   `json:"event"`
+	Score *float64 `json:"score"`
+}
+
+type StrokeStrength struct {
+	Rank   int      `json:"rank"`
+	Stroke string   `json:"stroke"`
+	Events int      `json:"events"`
+	Score  float64  `json:"score"`
+	Change *float64 `json:"change"`
+}
+
+type StrengthProfile struct {
+	Method    string           `json:"method"`
+	Events    []EventStrength  `json:"events"`
+	Strokes   []StrokeStrength `json:"strokes"`
+	Strongest []EventStrength  `json:"strongest"`
+	Weakest   []EventStrength  `json:"weakest"`
+	Seasons   []struct {
+		Season  string           `json:"season"`
+		Strokes []StrokeStrength `json:"strokes"`
+	} `json:"seasons"`
+}
+
+func TestStrengthProfileAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Strength Swimmer", BirthDate: "2012-05-01", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	rr = client.Post("/api/v1/standards/import", StandardImportInput{
+		Name: "Provincial", CourseType: "25m", Gender: "female",
+		Times: []StandardTimeInput{
+			{Event: "50FR", AgeGroup: "OPEN", TimeMs: 30000},
+			{Event: "100FR", AgeGroup: "OPEN", TimeMs: 66000},
+			{Event: "100BK", AgeGroup: "OPEN", TimeMs: 72000},
+		},
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var std StandardWithTimes
+	AssertJSONBody(t, rr, &std)
+
+	swims := map[string]map[string]int{
+		"2025-03-01": {"50FR": 33000, "100BK": 71280, "200IM": 170000},
+		"2026-03-01": {"50FR": 30000, "100FR": 69000, "100BK": 75000},
+	}
+	for date, times := range swims {
+		rr := client.Post("/api/v1/meets", MeetInput{Name: "Spring Open", City: "Regina", StartDate: date, EndDate: date, CourseType: "25m"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var meet Meet
+		AssertJSONBody(t, rr, &meet)
+		for event, timeMS := range times {
+			rr := client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: event, TimeMS: timeMS, EventDate: date})
+			require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		}
+	}
+
+	t.Run("GET /analytics/strength ranks events against a standard", func(t *testing.T) {
+		rr := client.Get("/api/v1/analytics/strength?course_type=25m&standard_id=" + std.ID)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var profile StrengthProfile
+		AssertJSONBody(t, rr, &profile)
+		assert.Equal(t, "standard", profile.Method)
+
+		require.Len(t, profile.Events, 4)
+		order := []string{"100BK", "50FR", "100FR", "200IM"}
+		for i, event := range order {
+			assert.Equal(t, event, profile.Events[i].Event)
+		}
+		require.NotNil(t, profile.Events[0].Score)
+		assert.InDelta(t, 101.01, *profile.Events[0].Score, 0.01)
+		assert.Nil(t, profile.Events[3].Score, "200IM has no cut")
+		assert.Equal(t, 0, profile.Events[3].Rank)
+
+		require.Len(t, profile.Strongest, 3)
+		assert.Equal(t, "100BK", profile.Strongest[0].Event)
+		require.Len(t, profile.Weakest, 3)
+		assert.Equal(t, "100FR", profile.Weakest[0].Event)
+
+		require.Len(t, profile.Strokes, 2)
+		assert.Equal(t, "Backstroke", profile.Strokes[0].Stroke)
+		assert.Equal(t, "Freestyle", profile.Strokes[1].Stroke)
+		assert.Equal(t, 2, profile.Strokes[1].Events)
+	})
+
+	t.Run("GET /analytics/strength shows the shift between seasons", func(t *testing.T) {
+		rr := client.Get("/api/v1/analytics/strength?standard_id=" + std.ID)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var profile StrengthProfile
+		AssertJSONBody(t, rr, &profile)
+		require.Len(t, profile.Seasons, 2)
+		assert.Equal(t, "2024-25", profile.Seasons[0].Season)
+		assert.Equal(t, "Backstroke", profile.Seasons[0].Strokes[0].Stroke)
+		assert.Nil(t, profile.Seasons[0].Strokes[0].Change)
+
+		latest := profile.Seasons[1]
+		assert.Equal(t, "2025-26", latest.Season)
+		require.Len(t, latest.Strokes, 2)
+		assert.Equal(t, "Freestyle", latest.Strokes[0].Stroke)
+		require.NotNil(t, latest.Strokes[0].Change)
+		assert.Greater(t, *latest.Strokes[0].Change, 0.0)
+		require.NotNil(t, latest.Strokes[1].Change)
+		assert.Less(t, *latest.Strokes[1].Change, 0.0)
+	})
+
+	t.Run("GET /analytics/strength validates the method", func(t *testing.T) {
+		rr := client.Get("/api/v1/analytics/strength?method=standard")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Get("/api/v1/analytics/strength?method=speed")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Get("/api/v1/analytics/strength?method=points")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var profile StrengthProfile
+		AssertJSONBody(t, rr, &profile)
+		assert.Equal(t, "points", profile.Method)
+		assert.Len(t, profile.Events, 4)
+		assert.Empty(t, profile.Strongest, "no base times are loaded")
+	})
+}
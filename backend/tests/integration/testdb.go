@@ -90,6 +90,18 @@ func (db *TestDB) CleanTables(t *testing.T) {
 
 	// Tables in order respecting foreign key constraints
 	tables := []string{
+		"para_base_times",
+		"world_aquatics_base_times",
+		"reference_times",
+		"reference_swimmers",
+		"ranking_entries",
+		"ranking_snapshots",
+		"records",
+		"record_tables",
+		"goals",
+		"comparison_thresholds",
+		"standard_ladder_tiers",
+		"standard_ladders",
 		"standard_times",
 		"time_standards",
 		"times",
@@ -0,0 +1,186 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ThresholdInput struct {
+	StandardID  string   `json:"standard_id,omitempty"`
+	Event       string   `json:"event,omitempty"`
+	MinDistance *int     `json:"min_distance,omitempty"`
+	MaxDistance *int     `json:"max_distance,omitempty"`
+	Percent     *float64 `json:"percent,omitempty"`
+	TimeMS      *int     `json:"time_ms,omitempty"`
+}
+
+type Threshold struct {
+	ID      string   `json:"id"`
+	Event   *string  `json:"event"`
+	Percent *float64 `json:"percent"`
+	TimeMS  *int     `json:"time_ms"`
+}
+
+type ThresholdComparison struct {
+	ThresholdResolution []string `json:"threshold_resolution"`
+	Comparisons         []struct {
+		Event     string `json:"event"`
+		Status    string `json:"status"`
+		Threshold *struct {
+			Source      string   `json:"source"`
+			ThresholdID *string  `json:"threshold_id"`
+			Percent     *float64 `json:"percent"`
+			TimeMS      *int     `json:"time_ms"`
+		} `json:"threshold"`
+	} `json:"comparisons"`
+}
+
+func TestThresholdAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	testDB := SetupTestDB(ctx, t)
+	defer testDB.TeardownTestDB(ctx, t)
+
+	testDB.CleanTables(t)
+
+	handler := setupTestHandler(t, testDB)
+	client := NewAPIClient(t, handler)
+	client.SetMockUser("full")
+
+	rr := client.Put("/api/v1/swimmer", SwimmerInput{Name: "Threshold Swimmer", BirthDate: "2008-06-01", Gender: "female"})
+	require.True(t, rr.Code == http.StatusCreated || rr.Code == http.StatusOK, rr.Body.String())
+
+	rr = client.Post("/api/v1/standards/import", StandardImportInput{
+		Name: "Provincial", CourseType: "25m", Gender: "female",
+		Times: []StandardTimeInput{
+			{Event: "50FR", AgeGroup: "OPEN", TimeMs: 30000},
+			{Event: "100FR", AgeGroup: "OPEN", TimeMs: 60000},
+			{Event: "400FR", AgeGroup: "OPEN", TimeMs: 300000},
+		},
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var std StandardWithTimes
+	AssertJSONBody(t, rr, &std)
+
+	rr = client.Post("/api/v1/meets", MeetInput{
+		Name: "Threshold Open", City: "Toronto", StartDate: "2025-11-01", EndDate: "2025-11-01", CourseType: "25m",
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var meet Meet
+	AssertJSONBody(t, rr, &meet)
+
+	for event, timeMS := range map[string]int{"50FR": 30600, "100FR": 61500, "400FR": 312000} {
+		rr := client.Post("/api/v1/times", TimeInput{MeetID: meet.ID, Event: event, TimeMS: timeMS, EventDate: "2025-11-01"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	compare := func(t *testing.T) map[string]string {
+		t.Helper()
+		rr := client.Get("/api/v1/comparisons?standard_id=" + std.ID + "&course_type=25m")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var result ThresholdComparison
+		AssertJSONBody(t, rr, &result)
+		assert.Equal(t, []string{"event", "distance", "standard", "swimmer"}, result.ThresholdResolution)
+
+		statuses := make(map[string]string)
+		for _, comp := range result.Comparisons {
+			if comp.Threshold != nil {
+				statuses[comp.Event] = comp.Status + "/" + comp.Threshold.Source
+			}
+		}
+		return statuses
+	}
+
+	percent := func(p float64) *float64 { return &p }
+	ms := func(v int) *int { return &v }
+
+	t.Run("comparisons use the swimmer default without thresholds", func(t *testing.T) {
+		statuses := compare(t)
+		assert.Equal(t, "almost/swimmer", statuses["50FR"])
+		assert.Equal(t, "almost/swimmer", statuses["100FR"])
+		assert.Equal(t, "not_achieved/swimmer", statuses["400FR"])
+	})
+
+	var sprintID string
+
+	t.Run("POST /thresholds sets thresholds at every level", func(t *testing.T) {
+		rr := client.Post("/api/v1/thresholds", ThresholdInput{StandardID: std.ID, Percent: percent(5)})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		rr = client.Post("/api/v1/thresholds", ThresholdInput{MaxDistance: ms(100), TimeMS: ms(500)})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var sprint Threshold
+		AssertJSONBody(t, rr, &sprint)
+		sprintID = sprint.ID
+		require.NotNil(t, sprint.TimeMS)
+		assert.Equal(t, 500, *sprint.TimeMS)
+
+		rr = client.Post("/api/v1/thresholds", ThresholdInput{StandardID: std.ID, Event: "100fr", Percent: percent(3)})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var event Threshold
+		AssertJSONBody(t, rr, &event)
+		require.NotNil(t, event.Event)
+		assert.Equal(t, "100FR", *event.Event)
+
+		statuses := compare(t)
+		assert.Equal(t, "not_achieved/distance", statuses["50FR"])
+		assert.Equal(t, "almost/event", statuses["100FR"])
+		assert.Equal(t, "almost/standard", statuses["400FR"])
+	})
+
+	t.Run("POST /thresholds validates the input", func(t *testing.T) {
+		rr := client.Post("/api/v1/thresholds", ThresholdInput{Event: "50FR", Percent: percent(2), TimeMS: ms(300)})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Post("/api/v1/thresholds", ThresholdInput{Percent: percent(2)})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Post("/api/v1/thresholds", ThresholdInput{Event: "50FR", MaxDistance: ms(50), Percent: percent(2)})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = client.Post("/api/v1/thresholds", ThresholdInput{MinDistance: ms(50), MaxDistance: ms(200), Percent: percent(2)})
+		assert.Equal(t, http.StatusBadRequest, rr.Code, "overlaps the sprint bucket")
+	})
+
+	t.Run("POST /thresholds requires write access", func(t *testing.T) {
+		client.SetMockUser("view_only")
+		defer client.SetMockUser("full")
+
+		rr := client.Post("/api/v1/thresholds", ThresholdInput{Event: "50FR", Percent: percent(2)})
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("PUT /thresholds/{id} updates a threshold", func(t *testing.T) {
+		rr := client.Put("/api/v1/thresholds/"+sprintID, ThresholdInput{MaxDistance: ms(100), TimeMS: ms(700)})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		statuses := compare(t)
+		assert.Equal(t, "almost/distance", statuses["50FR"])
+	})
+
+	t.Run("DELETE /thresholds/{id} removes a threshold", func(t *testing.T) {
+		rr := client.Delete("/api/v1/thresholds/" + sprintID)
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+
+		rr = client.Get("/api/v1/thresholds/" + sprintID)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+
+		statuses := compare(t)
+		assert.Equal(t, "almost/standard", statuses["50FR"])
+
+		rr = client.Get("/api/v1/thresholds")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list struct {
+			Thresholds []Threshold `json:"thresholds"`
+		}
+		AssertJSONBody(t, rr, &list)
+		assert.Len(t, list.Thresholds, 2)
+	})
+}
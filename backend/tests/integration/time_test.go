@@ -10,11 +10,22 @@ import (
 )
 
 type TimeInput struct {
-	MeetID    string `json:"meet_id"`
-	Event     string `json:"event"`
-	TimeMS    int    `json:"time_ms"`
-	Notes     string `json:"notes,omitempty"`
-	EventDate string `json:"event_date"`
+	MeetID        string   `json:"meet_id"`
+	Event         string   `json:"event"`
+	TimeMS        int      `json:"time_ms"`
+	Notes         string   `json:"notes,omitempty"`
+	EventDate     string   `json:"event_date"`
+	Heat          *int     `json:"heat,omitempty"`
+	Lane          *int     `json:"lane,omitempty"`
+	HeatPlace     *int     `json:"heat_place,omitempty"`
+	OverallPlace  *int     `json:"overall_place,omitempty"`
+	AgeGroupPlace *int     `json:"age_group_place,omitempty"`
+	PointsEarned  *float64 `json:"points_earned,omitempty"`
+	TimingMethod  string   `json:"timing_method,omitempty"`
+	ReactionTime  *int     `json:"reaction_time_ms,omitempty"`
+
+	WaterTemperatureC *float64 `json:"water_temperature_c,omitempty"`
+	Wetsuit           *bool    `json:"wetsuit,omitempty"`
 }
 
 type TimeBatchInput struct {
@@ -28,16 +39,42 @@ type TimeBatchInput struct {
 }
 
 type TimeRecord struct {
-	ID            string `json:"id"`
-	MeetID        string `json:"meet_id"`
-	Event         string `json:"event"`
-	TimeMS        int    `json:"time_ms"`
-	TimeFormatted string `json:"time_formatted"`
-	Notes         string `json:"notes,omitempty"`
-	IsPB          bool   `json:"is_pb,omitempty"`
-	Meet          *Meet  `json:"meet,omitempty"`
+	ID             string   `json:"id"`
+	MeetID         string   `json:"meet_id"`
+	Event          string   `json:"event"`
+	TimeMS         int      `json:"time_ms"`
+	TimeFormatted  string   `json:"time_formatted"`
+	Notes          string   `json:"notes,omitempty"`
+	IsPB           bool     `json:"is_pb,omitempty"`
+	AgeGroup       string   `json:"age_group,omitempty"`
+	IsAgeGroupBest bool     `json:"is_age_group_best,omitempty"`
+	Heat           *int     `json:"heat,omitempty"`
+	Lane           *int     `json:"lane,omitempty"`
+	HeatPlace      *int     `json:"heat_place,omitempty"`
+	OverallPlace   *int     `json:"overall_place,omitempty"`
+	AgeGroupPlace  *int     `json:"age_group_place,omitempty"`
+	PointsEarned   *float64 `json:"points_earned,omitempty"`
+	TimingMethod   string   `json:"timing_method"`
+	ReactionTime   *int     `json:"reaction_time_ms,omitempty"`
+	Meet           *Meet    `json:"meet,omitempty"`
+	Points         *int     `json:"points,omitempty"`
+	Records        []struct {
+		TableName    string `json:"table_name"`
+		AgeGroup     string `json:"age_group"`
+		DifferenceMS int    `json:"difference_ms"`
+		Broken       bool   `json:"broken"`
+	} `json:"records,omitempty"`
+
+	WaterTemperatureC *float64 `json:"water_temperature_c,omitempty"`
+	Wetsuit           *bool    `json:"wetsuit,omitempty"`
 }
 
+func intPtr(v int) *int { return &v }
+
+func floatPtr(v float64) *float64 { return &v }
+
+func boolPtr(v bool) *bool { return &v }
+
 type TimeList struct {
 	Times []TimeRecord `json:"times"`
 	Total int          `json:"total"`
@@ -121,6 +158,84 @@ func TestTimeAPI(t *testing.T) {
 		assert.Equal(t, "Heat 3", time.Notes)
 	})
 
+	t.Run("POST /times stores placement details", func(t *testing.T) {
+		testDB.ClearTables(ctx, t)
+		_, meetID := setupSwimmerAndMeet(t, "25m")
+
+		input := TimeInput{
+			MeetID:        meetID,
+			Event:         "50FR",
+			TimeMS:        30120,
+			EventDate:     "2026-03-15",
+			Heat:          intPtr(2),
+			Lane:          intPtr(5),
+			HeatPlace:     intPtr(1),
+			OverallPlace:  intPtr(4),
+			AgeGroupPlace: intPtr(2),
+			PointsEarned:  floatPtr(16.5),
+		}
+
+		rr := client.Post("/api/v1/times", input)
+		require.Equal(t, http.StatusCreated, rr.Code, "got %d: %s", rr.Code, rr.Body.String())
+
+		var created TimeRecord
+		AssertJSONBody(t, rr, &created)
+
+		rr = client.Get("/api/v1/times/" + created.ID)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var fetched TimeRecord
+		AssertJSONBody(t, rr, &fetched)
+		require.NotNil(t, fetched.Heat)
+		assert.Equal(t, 2, *fetched.Heat)
+		require.NotNil(t, fetched.Lane)
+		assert.Equal(t, 5, *fetched.Lane)
+		require.NotNil(t, fetched.HeatPlace)
+		assert.Equal(t, 1, *fetched.HeatPlace)
+		require.NotNil(t, fetched.OverallPlace)
+		assert.Equal(t, 4, *fetched.OverallPlace)
+		require.NotNil(t, fetched.AgeGroupPlace)
+		assert.Equal(t, 2, *fetched.AgeGroupPlace)
+		require.NotNil(t, fetched.PointsEarned)
+		assert.InDelta(t, 16.5, *fetched.PointsEarned, 0.001)
+	})
+
+	t.Run("POST /times records timing method", func(t *testing.T) {
+		testDB.ClearTables(ctx, t)
+		_, meetID := setupSwimmerAndMeet(t, "25m")
+
+		rr := client.Post("/api/v1/times", TimeInput{MeetID: meetID, Event: "50FR", TimeMS: 30120, EventDate: "2026-03-15"})
+		require.Equal(t, http.StatusCreated, rr.Code, "got %d: %s", rr.Code, rr.Body.String())
+		var electronic TimeRecord
+		AssertJSONBody(t, rr, &electronic)
+		assert.Equal(t, "electronic", electronic.TimingMethod)
+
+		rr = client.Post("/api/v1/times", TimeInput{MeetID: meetID, Event: "100FR", TimeMS: 65320, EventDate: "2026-03-15", TimingMethod: "manual"})
+		require.Equal(t, http.StatusCreated, rr.Code, "got %d: %s", rr.Code, rr.Body.String())
+		var manual TimeRecord
+		AssertJSONBody(t, rr, &manual)
+		assert.Equal(t, "manual", manual.TimingMethod)
+	})
+
+	t.Run("POST /times stores reaction time", func(t *testing.T) {
+		testDB.ClearTables(ctx, t)
+		_, meetID := setupSwimmerAndMeet(t, "25m")
+
+		rr := client.Post("/api/v1/times", TimeInput{MeetID: meetID, Event: "50FR", TimeMS: 30120, EventDate: "2026-03-15", ReactionTime: intPtr(680)})
+		require.Equal(t, http.StatusCreated, rr.Code, "got %d: %s", rr.Code, rr.Body.String())
+		var created TimeRecord
+		AssertJSONBody(t, rr, &created)
+		require.NotNil(t, created.ReactionTime)
+		assert.Equal(t, 680, *created.ReactionTime)
+
+		rr = client.Get("/api/v1/times/" + created.ID)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var fetched TimeRecord
+		AssertJSONBody(t, rr, &fetched)
+		require.NotNil(t, fetched.ReactionTime)
+		assert.Equal(t, 680, *fetched.ReactionTime)
+	})
+
 	t.Run("GET /times lists times", func(t *testing.T) {
 		testDB.ClearTables(ctx, t)
 		_, meetID := setupSwimmerAndMeet(t, "25m")
@@ -303,6 +418,22 @@ func TestTimeAPI(t *testing.T) {
 				name:  "missing event_date",
 				input: TimeInput{MeetID: meetID, Event: "100FR", TimeMS: 65320, EventDate: ""},
 			},
+			{
+				name:  "invalid timing method",
+				input: TimeInput{MeetID: meetID, Event: "100FR", TimeMS: 65320, EventDate: "2026-03-15", TimingMethod: "stopwatch"},
+			},
+			{
+				name:  "reaction time too slow",
+				input: TimeInput{MeetID: meetID, Event: "100FR", TimeMS: 65320, EventDate: "2026-03-15", ReactionTime: intPtr(5000)},
+			},
+			{
+				name:  "zero heat",
+				input: TimeInput{MeetID: meetID, Event: "100FR", TimeMS: 65320, EventDate: "2026-03-15", Heat: intPtr(0)},
+			},
+			{
+				name:  "zero overall place",
+				input: TimeInput{MeetID: meetID, Event: "100FR", TimeMS: 65320, EventDate: "2026-03-15", OverallPlace: intPtr(0)},
+			},
 		}
 
 		for _, tc := range testCases {